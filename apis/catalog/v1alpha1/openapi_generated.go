@@ -346,6 +346,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kmodules.xyz/offshoot-api/api/v1.ServiceSpec":                                schema_kmodulesxyz_offshoot_api_api_v1_ServiceSpec(ref),
 		"kmodules.xyz/offshoot-api/api/v1.ServiceTemplateSpec":                        schema_kmodulesxyz_offshoot_api_api_v1_ServiceTemplateSpec(ref),
 		"kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersion":             schema_operator_apis_catalog_v1alpha1_VaultServerVersion(ref),
+		"kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionAgent":        schema_operator_apis_catalog_v1alpha1_VaultServerVersionAgent(ref),
 		"kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionExporter":     schema_operator_apis_catalog_v1alpha1_VaultServerVersionExporter(ref),
 		"kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionList":         schema_operator_apis_catalog_v1alpha1_VaultServerVersionList(ref),
 		"kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionSpec":         schema_operator_apis_catalog_v1alpha1_VaultServerVersionSpec(ref),
@@ -15679,6 +15680,25 @@ func schema_operator_apis_catalog_v1alpha1_VaultServerVersion(ref common.Referen
 	}
 }
 
+func schema_operator_apis_catalog_v1alpha1_VaultServerVersionAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VaultServerVersionAgent is the image for the vault agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_operator_apis_catalog_v1alpha1_VaultServerVersionExporter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15778,6 +15798,12 @@ func schema_operator_apis_catalog_v1alpha1_VaultServerVersionSpec(ref common.Ref
 							Ref:         ref("kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionExporter"),
 						},
 					},
+					"agent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vault Agent Image, used by the vault agent injector. It must be vault 1.3.0 or newer, as the older agents don't support templates. If not set, the vault image is used if it is vault 1.3.0 or newer, otherwise vault:1.3.1.",
+							Ref:         ref("kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionAgent"),
+						},
+					},
 					"deprecated": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Deprecated versions usable but regarded as obsolete and best avoided, typically due to having been superseded.",
@@ -15790,7 +15816,7 @@ func schema_operator_apis_catalog_v1alpha1_VaultServerVersionSpec(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionExporter", "kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionUnsealer", "kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionVault"},
+			"kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionAgent", "kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionExporter", "kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionUnsealer", "kubevault.dev/operator/apis/catalog/v1alpha1.VaultServerVersionVault"},
 	}
 }
 
@@ -15807,6 +15833,13 @@ func schema_operator_apis_catalog_v1alpha1_VaultServerVersionUnsealer(ref common
 							Format: "",
 						},
 					},
+					"pgpKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PGPKeys indicates whether the unsealer image supports encrypting the unseal keys and root token with pgp keys (--pgp-keys, --pgp-custodians, --root-token-pgp-key and --unseal-keys-secret flags)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"image"},
 			},
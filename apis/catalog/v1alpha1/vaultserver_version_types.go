@@ -53,6 +53,11 @@ type VaultServerVersionSpec struct {
 	Unsealer VaultServerVersionUnsealer `json:"unsealer"`
 	// Exporter Image
 	Exporter VaultServerVersionExporter `json:"exporter"`
+	// Vault Agent Image, used by the vault agent injector.
+	// It must be vault 1.3.0 or newer, as the older agents don't support templates.
+	// If not set, the vault image is used if it is vault 1.3.0 or newer, otherwise vault:1.3.1.
+	// +optional
+	Agent VaultServerVersionAgent `json:"agent,omitempty"`
 	// Deprecated versions usable but regarded as obsolete and best avoided, typically due to having been superseded.
 	// +optional
 	Deprecated bool `json:"deprecated,omitempty"`
@@ -66,6 +71,11 @@ type VaultServerVersionVault struct {
 // VaultServerVersionUnsealer is the image for the vault unsealer
 type VaultServerVersionUnsealer struct {
 	Image string `json:"image"`
+	// PGPKeys indicates whether the unsealer image supports encrypting
+	// the unseal keys and root token with pgp keys
+	// (--pgp-keys, --pgp-custodians, --root-token-pgp-key and --unseal-keys-secret flags)
+	// +optional
+	PGPKeys bool `json:"pgpKeys,omitempty"`
 }
 
 // VaultServerVersionExporter is the image for the vault exporter
@@ -73,6 +83,11 @@ type VaultServerVersionExporter struct {
 	Image string `json:"image"`
 }
 
+// VaultServerVersionAgent is the image for the vault agent
+type VaultServerVersionAgent struct {
+	Image string `json:"image,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 
@@ -51,6 +51,22 @@ func (in *VaultServerVersion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultServerVersionAgent) DeepCopyInto(out *VaultServerVersionAgent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultServerVersionAgent.
+func (in *VaultServerVersionAgent) DeepCopy() *VaultServerVersionAgent {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultServerVersionAgent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultServerVersionExporter) DeepCopyInto(out *VaultServerVersionExporter) {
 	*out = *in
@@ -106,6 +122,7 @@ func (in *VaultServerVersionSpec) DeepCopyInto(out *VaultServerVersionSpec) {
 	out.Vault = in.Vault
 	out.Unsealer = in.Unsealer
 	out.Exporter = in.Exporter
+	out.Agent = in.Agent
 	return
 }
 
@@ -0,0 +1,115 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindAppRoleSecretIDRequest = "AppRoleSecretIDRequest"
+	ResourceAppRoleSecretIDRequest     = "approlesecretidrequest"
+	ResourceAppRoleSecretIDRequests    = "approlesecretidrequests"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=approlesecretidrequests,singular=approlesecretidrequest,categories={vault,appscode,all}
+// +kubebuilder:subresource:status
+type AppRoleSecretIDRequest struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              AppRoleSecretIDRequestSpec   `json:"spec,omitempty"`
+	Status            AppRoleSecretIDRequestStatus `json:"status,omitempty"`
+}
+
+// Link:
+//  - https://www.vaultproject.io/api/auth/approle/index.html#generate-new-secret-id
+
+// AppRoleSecretIDRequestSpec contains information to request for a secret_id of a VaultAppRole
+type AppRoleSecretIDRequestSpec struct {
+	// Contains vault AppRole info
+	// +required
+	RoleRef RoleRef `json:"roleRef"`
+
+	// Contains a reference to the object or user identities the role binding is applied to
+	// +required
+	Subjects []rbac.Subject `json:"subjects"`
+
+	// Metadata to be tied to the secret_id
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// List of CIDR blocks enforcing secret_id to be used from specific set of IP addresses
+	// +optional
+	CIDRList []string `json:"cidrList,omitempty"`
+
+	// List of CIDR blocks; if set, specifies blocks of IP addresses
+	// which can use the tokens issued using this secret_id
+	// +optional
+	TokenBoundCIDRs []string `json:"tokenBoundCIDRs,omitempty"`
+
+	// If set, the secret_id is response-wrapped with the given TTL, i.e. 10m
+	// and only the wrapping token is stored in the secret
+	// +optional
+	WrapTTL string `json:"wrapTTL,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+type AppRoleSecretIDRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of AppRoleSecretIDRequest objects
+	Items []AppRoleSecretIDRequest `json:"items,omitempty"`
+}
+
+type AppRoleSecretIDRequestStatus struct {
+	// Conditions applied to the request, such as approval or denial.
+	// +optional
+	Conditions []AppRoleSecretIDRequestCondition `json:"conditions,omitempty"`
+
+	// Name of the secret containing role_id and secret_id
+	Secret *core.LocalObjectReference `json:"secret,omitempty"`
+
+	// Accessor of the issued secret_id
+	SecretIDAccessor string `json:"secretIDAccessor,omitempty"`
+}
+
+type AppRoleSecretIDRequestCondition struct {
+	// request approval state, currently Approved or Denied.
+	Type RequestConditionType `json:"type"`
+
+	// brief reason for the request state
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// human readable message with details about the request state
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// timestamp for the last update to this condition
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
@@ -0,0 +1,142 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindVaultAppRole = "VaultAppRole"
+	ResourceVaultAppRole     = "vaultapprole"
+	ResourceVaultAppRoles    = "vaultapproles"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vaultapproles,singular=vaultapprole,categories={vault,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+type VaultAppRole struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultAppRoleSpec   `json:"spec,omitempty"`
+	Status            VaultAppRoleStatus `json:"status,omitempty"`
+}
+
+// VaultAppRoleSpec contains connection information, AppRole info, etc
+// More info: https://www.vaultproject.io/api/auth/approle/index.html#create-update-approle
+type VaultAppRoleSpec struct {
+	// VaultRef is the name of a AppBinding referencing to a Vault Server
+	VaultRef core.LocalObjectReference `json:"vaultRef"`
+
+	// Path defines the path where the AppRole auth method is enabled
+	// default: approle
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// List of policies to encode onto tokens issued for this role
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// Require secret_id to be presented when logging in using this AppRole.
+	// Defaults to true.
+	// +optional
+	BindSecretID *bool `json:"bindSecretID,omitempty"`
+
+	// List of CIDR blocks; if set, specifies blocks of IP addresses
+	// which can perform the login operation
+	// +optional
+	SecretIDBoundCIDRs []string `json:"secretIDBoundCIDRs,omitempty"`
+
+	// Number of times any particular secret_id can be used to fetch
+	// a token from this AppRole. A value of zero allows unlimited uses.
+	// +optional
+	SecretIDNumUses int64 `json:"secretIDNumUses,omitempty"`
+
+	// Duration after which any secret_id expires, i.e. 10m, 1h
+	// +optional
+	SecretIDTTL string `json:"secretIDTTL,omitempty"`
+
+	// List of CIDR blocks; if set, specifies blocks of IP addresses
+	// which can use the tokens issued for this role
+	// +optional
+	TokenBoundCIDRs []string `json:"tokenBoundCIDRs,omitempty"`
+
+	// The incremental lifetime for generated tokens
+	// +optional
+	TokenTTL string `json:"tokenTTL,omitempty"`
+
+	// The maximum lifetime for generated tokens
+	// +optional
+	TokenMaxTTL string `json:"tokenMaxTTL,omitempty"`
+
+	// The maximum number of times a generated token may be used.
+	// A value of zero allows unlimited uses.
+	// +optional
+	TokenNumUses int64 `json:"tokenNumUses,omitempty"`
+
+	// If set, generated tokens will be periodic with the given period
+	// +optional
+	TokenPeriod string `json:"tokenPeriod,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+type VaultAppRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of VaultAppRole objects
+	Items []VaultAppRole `json:"items,omitempty"`
+}
+
+type VaultAppRolePhase string
+
+type VaultAppRoleStatus struct {
+	Phase VaultAppRolePhase `json:"phase,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed for this VaultAppRole. It corresponds to the
+	// VaultAppRole's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// RoleID is the role_id of the AppRole in vault
+	RoleID string `json:"roleID,omitempty"`
+
+	// Represents the latest available observations of a VaultAppRole current state.
+	Conditions []VaultAppRoleCondition `json:"conditions,omitempty"`
+}
+
+// VaultAppRoleCondition describes the state of a VaultAppRole at a certain point.
+type VaultAppRoleCondition struct {
+	// Type of VaultAppRole condition.
+	Type string `json:"type,omitempty"`
+
+	// Status of the condition, one of True, False, Unknown.
+	Status core.ConditionStatus `json:"status,omitempty"`
+
+	// The reason for the condition's.
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
@@ -16,6 +16,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
 )
@@ -45,6 +50,65 @@ func (d AWSAccessKeyRequest) CustomResourceDefinition() *apiextensions.CustomRes
 	})
 }
 
+const (
+	// AWS STS limits the duration of the STS credentials, the federation tokens
+	// last up to AWSMaxSTSTTL and the assumed role sessions up to AWSMaxAssumedRoleTTL
+	AWSMinSTSTTL         = 15 * time.Minute
+	AWSMaxSTSTTL         = 36 * time.Hour
+	AWSMaxAssumedRoleTTL = 12 * time.Hour
+
+	AWSDefaultProfileName = "default"
+)
+
+// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html#API_AssumeRole_RequestParameters
+var roleSessionNameRegex = regexp.MustCompile(`^[\w+=,.@-]{2,64}$`)
+
 func (d AWSAccessKeyRequest) IsValid() error {
+	if d.Spec.TTL != "" {
+		if _, err := time.ParseDuration(d.Spec.TTL); err != nil {
+			return fmt.Errorf("invalid ttl %s: %v", d.Spec.TTL, err)
+		}
+	}
+	if d.Spec.RoleSessionName != "" && !roleSessionNameRegex.MatchString(d.Spec.RoleSessionName) {
+		return fmt.Errorf("invalid roleSessionName %s, it must be 2 to 64 characters long and contain only alphanumeric characters or any of +=,.@-_", d.Spec.RoleSessionName)
+	}
+	if strings.ContainsAny(d.Spec.ProfileName, "[]\r\n") {
+		return fmt.Errorf("invalid profileName %s", d.Spec.ProfileName)
+	}
+	return validateSecretTemplate(d.Spec.Delivery, d.Spec.SecretTemplate)
+}
+
+// IsValidFor checks the ttl against the limits of AWS STS for the credential type of the role.
+// The ttl is only used for the STS credentials, so it is not checked for iam_user.
+func (d AWSAccessKeyRequest) IsValidFor(credentialType AWSCredentialType) error {
+	if d.Spec.TTL == "" {
+		return nil
+	}
+
+	var maxTTL time.Duration
+	switch credentialType {
+	case AWSCredentialAssumedRole:
+		maxTTL = AWSMaxAssumedRoleTTL
+	case AWSCredentialFederationToken:
+		maxTTL = AWSMaxSTSTTL
+	default:
+		return nil
+	}
+
+	ttl, err := time.ParseDuration(d.Spec.TTL)
+	if err != nil {
+		return fmt.Errorf("invalid ttl %s: %v", d.Spec.TTL, err)
+	}
+	if ttl < AWSMinSTSTTL || ttl > maxTTL {
+		return fmt.Errorf("ttl %s is out of the bounds of AWS STS for %s, it must be between %s and %s", d.Spec.TTL, credentialType, AWSMinSTSTTL, maxTTL)
+	}
 	return nil
 }
+
+// ProfileName returns the name of the profile in the shared credentials file
+func (d AWSAccessKeyRequest) ProfileName() string {
+	if d.Spec.ProfileName == "" {
+		return AWSDefaultProfileName
+	}
+	return d.Spec.ProfileName
+}
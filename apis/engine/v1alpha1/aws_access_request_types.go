@@ -61,12 +61,53 @@ type AWSAccessKeyRequestSpec struct {
 	// the default_sts_ttl set for the role will be used. If that is also not set, then the default value of
 	// 3600s will be used. AWS places limits on the maximum TTL allowed. See the AWS documentation on the
 	// DurationSeconds parameter for AssumeRole (for assumed_role credential types) and
-	// GetFederationToken (for federation_token credential types) for more details.
+	// GetFederationToken (for federation_token credential types) for more details, i.e. the ttl
+	// must be between 15m and 12h for assumed_role, and between 15m and 36h for federation_token.
 	TTL string `json:"ttl,omitempty"`
 
 	// If true, '/aws/sts' endpoint will be used to retrieve credential
 	// Otherwise, '/aws/creds' endpoint will be used to retrieve credential
 	UseSTS bool `json:"useSTS,omitempty"`
+
+	// The role session name to attach to the assumed role ARN.
+	// Valid only when credential_type is assumed_role. If not specified,
+	// a role session name is generated by vault.
+	// +optional
+	RoleSessionName string `json:"roleSessionName,omitempty"`
+
+	// Name of the profile in the shared credentials file, which is stored
+	// in the secret along with the credential. Defaults to default.
+	// +optional
+	ProfileName string `json:"profileName,omitempty"`
+
+	// Specifies how the credential is delivered.
+	// If wrapped, only the single-use response-wrapping token of the
+	// credential is stored in the secret. Defaults to plain.
+	// +optional
+	Delivery CredentialDelivery `json:"delivery,omitempty"`
+
+	// Specifies the TTL of the response-wrapping token, i.e. 10m.
+	// Used only if delivery is wrapped. Defaults to 10m.
+	// +optional
+	WrapTTL string `json:"wrapTTL,omitempty"`
+
+	// Map of the keys of the secret to Go templates, which are rendered
+	// over the credential and added to the secret.
+	// The built-in templates postgres-uri, mysql-dsn, jdbc-url, aws-credentials
+	// and gcp-adc can be used, i.e. {{ template "postgres-uri" . }}.
+	// It can not be used, if delivery is wrapped.
+	// +optional
+	SecretTemplate map[string]string `json:"secretTemplate,omitempty"`
+
+	// Specifies the secret where the credential is stored. If the secret is not specified,
+	// a secret with a generated name is created.
+	// +optional
+	SecretRef *CredentialSecretRef `json:"secretRef,omitempty"`
+
+	// Specifies the workloads to roll out, whenever the credential is issued or rotated.
+	// The pod templates of the workloads are annotated with the hash of the credential.
+	// +optional
+	RestartTargets []RestartTarget `json:"restartTargets,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -90,6 +131,15 @@ type AWSAccessKeyRequestStatus struct {
 
 	// Contains lease info
 	Lease *Lease `json:"lease,omitempty"`
+
+	// Contains the response-wrapping token info, if the credential is wrapped
+	// +optional
+	Wrapping *WrappingStatus `json:"wrapping,omitempty"`
+
+	// Time when the STS credential expires. As the STS credential has no
+	// renewable lease, it is reissued in the secret before it expires.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 type AWSAccessKeyRequestCondition struct {
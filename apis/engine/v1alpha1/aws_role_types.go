@@ -95,6 +95,14 @@ type AWSRoleSpec struct {
 	// The max allowed TTL for STS credentials (credentials TTL are capped to max_sts_ttl).
 	// Valid only when credential_type is one of assumed_role or federation_token
 	MaxSTSTTL string `json:"maxSTSTTL,omitempty"`
+
+	// Specifies what happens to the issued credentials on deletion.
+	// RevokeLeases revokes their leases, Retain keeps them until their TTL runs out
+	// and Block refuses deletion while any of them has a live lease.
+	// Defaults to Retain.
+	// +optional
+	// +kubebuilder:validation:Enum=RevokeLeases;Retain;Block
+	RevocationPolicy RevocationPolicy `json:"revocationPolicy,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -109,8 +117,14 @@ type AWSRoleList struct {
 }
 
 const (
-	AWSCredentialAccessKeyKey = "access_key"
-	AWSCredentialSecretKeyKey = "secret_key"
+	AWSCredentialAccessKeyKey     = "access_key"
+	AWSCredentialSecretKeyKey     = "secret_key"
+	AWSCredentialSecurityTokenKey = "security_token"
+
+	// Key of the shared credentials file (~/.aws/credentials) in the secret
+	AWSCredentialsFileKey = "credentials"
+	// Key of the credential_process compatible JSON in the secret
+	AWSCredentialProcessKey = "credential_process.json"
 )
 
 type AWSRolePhase string
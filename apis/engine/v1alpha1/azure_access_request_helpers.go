@@ -48,5 +48,5 @@ func (d AzureAccessKeyRequest) CustomResourceDefinition() *apiextensions.CustomR
 }
 
 func (d AzureAccessKeyRequest) IsValid() error {
-	return nil
+	return validateSecretTemplate(d.Spec.Delivery, d.Spec.SecretTemplate)
 }
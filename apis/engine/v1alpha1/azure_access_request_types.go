@@ -57,6 +57,35 @@ type AzureAccessKeyRequestSpec struct {
 	// Contains a reference to the object or user identities the role binding is applied to
 	// +required
 	Subjects []rbac.Subject `json:"subjects"`
+
+	// Specifies how the credential is delivered.
+	// If wrapped, only the single-use response-wrapping token of the
+	// credential is stored in the secret. Defaults to plain.
+	// +optional
+	Delivery CredentialDelivery `json:"delivery,omitempty"`
+
+	// Specifies the TTL of the response-wrapping token, i.e. 10m.
+	// Used only if delivery is wrapped. Defaults to 10m.
+	// +optional
+	WrapTTL string `json:"wrapTTL,omitempty"`
+
+	// Map of the keys of the secret to Go templates, which are rendered
+	// over the credential and added to the secret.
+	// The built-in templates postgres-uri, mysql-dsn, jdbc-url, aws-credentials
+	// and gcp-adc can be used, i.e. {{ template "postgres-uri" . }}.
+	// It can not be used, if delivery is wrapped.
+	// +optional
+	SecretTemplate map[string]string `json:"secretTemplate,omitempty"`
+
+	// Specifies the secret where the credential is stored. If the secret is not specified,
+	// a secret with a generated name is created.
+	// +optional
+	SecretRef *CredentialSecretRef `json:"secretRef,omitempty"`
+
+	// Specifies the workloads to roll out, whenever the credential is issued or rotated.
+	// The pod templates of the workloads are annotated with the hash of the credential.
+	// +optional
+	RestartTargets []RestartTarget `json:"restartTargets,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -80,6 +109,10 @@ type AzureAccessKeyRequestStatus struct {
 
 	// Contains lease info
 	Lease *Lease `json:"lease,omitempty"`
+
+	// Contains the response-wrapping token info, if the credential is wrapped
+	// +optional
+	Wrapping *WrappingStatus `json:"wrapping,omitempty"`
 }
 
 type AzureAccessKeyRequestCondition struct {
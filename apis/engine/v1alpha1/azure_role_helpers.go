@@ -16,6 +16,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
@@ -64,5 +66,27 @@ func (r AzureRole) CustomResourceDefinition() *apiextensions.CustomResourceDefin
 }
 
 func (r AzureRole) IsValid() error {
+	spec := r.Spec
+	if spec.AzureRoles == "" && spec.AzureGroups == "" && spec.ApplicationObjectID == "" {
+		return errors.New("one of azureRoles, azureGroups or applicationObjectID is required")
+	}
+	if err := isJSONArray(spec.AzureRoles); err != nil {
+		return fmt.Errorf("invalid azureRoles: %v", err)
+	}
+	if err := isJSONArray(spec.AzureGroups); err != nil {
+		return fmt.Errorf("invalid azureGroups: %v", err)
+	}
+	if spec.ApplicationObjectID != "" && spec.PermanentlyDelete != nil && *spec.PermanentlyDelete {
+		return errors.New("permanentlyDelete must be false for an existing service principal, as it is not created by vault")
+	}
 	return nil
 }
+
+// isJSONArray returns an error, if the non-empty string is not a JSON array
+func isJSONArray(s string) error {
+	if s == "" {
+		return nil
+	}
+	var v []interface{}
+	return json.Unmarshal([]byte(s), &v)
+}
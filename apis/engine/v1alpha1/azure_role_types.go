@@ -66,11 +66,26 @@ type AzureRoleSpec struct {
 	// The array must be in JSON format, properly escaped as a string
 	AzureRoles string `json:"azureRoles,omitempty"`
 
+	// List of Azure groups that the generated service principal will be assigned to.
+	// The array must be in JSON format, properly escaped as a string,
+	// i.e. [{"group_name": "foo"}]
+	// +optional
+	AzureGroups string `json:"azureGroups,omitempty"`
+
 	// Application Object ID for an existing service principal
 	// that will be used instead of creating dynamic service principals.
-	// If present, azure_roles will be ignored.
+	// A new client secret is issued on the existing application for each
+	// credential and removed on revocation.
+	// If present, azure_roles and azure_groups will be ignored.
 	ApplicationObjectID string `json:"applicationObjectID,omitempty"`
 
+	// Specifies whether to permanently delete the applications and the service
+	// principals, that are dynamically created by vault, on revocation.
+	// Must be false, if applicationObjectID is present.
+	// If not set, the default of vault is used.
+	// +optional
+	PermanentlyDelete *bool `json:"permanentlyDelete,omitempty"`
+
 	// Specifies the default TTL for service principals generated using this role.
 	// Accepts time suffixed strings ("1h") or an integer number of seconds.
 	// Defaults to the system/engine default TTL time.
@@ -80,6 +95,14 @@ type AzureRoleSpec struct {
 	// generated using this role. Accepts time suffixed strings ("1h")
 	// or an integer number of seconds. Defaults to the system/engine max TTL time.
 	MaxTTL string `json:"maxTTL,omitempty"`
+
+	// Specifies what happens to the issued credentials on deletion.
+	// RevokeLeases revokes their leases, Retain keeps them until their TTL runs out
+	// and Block refuses deletion while any of them has a live lease.
+	// Defaults to Retain.
+	// +optional
+	// +kubebuilder:validation:Enum=RevokeLeases;Retain;Block
+	RevocationPolicy RevocationPolicy `json:"revocationPolicy,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
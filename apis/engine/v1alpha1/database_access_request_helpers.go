@@ -46,5 +46,5 @@ func (d DatabaseAccessRequest) CustomResourceDefinition() *apiextensions.CustomR
 }
 
 func (d DatabaseAccessRequest) IsValid() error {
-	return nil
+	return validateSecretTemplate(d.Spec.Delivery, d.Spec.SecretTemplate)
 }
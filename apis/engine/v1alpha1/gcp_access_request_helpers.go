@@ -48,5 +48,5 @@ func (d GCPAccessKeyRequest) CustomResourceDefinition() *apiextensions.CustomRes
 }
 
 func (d GCPAccessKeyRequest) IsValid() error {
-	return nil
+	return validateSecretTemplate(d.Spec.Delivery, d.Spec.SecretTemplate)
 }
@@ -66,6 +66,37 @@ type GCPAccessKeyRequestSpec struct {
 	// Accepted values: TYPE_UNSPECIFIED, TYPE_PKCS12_FILE, TYPE_GOOGLE_CREDENTIALS_FILE
 	// +optional
 	KeyType string `json:"keyType,omitempty"`
+
+	// Specifies how the credential is delivered.
+	// If wrapped, only the single-use response-wrapping token of the
+	// credential is stored in the secret. Defaults to plain.
+	// +optional
+	Delivery CredentialDelivery `json:"delivery,omitempty"`
+
+	// Specifies the TTL of the response-wrapping token, i.e. 10m.
+	// Used only if delivery is wrapped. Defaults to 10m.
+	// +optional
+	WrapTTL string `json:"wrapTTL,omitempty"`
+
+	// Map of the keys of the secret to Go templates, which are rendered
+	// over the credential and added to the secret.
+	// The built-in templates postgres-uri, mysql-dsn, jdbc-url, aws-credentials
+	// and gcp-adc can be used, i.e. {{ template "postgres-uri" . }}.
+	// It can not be used, if delivery is wrapped.
+	// +optional
+	SecretTemplate map[string]string `json:"secretTemplate,omitempty"`
+
+	// Specifies the secret where the credential is stored. If the secret is not specified,
+	// a secret with a generated name is created.
+	// +optional
+	SecretRef *CredentialSecretRef `json:"secretRef,omitempty"`
+
+	// Specifies the workloads to roll out, whenever the credential is issued or rotated.
+	// The pod templates of the workloads are annotated with the hash of the credential.
+	// An access token is refreshed in place before it expires, the workloads are rolled out
+	// only once onto it and the mounted secret volumes are updated by the kubelet.
+	// +optional
+	RestartTargets []RestartTarget `json:"restartTargets,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -89,6 +120,15 @@ type GCPAccessKeyRequestStatus struct {
 
 	// Contains lease info
 	Lease *Lease `json:"lease,omitempty"`
+
+	// Contains the response-wrapping token info, if the credential is wrapped
+	// +optional
+	Wrapping *WrappingStatus `json:"wrapping,omitempty"`
+
+	// Time when the access token expires. As the access token has no
+	// renewable lease, it is refreshed in the secret before it expires.
+	// +optional
+	TokenExpiresAt *metav1.Time `json:"tokenExpiresAt,omitempty"`
 }
 
 type GCPAccessKeyRequestCondition struct {
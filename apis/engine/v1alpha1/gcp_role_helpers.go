@@ -16,6 +16,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
 
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
@@ -64,5 +65,39 @@ func (r GCPRole) CustomResourceDefinition() *apiextensions.CustomResourceDefinit
 }
 
 func (r GCPRole) IsValid() error {
+	spec := r.Spec
+	switch spec.Type {
+	case "", GCPRoleTypeRoleset:
+		if spec.Project == "" || spec.Bindings == "" {
+			return errors.New("project and bindings are required for a roleset")
+		}
+	case GCPRoleTypeStaticAccount:
+		if spec.ServiceAccountEmail == "" {
+			return errors.New("serviceAccountEmail is required for a static-account")
+		}
+	case GCPRoleTypeImpersonatedAccount:
+		if spec.ServiceAccountEmail == "" {
+			return errors.New("serviceAccountEmail is required for an impersonated-account")
+		}
+		if spec.SecretType != GCPSecretAccessToken {
+			return fmt.Errorf("secretType of an impersonated-account must be %s", GCPSecretAccessToken)
+		}
+		if spec.Bindings != "" {
+			return errors.New("bindings are not supported for an impersonated-account")
+		}
+	default:
+		return fmt.Errorf("unknown type %s", spec.Type)
+	}
+	if spec.TTL != "" && spec.Type != GCPRoleTypeImpersonatedAccount {
+		return errors.New("ttl is supported only for an impersonated-account")
+	}
 	return nil
 }
+
+// RoleType returns the type of the role, defaults to roleset
+func (r GCPRole) RoleType() GCPRoleType {
+	if r.Spec.Type == "" {
+		return GCPRoleTypeRoleset
+	}
+	return r.Spec.Type
+}
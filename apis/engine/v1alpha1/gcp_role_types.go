@@ -48,6 +48,19 @@ const (
 	GCPSecretServiceAccountKey GCPSecretType = "service_account_key"
 )
 
+// GCPRoleType specifies how the credentials of a GCPRole are issued
+type GCPRoleType string
+
+const (
+	// A roleset creates a service account, bound to the given bindings, for the role
+	GCPRoleTypeRoleset GCPRoleType = "roleset"
+	// A static account issues credentials of an existing service account
+	GCPRoleTypeStaticAccount GCPRoleType = "static-account"
+	// An impersonated account issues access tokens of an existing service account
+	// by impersonating it, without creating any service account key
+	GCPRoleTypeImpersonatedAccount GCPRoleType = "impersonated-account"
+)
+
 // GCPRoleSpec contains connection information, GCP role info, etc
 // More info: https://www.vaultproject.io/api/secret/gcp/index.html#parameters
 type GCPRoleSpec struct {
@@ -60,21 +73,48 @@ type GCPRoleSpec struct {
 	// +optional
 	Path string `json:"path,omitempty"`
 
-	// Specifies the type of secret generated for this role set
+	// Specifies the type of the role in vault: roleset, static-account or impersonated-account.
+	// Defaults to roleset. Cannot be updated.
+	// More info: https://www.vaultproject.io/api-docs/secret/gcp
+	// +optional
+	// +kubebuilder:validation:Enum=roleset;static-account;impersonated-account
+	Type GCPRoleType `json:"type,omitempty"`
+
+	// Specifies the type of secret generated for this role set.
+	// An impersonated-account supports only access_token.
 	SecretType GCPSecretType `json:"secretType"`
 
 	// Name of the GCP project that this roleset's service account will belong to.
-	// Cannot be updated.
-	Project string `json:"project"`
+	// Required for a roleset. Cannot be updated.
+	// +optional
+	Project string `json:"project,omitempty"`
 
 	// Bindings configuration string (expects HCL or JSON format in raw
-	// or base64-encoded string)
-	Bindings string `json:"bindings"`
+	// or base64-encoded string). Required for a roleset, optional for a static-account.
+	// +optional
+	Bindings string `json:"bindings,omitempty"`
+
+	// Email of the existing service account, whose credentials are issued
+	// by a static-account or an impersonated-account. Cannot be updated.
+	// +optional
+	ServiceAccountEmail string `json:"serviceAccountEmail,omitempty"`
+
+	// Specifies the TTL of the access tokens issued by an impersonated-account, i.e. 1h
+	// +optional
+	TTL string `json:"ttl,omitempty"`
 
 	// List of OAuth scopes to assign to access_token secrets generated
 	// under this role set (access_token role sets only)
 	// +optional
 	TokenScopes []string `json:"tokenScopes,omitempty"`
+
+	// Specifies what happens to the issued credentials on deletion.
+	// RevokeLeases revokes their leases, Retain keeps them until their TTL runs out
+	// and Block refuses deletion while any of them has a live lease.
+	// Defaults to Retain.
+	// +optional
+	// +kubebuilder:validation:Enum=RevokeLeases;Retain;Block
+	RevocationPolicy RevocationPolicy `json:"revocationPolicy,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
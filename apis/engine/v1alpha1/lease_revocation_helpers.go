@@ -0,0 +1,94 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	"errors"
+	"strings"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+)
+
+func (d LeaseRevocation) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourceLeaseRevocations,
+		Singular:      ResourceLeaseRevocation,
+		Kind:          ResourceKindLeaseRevocation,
+		Categories:    []string{"vault", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{
+				"app": "vault",
+			},
+		},
+		SpecDefinitionName:      "kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocation",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Status",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+		},
+	})
+}
+
+func (d LeaseRevocation) IsValid() error {
+	scope := d.Spec.Scope
+	n := 0
+	if scope.RoleRef != nil {
+		n++
+		if scope.RoleRef.Kind == "" {
+			return errors.New("spec.scope.roleRef.kind is missing")
+		}
+		if scope.RoleRef.Namespace != "" && scope.RoleRef.Namespace != d.Namespace {
+			return errors.New("spec.scope.roleRef.namespace must be the namespace of the LeaseRevocation")
+		}
+	}
+	if scope.SecretEngineRef != nil {
+		n++
+	}
+	if scope.Namespace != "" {
+		n++
+		if scope.Namespace != d.Namespace {
+			return errors.New("spec.scope.namespace must be the namespace of the LeaseRevocation")
+		}
+	}
+	if scope.Prefix != "" {
+		n++
+		if strings.Trim(scope.Prefix, "/") == "" {
+			return errors.New("spec.scope.prefix must not be the root of all leases")
+		}
+		if d.Spec.VaultRef.Name == "" {
+			return errors.New("spec.vaultRef is required for spec.scope.prefix")
+		}
+	}
+	if n != 1 {
+		return errors.New("exactly one of roleRef, secretEngineRef, namespace and prefix must be set in spec.scope")
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindLeaseRevocation = "LeaseRevocation"
+	ResourceLeaseRevocation     = "leaserevocation"
+	ResourceLeaseRevocations    = "leaserevocations"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=leaserevocations,singular=leaserevocation,categories={vault,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+type LeaseRevocation struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LeaseRevocationSpec   `json:"spec,omitempty"`
+	Status            LeaseRevocationStatus `json:"status,omitempty"`
+}
+
+// Link:
+//  - https://www.vaultproject.io/api/system/leases.html#revoke-prefix
+//  - https://www.vaultproject.io/api/system/leases.html#revoke-force
+
+// LeaseRevocationSpec contains information to revoke leases in bulk
+type LeaseRevocationSpec struct {
+	// Specifies the leases to revoke
+	// +required
+	Scope LeaseRevocationScope `json:"scope"`
+
+	// VaultRef is the name of a AppBinding referencing to a Vault Server.
+	// Required, if the scope is a lease prefix.
+	// +optional
+	VaultRef core.LocalObjectReference `json:"vaultRef,omitempty"`
+
+	// Specifies whether to revoke the leases with revoke-force,
+	// ignoring the errors of the secret engine while revoking them
+	// +optional
+	Force bool `json:"force,omitempty"`
+}
+
+// LeaseRevocationScope specifies the leases to revoke.
+// Exactly one of the fields must be set.
+type LeaseRevocationScope struct {
+	// Revokes the leases issued against the role.
+	// The role must be in the namespace of the LeaseRevocation.
+	// +optional
+	RoleRef *RoleRef `json:"roleRef,omitempty"`
+
+	// Revokes the leases issued by the SecretEngine in the namespace of the LeaseRevocation
+	// +optional
+	SecretEngineRef *core.LocalObjectReference `json:"secretEngineRef,omitempty"`
+
+	// Revokes the leases of the access requests in the namespace.
+	// It must be the namespace of the LeaseRevocation.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Revokes the leases under the raw lease prefix, i.e. database/creds/my-role.
+	// The root prefix is not allowed.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+type LeaseRevocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of LeaseRevocation objects
+	Items []LeaseRevocation `json:"items,omitempty"`
+}
+
+type LeaseRevocationPhase string
+
+const (
+	LeaseRevocationPhaseSucceeded LeaseRevocationPhase = "Succeeded"
+	LeaseRevocationPhaseFailed    LeaseRevocationPhase = "Failed"
+)
+
+type LeaseRevocationStatus struct {
+	Phase LeaseRevocationPhase `json:"phase,omitempty"`
+
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The time when the leases were revoked last
+	// +optional
+	RevocationTime *metav1.Time `json:"revocationTime,omitempty"`
+
+	// Number of lease prefixes revoked
+	// +optional
+	Revoked int32 `json:"revoked,omitempty"`
+
+	// Number of lease prefixes failed to revoke
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// Number of access requests marked as Revoked
+	// +optional
+	RevokedRequests int32 `json:"revokedRequests,omitempty"`
+
+	// Lease prefixes failed to revoke, with the reasons
+	// +optional
+	Failures []LeaseRevocationFailure `json:"failures,omitempty"`
+}
+
+// LeaseRevocationFailure contains the reason why a lease prefix failed to revoke
+type LeaseRevocationFailure struct {
+	// Lease prefix or access request, which failed
+	Target string `json:"target"`
+
+	// human readable message with details about the failure
+	// +optional
+	Message string `json:"message,omitempty"`
+}
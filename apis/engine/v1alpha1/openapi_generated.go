@@ -356,6 +356,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevault.dev/operator/apis/engine/v1alpha1.AWSRoleList":                     schema_operator_apis_engine_v1alpha1_AWSRoleList(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.AWSRoleSpec":                     schema_operator_apis_engine_v1alpha1_AWSRoleSpec(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.AWSRoleStatus":                   schema_operator_apis_engine_v1alpha1_AWSRoleStatus(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequest":          schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequest(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestCondition": schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestCondition(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestList":      schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestList(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestSpec":      schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestSpec(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestStatus":    schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestStatus(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequest":           schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequest(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestCondition":  schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestCondition(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestList":       schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestList(ref),
@@ -367,6 +372,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleList":                   schema_operator_apis_engine_v1alpha1_AzureRoleList(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleSpec":                   schema_operator_apis_engine_v1alpha1_AzureRoleSpec(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleStatus":                 schema_operator_apis_engine_v1alpha1_AzureRoleStatus(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef":             schema_operator_apis_engine_v1alpha1_CredentialSecretRef(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequest":           schema_operator_apis_engine_v1alpha1_DatabaseAccessRequest(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestCondition":  schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestCondition(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestList":       schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestList(ref),
@@ -385,6 +391,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleStatus":                   schema_operator_apis_engine_v1alpha1_GCPRoleStatus(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.Lease":                           schema_operator_apis_engine_v1alpha1_Lease(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.LeaseConfig":                     schema_operator_apis_engine_v1alpha1_LeaseConfig(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocation":                 schema_operator_apis_engine_v1alpha1_LeaseRevocation(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationFailure":          schema_operator_apis_engine_v1alpha1_LeaseRevocationFailure(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationList":             schema_operator_apis_engine_v1alpha1_LeaseRevocationList(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationScope":            schema_operator_apis_engine_v1alpha1_LeaseRevocationScope(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationSpec":             schema_operator_apis_engine_v1alpha1_LeaseRevocationSpec(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationStatus":           schema_operator_apis_engine_v1alpha1_LeaseRevocationStatus(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration":            schema_operator_apis_engine_v1alpha1_MongoDBConfiguration(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRole":                     schema_operator_apis_engine_v1alpha1_MongoDBRole(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleCondition":            schema_operator_apis_engine_v1alpha1_MongoDBRoleCondition(ref),
@@ -403,13 +415,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleList":                schema_operator_apis_engine_v1alpha1_PostgresRoleList(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleSpec":                schema_operator_apis_engine_v1alpha1_PostgresRoleSpec(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleStatus":              schema_operator_apis_engine_v1alpha1_PostgresRoleStatus(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget":                   schema_operator_apis_engine_v1alpha1_RestartTarget(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.RoleRef":                         schema_operator_apis_engine_v1alpha1_RoleRef(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngine":                    schema_operator_apis_engine_v1alpha1_SecretEngine(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineCondition":           schema_operator_apis_engine_v1alpha1_SecretEngineCondition(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineConfiguration":       schema_operator_apis_engine_v1alpha1_SecretEngineConfiguration(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineList":                schema_operator_apis_engine_v1alpha1_SecretEngineList(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineMountStatus":         schema_operator_apis_engine_v1alpha1_SecretEngineMountStatus(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineSpec":                schema_operator_apis_engine_v1alpha1_SecretEngineSpec(ref),
 		"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineStatus":              schema_operator_apis_engine_v1alpha1_SecretEngineStatus(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRole":                    schema_operator_apis_engine_v1alpha1_VaultAppRole(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleCondition":           schema_operator_apis_engine_v1alpha1_VaultAppRoleCondition(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleList":                schema_operator_apis_engine_v1alpha1_VaultAppRoleList(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleSpec":                schema_operator_apis_engine_v1alpha1_VaultAppRoleSpec(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleStatus":              schema_operator_apis_engine_v1alpha1_VaultAppRoleStatus(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequest":               schema_operator_apis_engine_v1alpha1_VaultTokenRequest(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestCondition":      schema_operator_apis_engine_v1alpha1_VaultTokenRequestCondition(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestList":           schema_operator_apis_engine_v1alpha1_VaultTokenRequestList(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestSpec":           schema_operator_apis_engine_v1alpha1_VaultTokenRequestSpec(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestStatus":         schema_operator_apis_engine_v1alpha1_VaultTokenRequestStatus(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRole":                  schema_operator_apis_engine_v1alpha1_VaultTokenRole(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleCondition":         schema_operator_apis_engine_v1alpha1_VaultTokenRoleCondition(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleList":              schema_operator_apis_engine_v1alpha1_VaultTokenRoleList(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleSpec":              schema_operator_apis_engine_v1alpha1_VaultTokenRoleSpec(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleStatus":            schema_operator_apis_engine_v1alpha1_VaultTokenRoleStatus(ref),
+		"kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus":                  schema_operator_apis_engine_v1alpha1_WrappingStatus(ref),
 	}
 }
 
@@ -15865,7 +15895,7 @@ func schema_operator_apis_engine_v1alpha1_AWSAccessKeyRequestSpec(ref common.Ref
 					},
 					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the TTL for the use of the STS token. This is specified as a string with a duration suffix. Valid only when credential_type is assumed_role or federation_token. When not specified, the default_sts_ttl set for the role will be used. If that is also not set, then the default value of 3600s will be used. AWS places limits on the maximum TTL allowed. See the AWS documentation on the DurationSeconds parameter for AssumeRole (for assumed_role credential types) and GetFederationToken (for federation_token credential types) for more details.",
+							Description: "Specifies the TTL for the use of the STS token. This is specified as a string with a duration suffix. Valid only when credential_type is assumed_role or federation_token. When not specified, the default_sts_ttl set for the role will be used. If that is also not set, then the default value of 3600s will be used. AWS places limits on the maximum TTL allowed. See the AWS documentation on the DurationSeconds parameter for AssumeRole (for assumed_role credential types) and GetFederationToken (for federation_token credential types) for more details, i.e. the ttl must be between 15m and 12h for assumed_role, and between 15m and 36h for federation_token.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -15877,12 +15907,74 @@ func schema_operator_apis_engine_v1alpha1_AWSAccessKeyRequestSpec(ref common.Ref
 							Format:      "",
 						},
 					},
+					"roleSessionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The role session name to attach to the assumed role ARN. Valid only when credential_type is assumed_role. If not specified, a role session name is generated by vault.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"profileName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the profile in the shared credentials file, which is stored in the secret along with the credential. Defaults to default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"delivery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies how the credential is delivered. If wrapped, only the single-use response-wrapping token of the credential is stored in the secret. Defaults to plain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wrapTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL of the response-wrapping token, i.e. 10m. Used only if delivery is wrapped. Defaults to 10m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map of the keys of the secret to Go templates, which are rendered over the credential and added to the secret. The built-in templates postgres-uri, mysql-dsn, jdbc-url, aws-credentials and gcp-adc can be used, i.e. {{ template \"postgres-uri\" . }}. It can not be used, if delivery is wrapped.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the secret where the credential is stored. If the secret is not specified, a secret with a generated name is created.",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef"),
+						},
+					},
+					"restartTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the workloads to roll out, whenever the credential is issued or rotated. The pod templates of the workloads are annotated with the hash of the credential.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"roleRef", "subjects"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
+			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef", "kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
 	}
 }
 
@@ -15917,11 +16009,23 @@ func schema_operator_apis_engine_v1alpha1_AWSAccessKeyRequestStatus(ref common.R
 							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.Lease"),
 						},
 					},
+					"wrapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains the response-wrapping token info, if the credential is wrapped",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"),
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time when the STS credential expires. As the STS credential has no renewable lease, it is reissued in the secret before it expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.AWSAccessKeyRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease"},
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevault.dev/operator/apis/engine/v1alpha1.AWSAccessKeyRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease", "kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"},
 	}
 }
 
@@ -16193,6 +16297,13 @@ func schema_operator_apis_engine_v1alpha1_AWSRoleSpec(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"revocationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies what happens to the issued credentials on deletion. RevokeLeases revokes their leases, Retain keeps them until their TTL runs out and Block refuses deletion while any of them has a live lease. Defaults to Retain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"vaultRef", "credentialType"},
 			},
@@ -16242,7 +16353,7 @@ func schema_operator_apis_engine_v1alpha1_AWSRoleStatus(ref common.ReferenceCall
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16269,23 +16380,23 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequest(ref common.Refer
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16327,7 +16438,7 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestCondition(ref com
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16354,12 +16465,12 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestList(ref common.R
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of AzureAccessKeyRequest objects",
+							Description: "Items is a list of AppRoleSecretIDRequest objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequest"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequest"),
 									},
 								},
 							},
@@ -16369,19 +16480,20 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestList(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequest"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequest"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AppRoleSecretIDRequestSpec contains information to request for a secret_id of a VaultAppRole",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"roleRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains vault azure role info",
+							Description: "Contains vault AppRole info",
 							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"),
 						},
 					},
@@ -16398,6 +16510,56 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestSpec(ref common.R
 							},
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata to be tied to the secret_id",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"cidrList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of CIDR blocks enforcing secret_id to be used from specific set of IP addresses",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of CIDR blocks; if set, specifies blocks of IP addresses which can use the tokens issued using this secret_id",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"wrapTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the secret_id is response-wrapped with the given TTL, i.e. 10m and only the wrapping token is stored in the secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"roleRef", "subjects"},
 			},
@@ -16407,7 +16569,7 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestSpec(ref common.R
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AppRoleSecretIDRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16420,7 +16582,7 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestStatus(ref common
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestCondition"),
 									},
 								},
 							},
@@ -16428,53 +16590,26 @@ func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestStatus(ref common
 					},
 					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the secret containing AzureCredential",
+							Description: "Name of the secret containing role_id and secret_id",
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"lease": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Contains lease info",
-							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.Lease"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease"},
-	}
-}
-
-func schema_operator_apis_engine_v1alpha1_AzureConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AzureConfiguration contains information to communicate with Azure",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"credentialSecret": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the secret name containing Azure credentials secret.Data:\n\t- subscription-id: <value>, The subscription id for the Azure Active Directory.\n\t- tenant-id: <value>, The tenant id for the Azure Active Directory.\n\t- client-id: <value>, The OAuth2 client id to connect to Azure.\n\t- client-secret: <value>, The OAuth2 client secret to connect to Azure.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"environment": {
+					"secretIDAccessor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Azure environment. If not specified, Vault will use Azure Public Cloud.",
+							Description: "Accessor of the issued secret_id",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"credentialSecret"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.AppRoleSecretIDRequestCondition"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16501,64 +16636,65 @@ func schema_operator_apis_engine_v1alpha1_AzureRole(ref common.ReferenceCallback
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureRoleCondition describes the state of a AzureRole at a certain point.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of AzureRole condition.",
+							Description: "request approval state, currently Approved or Denied.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
+							Description: "brief reason for the request state",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's.",
+							Description: "human readable message with details about the request state",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "timestamp for the last update to this condition",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16585,12 +16721,12 @@ func schema_operator_apis_engine_v1alpha1_AzureRoleList(ref common.ReferenceCall
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of AzureRole objects",
+							Description: "Items is a list of AzureAccessKeyRequest objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRole"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequest"),
 									},
 								},
 							},
@@ -16600,108 +16736,193 @@ func schema_operator_apis_engine_v1alpha1_AzureRoleList(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureRole"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequest"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureRoleSpec contains connection information, Azure role info, etc More info: https://www.vaultproject.io/api/secret/azure/index.html#create-update-role",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vaultRef": {
+					"roleRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Contains vault azure role info",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"),
 						},
 					},
-					"path": {
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path defines the path of the Azure secret engine default: azure More info: https://www.vaultproject.io/docs/auth/azure.html#via-the-cli",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Contains a reference to the object or user identities the role binding is applied to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/rbac/v1.Subject"),
+									},
+								},
+							},
 						},
 					},
-					"azureRoles": {
+					"delivery": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of Azure roles to be assigned to the generated service principal. The array must be in JSON format, properly escaped as a string",
+							Description: "Specifies how the credential is delivered. If wrapped, only the single-use response-wrapping token of the credential is stored in the secret. Defaults to plain.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"applicationObjectID": {
+					"wrapTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Application Object ID for an existing service principal that will be used instead of creating dynamic service principals. If present, azure_roles will be ignored.",
+							Description: "Specifies the TTL of the response-wrapping token, i.e. 10m. Used only if delivery is wrapped. Defaults to 10m.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ttl": {
+					"secretTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the default TTL for service principals generated using this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to the system/engine default TTL time.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Map of the keys of the secret to Go templates, which are rendered over the credential and added to the secret. The built-in templates postgres-uri, mysql-dsn, jdbc-url, aws-credentials and gcp-adc can be used, i.e. {{ template \"postgres-uri\" . }}. It can not be used, if delivery is wrapped.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"maxTTL": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum TTL for service principals generated using this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to the system/engine max TTL time.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Specifies the secret where the credential is stored. If the secret is not specified, a secret with a generated name is created.",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef"),
+						},
+					},
+					"restartTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the workloads to roll out, whenever the credential is issued or rotated. The pod templates of the workloads are annotated with the hash of the credential.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"vaultRef"},
+				Required: []string{"roleRef", "subjects"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference"},
+			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef", "kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_AzureRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureAccessKeyRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this AzureRole. It corresponds to the AzureRole's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Represents the latest available observations of a AzureRole current state.",
+							Description: "Conditions applied to the request, such as approval or denial.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestCondition"),
 									},
 								},
 							},
 						},
 					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the secret containing AzureCredential",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"lease": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains lease info",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.Lease"),
+						},
+					},
+					"wrapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains the response-wrapping token info, if the credential is wrapped",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleCondition"},
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.AzureAccessKeyRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease", "kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureConfiguration contains information to communicate with Azure",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"credentialSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the secret name containing Azure credentials secret.Data:\n\t- subscription-id: <value>, The subscription id for the Azure Active Directory.\n\t- tenant-id: <value>, The tenant id for the Azure Active Directory.\n\t- client-id: <value>, The OAuth2 client id to connect to Azure.\n\t- client-secret: <value>, The OAuth2 client secret to connect to Azure.\nIf the secret is not specified, subscriptionID and tenantID are required and vault connects to Azure with the managed identity of its host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subscriptionID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The subscription id for the Azure Active Directory. Takes precedence over the subscription-id of the credential secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The tenant id for the Azure Active Directory. Takes precedence over the tenant-id of the credential secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The OAuth2 client id to connect to Azure. Takes precedence over the client-id of the credential secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rootPasswordTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies how long the client secret of the configuration is valid, before vault rotates it, i.e. 4380h. If not specified, vault uses its default of 6 months.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"environment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Azure environment. If not specified, Vault will use Azure Public Cloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_AzureRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16728,65 +16949,64 @@ func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequest(ref common.Refer
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AzureRoleCondition describes the state of a AzureRole at a certain point.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "request approval state, currently Approved or Denied.",
+							Description: "Type of AzureRole condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "brief reason for the request state",
+							Description: "Status of the condition, one of True, False, Unknown.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "human readable message with details about the request state",
+							Description: "The reason for the condition's.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "timestamp for the last update to this condition",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16813,12 +17033,12 @@ func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestList(ref common.R
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of DatabaseAccessRequest objects",
+							Description: "Items is a list of AzureRole objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequest"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRole"),
 									},
 								},
 							},
@@ -16828,91 +17048,157 @@ func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestList(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequest"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.AzureRole"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatabaseAccessRequestSpec contains information to request for database credential",
+				Description: "AzureRoleSpec contains connection information, Azure role info, etc More info: https://www.vaultproject.io/api/secret/azure/index.html#create-update-role",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"roleRef": {
+					"vaultRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains vault database role info",
-							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"),
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"subjects": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/rbac/v1.Subject"),
-									},
-								},
-							},
+							Description: "Path defines the path of the Azure secret engine default: azure More info: https://www.vaultproject.io/docs/auth/azure.html#via-the-cli",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"azureRoles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of Azure roles to be assigned to the generated service principal. The array must be in JSON format, properly escaped as a string",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"azureGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of Azure groups that the generated service principal will be assigned to. The array must be in JSON format, properly escaped as a string, i.e. [{\"group_name\": \"foo\"}]",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"applicationObjectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Application Object ID for an existing service principal that will be used instead of creating dynamic service principals. A new client secret is issued on the existing application for each credential and removed on revocation. If present, azure_roles and azure_groups will be ignored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"permanentlyDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies whether to permanently delete the applications and the service principals, that are dynamically created by vault, on revocation. Must be false, if applicationObjectID is present. If not set, the default of vault is used.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to roles default TTL time",
+							Description: "Specifies the default TTL for service principals generated using this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to the system/engine default TTL time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum TTL for service principals generated using this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to the system/engine max TTL time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revocationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies what happens to the issued credentials on deletion. RevokeLeases revokes their leases, Retain keeps them until their TTL runs out and Block refuses deletion while any of them has a live lease. Defaults to Retain.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"roleRef", "subjects"},
+				Required: []string{"vaultRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_AzureRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this AzureRole. It corresponds to the AzureRole's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions applied to the request, such as approval or denial.",
+							Description: "Represents the latest available observations of a AzureRole current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleCondition"),
 									},
 								},
 							},
 						},
 					},
-					"secret": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/engine/v1alpha1.AzureRoleCondition"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_CredentialSecretRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CredentialSecretRef specifies the secret where the credential of an access request is stored",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the secret containing database credentials",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Name of the secret in the namespace of the access request",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lease": {
+					"adopt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains lease info",
-							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.Lease"),
+							Description: "Specifies whether an existing secret, that is not controlled by any other object, is adopted by the access request. Otherwise, the request fails if the secret already exists. Only Opaque secrets are adopted, unless the secret is annotated with engine.kubevault.com/adoptable: \"true\". An adopted secret is deleted along with the access request.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16939,23 +17225,23 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequest(ref common.Referen
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -16997,7 +17283,7 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestCondition(ref commo
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17024,12 +17310,12 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestList(ref common.Ref
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of GCPAccessKeyRequest objects",
+							Description: "Items is a list of DatabaseAccessRequest objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequest"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequest"),
 									},
 								},
 							},
@@ -17039,27 +17325,26 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestList(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequest"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequest"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPAccessKeyRequestSpec contains information to request for vault gcp credentials",
+				Description: "DatabaseAccessRequestSpec contains information to request for database credential",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"roleRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains vault gcp role info",
+							Description: "Contains vault database role info",
 							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"),
 						},
 					},
 					"subjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains a reference to the object or user identities the role binding is applied to",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -17069,30 +17354,71 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestSpec(ref common.Ref
 							},
 						},
 					},
-					"keyAlgorithm": {
+					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the algorithm used to generate key. Defaults to 2k RSA key. Accepted values: KEY_ALG_UNSPECIFIED, KEY_ALG_RSA_1024, KEY_ALG_RSA_2048",
+							Description: "Specifies the TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to roles default TTL time",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"keyType": {
+					"delivery": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the private key type to generate. Defaults to JSON credentials file Accepted values: TYPE_UNSPECIFIED, TYPE_PKCS12_FILE, TYPE_GOOGLE_CREDENTIALS_FILE",
+							Description: "Specifies how the credential is delivered. If wrapped, only the single-use response-wrapping token of the credential is stored in the secret. Defaults to plain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wrapTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL of the response-wrapping token, i.e. 10m. Used only if delivery is wrapped. Defaults to 10m.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"secretTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map of the keys of the secret to Go templates, which are rendered over the credential and the connection info of the database and added to the secret. The built-in templates postgres-uri, mysql-dsn, jdbc-url, aws-credentials and gcp-adc can be used, i.e. {{ template \"postgres-uri\" . }}. It can not be used, if delivery is wrapped.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the secret where the credential is stored. If the secret is not specified, a secret with a generated name is created.",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef"),
+						},
+					},
+					"restartTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the workloads to roll out, whenever the credential is issued or rotated. The pod templates of the workloads are annotated with the hash of the credential.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"roleRef", "subjects"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
+			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef", "kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_DatabaseAccessRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17105,7 +17431,7 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestStatus(ref common.R
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestCondition"),
 									},
 								},
 							},
@@ -17113,7 +17439,7 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestStatus(ref common.R
 					},
 					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the secret containing GCPCredential",
+							Description: "Name of the secret containing database credentials",
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
@@ -17123,50 +17449,21 @@ func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestStatus(ref common.R
 							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.Lease"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease"},
-	}
-}
-
-func schema_operator_apis_engine_v1alpha1_GCPConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "https://www.vaultproject.io/api/secret/gcp/index.html#write-config GCPConfiguration contains information to communicate with GCP",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"credentialSecret": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the secret containing GCP credentials secret.Data:\n\t- sa.json",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"ttl": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies default config TTL for long-lived credentials (i.e. service account keys).",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"maxTTL": {
+					"wrapping": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum config TTL for long-lived credentials (i.e. service account keys).",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Contains the response-wrapping token info, if the credential is wrapped",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"),
 						},
 					},
 				},
-				Required: []string{"credentialSecret"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.DatabaseAccessRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease", "kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17193,64 +17490,65 @@ func schema_operator_apis_engine_v1alpha1_GCPRole(ref common.ReferenceCallback)
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPRoleCondition describes the state of a GCPRole at a certain point.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of GCPRole condition.",
+							Description: "request approval state, currently Approved or Denied.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
+							Description: "brief reason for the request state",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's.",
+							Description: "human readable message with details about the request state",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "timestamp for the last update to this condition",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17277,12 +17575,12 @@ func schema_operator_apis_engine_v1alpha1_GCPRoleList(ref common.ReferenceCallba
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of GCPRole objects",
+							Description: "Items is a list of GCPAccessKeyRequest objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRole"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequest"),
 									},
 								},
 							},
@@ -17292,56 +17590,70 @@ func schema_operator_apis_engine_v1alpha1_GCPRoleList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPRole"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequest"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPRoleSpec contains connection information, GCP role info, etc More info: https://www.vaultproject.io/api/secret/gcp/index.html#parameters",
+				Description: "GCPAccessKeyRequestSpec contains information to request for vault gcp credentials",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vaultRef": {
+					"roleRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Contains vault gcp role info",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"),
 						},
 					},
-					"path": {
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path defines the path of the Google Cloud secret engine default: gcp More info: https://www.vaultproject.io/docs/auth/gcp.html#via-the-cli-helper",
+							Description: "Contains a reference to the object or user identities the role binding is applied to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/rbac/v1.Subject"),
+									},
+								},
+							},
+						},
+					},
+					"keyAlgorithm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the algorithm used to generate key. Defaults to 2k RSA key. Accepted values: KEY_ALG_UNSPECIFIED, KEY_ALG_RSA_1024, KEY_ALG_RSA_2048",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretType": {
+					"keyType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the type of secret generated for this role set",
+							Description: "Specifies the private key type to generate. Defaults to JSON credentials file Accepted values: TYPE_UNSPECIFIED, TYPE_PKCS12_FILE, TYPE_GOOGLE_CREDENTIALS_FILE",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"project": {
+					"delivery": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the GCP project that this roleset's service account will belong to. Cannot be updated.",
+							Description: "Specifies how the credential is delivered. If wrapped, only the single-use response-wrapping token of the credential is stored in the secret. Defaults to plain.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"bindings": {
+					"wrapTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Bindings configuration string (expects HCL or JSON format in raw or base64-encoded string)",
+							Description: "Specifies the TTL of the response-wrapping token, i.e. 10m. Used only if delivery is wrapped. Defaults to 10m.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tokenScopes": {
+					"secretTemplate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of OAuth scopes to assign to access_token secrets generated under this role set (access_token role sets only)",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Map of the keys of the secret to Go templates, which are rendered over the credential and added to the secret. The built-in templates postgres-uri, mysql-dsn, jdbc-url, aws-credentials and gcp-adc can be used, i.e. {{ template \"postgres-uri\" . }}. It can not be used, if delivery is wrapped.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -17351,184 +17663,136 @@ func schema_operator_apis_engine_v1alpha1_GCPRoleSpec(ref common.ReferenceCallba
 							},
 						},
 					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the secret where the credential is stored. If the secret is not specified, a secret with a generated name is created.",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef"),
+						},
+					},
+					"restartTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the workloads to roll out, whenever the credential is issued or rotated. The pod templates of the workloads are annotated with the hash of the credential. An access token is refreshed in place before it expires, the workloads are rolled out only once onto it and the mounted secret volumes are updated by the kubelet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"vaultRef", "secretType", "project", "bindings"},
+				Required: []string{"roleRef", "subjects"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference"},
+			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.CredentialSecretRef", "kubevault.dev/operator/apis/engine/v1alpha1.RestartTarget", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_GCPRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPAccessKeyRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this GCPRole. It corresponds to the GCPRole's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents the latest available observations of a GCPRole current state.",
+							Description: "Conditions applied to the request, such as approval or denial.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestCondition"),
 									},
 								},
 							},
 						},
 					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the secret containing GCPCredential",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"lease": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains lease info",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.Lease"),
+						},
+					},
+					"wrapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains the response-wrapping token info, if the credential is wrapped",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"),
+						},
+					},
+					"tokenExpiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time when the access token expires. As the access token has no renewable lease, it is refreshed in the secret before it expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleCondition"},
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevault.dev/operator/apis/engine/v1alpha1.GCPAccessKeyRequestCondition", "kubevault.dev/operator/apis/engine/v1alpha1.Lease", "kubevault.dev/operator/apis/engine/v1alpha1.WrappingStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_Lease(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Lease contains lease info",
+				Description: "https://www.vaultproject.io/api/secret/gcp/index.html#write-config GCPConfiguration contains information to communicate with GCP",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"credentialSecret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "lease id",
+							Description: "Specifies the secret containing GCP credentials secret.Data:\n\t- sa.json",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"duration": {
+					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "lease duration",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Specifies default config TTL for long-lived credentials (i.e. service account keys).",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"renewable": {
+					"maxTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies whether this lease is renewable",
-							Type:        []string{"boolean"},
+							Description: "Specifies the maximum config TTL for long-lived credentials (i.e. service account keys).",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"credentialSecret"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_LeaseConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "https://www.vaultproject.io/api/secret/aws/index.html#configure-lease LeaseConfig contains lease configuration",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"lease": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the lease value provided as a string duration with time suffix. \"h\" (hour) is the largest suffix.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"leaseMax": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum lease value provided as a string duration with time suffix. \"h\" (hour) is the largest suffix",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"lease", "leaseMax"},
-			},
-		},
-	}
-}
-
-func schema_operator_apis_engine_v1alpha1_MongoDBConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MongoDBConfiguration defines a MongoDB app configuration. https://www.vaultproject.io/api/secret/databases/index.html https://www.vaultproject.io/api/secret/databases/mongodb.html#configure-connection",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"databaseRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the database appbinding reference",
-							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
-						},
-					},
-					"pluginName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the name of the plugin to use for this connection. Default plugin:\n - for mongodb: mongodb-database-plugin",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"allowedRoles": {
-						SchemaProps: spec.SchemaProps{
-							Description: "List of the roles allowed to use this connection. Defaults to empty (no roles), if contains a \"*\" any role can use this connection.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"writeConcern": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the MongoDB write concern. This is set for the entirety of the session, maintained for the lifecycle of the plugin process.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"databaseRef"},
-			},
-		},
-		Dependencies: []string{
-			"kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
-	}
-}
-
-func schema_operator_apis_engine_v1alpha1_MongoDBRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -17540,32 +17804,32 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRole(ref common.ReferenceCallba
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MongoDBRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MongoDBRoleCondition describes the state of a MongoDBRole at a certain point.",
+				Description: "GCPRoleCondition describes the state of a GCPRole at a certain point.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of MongoDBRole condition.",
+							Description: "Type of GCPRole condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -17597,7 +17861,7 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRoleCondition(ref common.Refere
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MongoDBRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17624,12 +17888,12 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRoleList(ref common.ReferenceCa
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of MongoDBRole objects",
+							Description: "Items is a list of GCPRole objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRole"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRole"),
 									},
 								},
 							},
@@ -17639,15 +17903,15 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRoleList(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRole"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.GCPRole"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MongoDBRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MongoDBRoleSpec contains connection information, Mongodb role info etc",
+				Description: "GCPRoleSpec contains connection information, GCP role info, etc More info: https://www.vaultproject.io/api/secret/gcp/index.html#parameters",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"vaultRef": {
@@ -17656,57 +17920,58 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRoleSpec(ref common.ReferenceCa
 							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"databaseRef": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DatabaseRef specifies the database appbinding reference in any namespace",
-							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
+							Description: "Path defines the path of the Google Cloud secret engine default: gcp More info: https://www.vaultproject.io/docs/auth/gcp.html#via-the-cli-helper",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"databaseName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the database name under which the role will be created",
+							Description: "Specifies the type of the role in vault: roleset, static-account or impersonated-account. Defaults to roleset. Cannot be updated. More info: https://www.vaultproject.io/api-docs/secret/gcp",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"secretType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the path where secret engine is enabled",
+							Description: "Specifies the type of secret generated for this role set. An impersonated-account supports only access_token.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"defaultTTL": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time",
+							Description: "Name of the GCP project that this roleset's service account will belong to. Required for a roleset. Cannot be updated.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"maxTTL": {
+					"bindings": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time.",
+							Description: "Bindings configuration string (expects HCL or JSON format in raw or base64-encoded string). Required for a roleset, optional for a static-account.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"creationStatements": {
+					"serviceAccountEmail": {
 						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/Mongodb-maria.html#creation_statements Specifies the database statements executed to create and configure a user.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Email of the existing service account, whose credentials are issued by a static-account or an impersonated-account. Cannot be updated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"revocationStatements": {
+					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/Mongodb-maria.html#revocation_statements Specifies the database statements to be executed to revoke a user.",
+							Description: "Specifies the TTL of the access tokens issued by an impersonated-account, i.e. 1h",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenScopes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of OAuth scopes to assign to access_token secrets generated under this role set (access_token role sets only)",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -17718,16 +17983,23 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRoleSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"revocationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies what happens to the issued credentials on deletion. RevokeLeases revokes their leases, Retain keeps them until their TTL runs out and Block refuses deletion while any of them has a live lease. Defaults to Retain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"vaultRef", "creationStatements"},
+				Required: []string{"vaultRef", "secretType"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MongoDBRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_GCPRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17741,19 +18013,19 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRoleStatus(ref common.Reference
 					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this MongoDBRole. It corresponds to the MongoDBRole's generation, which is updated on mutation by the API Server.",
+							Description: "ObservedGeneration is the most recent generation observed for this GCPRole. It corresponds to the GCPRole's generation, which is updated on mutation by the API Server.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents the latest available observations of a MongoDBRole current state.",
+							Description: "Represents the latest available observations of a GCPRole current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleCondition"),
 									},
 								},
 							},
@@ -17763,75 +18035,74 @@ func schema_operator_apis_engine_v1alpha1_MongoDBRoleStatus(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleCondition"},
+			"kubevault.dev/operator/apis/engine/v1alpha1.GCPRoleCondition"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MySQLConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_Lease(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MySQLConfiguration defines a MySQL app configuration. https://www.vaultproject.io/api/secret/databases/index.html https://www.vaultproject.io/api/secret/databases/mysql-maria.html#configure-connection",
+				Description: "Lease contains lease info",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"databaseRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DatabaseRef refers to a MySQL/MariaDB database AppBinding in any namespace",
-							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
-						},
-					},
-					"pluginName": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the name of the plugin to use for this connection. Default plugin:\n - for mysql: mysql-database-plugin",
+							Description: "lease id",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"allowedRoles": {
+					"duration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of the roles allowed to use this connection. Defaults to empty (no roles), if contains a \"*\" any role can use this connection.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "lease duration",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"maxOpenConnections": {
+					"renewable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum number of open connections to the database.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Specifies whether this lease is renewable",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"maxIdleConnections": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_LeaseConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "https://www.vaultproject.io/api/secret/aws/index.html#configure-lease LeaseConfig contains lease configuration",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lease": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum number of idle connections to the database. A zero uses the value of max_open_connections and a negative value disables idle connections. If larger than max_open_connections it will be reduced to be equal.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Specifies the lease value provided as a string duration with time suffix. \"h\" (hour) is the largest suffix.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxConnectionLifetime": {
+					"leaseMax": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum amount of time a connection may be reused. If <= 0s connections are reused forever.",
+							Description: "Specifies the maximum lease value provided as a string duration with time suffix. \"h\" (hour) is the largest suffix",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"databaseRef"},
+				Required: []string{"lease", "leaseMax"},
 			},
 		},
-		Dependencies: []string{
-			"kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MySQLRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_LeaseRevocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17858,64 +18129,51 @@ func schema_operator_apis_engine_v1alpha1_MySQLRole(ref common.ReferenceCallback
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationSpec", "kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MySQLRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_LeaseRevocationFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MySQLRoleCondition describes the state of a MySQLRole at a certain point.",
+				Description: "LeaseRevocationFailure contains the reason why a lease prefix failed to revoke",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type of MySQLRole condition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"reason": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's.",
+							Description: "Lease prefix or access request, which failed",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "human readable message with details about the failure",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"target"},
 			},
 		},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MySQLRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_LeaseRevocationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -17942,12 +18200,12 @@ func schema_operator_apis_engine_v1alpha1_MySQLRoleList(ref common.ReferenceCall
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of MySQLRole objects",
+							Description: "Items is a list of LeaseRevocation objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRole"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocation"),
 									},
 								},
 							},
@@ -17957,95 +18215,87 @@ func schema_operator_apis_engine_v1alpha1_MySQLRoleList(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLRole"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocation"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MySQLRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_LeaseRevocationScope(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MySQLRoleSpec contains connection information, mysql role info etc",
+				Description: "LeaseRevocationScope specifies the leases to revoke. Exactly one of the fields must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vaultRef": {
+					"roleRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
-						},
-					},
-					"databaseRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DatabaseRef specifies the database appbinding reference in any namespace.",
-							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
+							Description: "Revokes the leases issued against the role. The role must be in the namespace of the LeaseRevocation.",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"),
 						},
 					},
-					"databaseName": {
+					"secretEngineRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the database name under which the role will be created",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Revokes the leases issued by the SecretEngine in the namespace of the LeaseRevocation",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"path": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the path where secret engine is enabled",
+							Description: "Revokes the leases of the access requests in the namespace. It must be the namespace of the LeaseRevocation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"defaultTTL": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time",
+							Description: "Revokes the leases under the raw lease prefix, i.e. database/creds/my-role. The root prefix is not allowed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"maxTTL": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_LeaseRevocationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LeaseRevocationSpec contains information to revoke leases in bulk",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Specifies the leases to revoke",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationScope"),
 						},
 					},
-					"creationStatements": {
+					"vaultRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/mysql-maria.html#creation_statements Specifies the database statements executed to create and configure a user.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server. Required, if the scope is a lease prefix.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"revocationStatements": {
+					"force": {
 						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/mysql-maria.html#revocation_statements Specifies the database statements to be executed to revoke a user.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Specifies whether to revoke the leases with revoke-force, ignoring the errors of the secret engine while revoking them",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"vaultRef", "creationStatements"},
+				Required: []string{"scope"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationScope"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_MySQLRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_LeaseRevocationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18059,19 +18309,45 @@ func schema_operator_apis_engine_v1alpha1_MySQLRoleStatus(ref common.ReferenceCa
 					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this MySQLRole. It corresponds to the MySQLRole's generation, which is updated on mutation by the API Server.",
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"revocationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time when the leases were revoked last",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"revoked": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of lease prefixes revoked",
 							Type:        []string{"integer"},
-							Format:      "int64",
+							Format:      "int32",
 						},
 					},
-					"conditions": {
+					"failed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents the latest available observations of a MySQLRole current state.",
+							Description: "Number of lease prefixes failed to revoke",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"revokedRequests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of access requests marked as Revoked",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failures": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Lease prefixes failed to revoke, with the reasons",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationFailure"),
 									},
 								},
 							},
@@ -18081,26 +18357,26 @@ func schema_operator_apis_engine_v1alpha1_MySQLRoleStatus(ref common.ReferenceCa
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleCondition"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevault.dev/operator/apis/engine/v1alpha1.LeaseRevocationFailure"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_PostgresConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MongoDBConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PostgresConfiguration defines a PostgreSQL app configuration. https://www.vaultproject.io/api/secret/databases/index.html https://www.vaultproject.io/api/secret/databases/postgresql.html#configure-connection",
+				Description: "MongoDBConfiguration defines a MongoDB app configuration. https://www.vaultproject.io/api/secret/databases/index.html https://www.vaultproject.io/api/secret/databases/mongodb.html#configure-connection",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"databaseRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the Postgres database appbinding reference",
+							Description: "Specifies the database appbinding reference",
 							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
 						},
 					},
 					"pluginName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the name of the plugin to use for this connection. Default plugin:\n\t- for postgres: postgresql-database-plugin",
+							Description: "Specifies the name of the plugin to use for this connection. Default plugin:\n - for mongodb: mongodb-database-plugin",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -18119,23 +18395,9 @@ func schema_operator_apis_engine_v1alpha1_PostgresConfiguration(ref common.Refer
 							},
 						},
 					},
-					"maxOpenConnections": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum number of open connections to the database.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxIdleConnections": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum number of idle connections to the database. A zero uses the value of max_open_connections and a negative value disables idle connections. If larger than max_open_connections it will be reduced to be equal.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxConnectionLifetime": {
+					"writeConcern": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum amount of time a connection may be reused. If <= 0s connections are reused forever.",
+							Description: "Specifies the MongoDB write concern. This is set for the entirety of the session, maintained for the lifecycle of the plugin process.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -18149,7 +18411,7 @@ func schema_operator_apis_engine_v1alpha1_PostgresConfiguration(ref common.Refer
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_PostgresRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MongoDBRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18176,32 +18438,32 @@ func schema_operator_apis_engine_v1alpha1_PostgresRole(ref common.ReferenceCallb
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleStatus"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_PostgresRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MongoDBRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PostgresRoleCondition describes the state of a PostgresRole at a certain point.",
+				Description: "MongoDBRoleCondition describes the state of a MongoDBRole at a certain point.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of PostgresRole condition.",
+							Description: "Type of MongoDBRole condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -18233,7 +18495,7 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleCondition(ref common.Refer
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_PostgresRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MongoDBRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18260,12 +18522,12 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleList(ref common.ReferenceC
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is a list of PostgresRole objects",
+							Description: "Items is a list of MongoDBRole objects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRole"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRole"),
 									},
 								},
 							},
@@ -18275,15 +18537,15 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresRole"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRole"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_PostgresRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MongoDBRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PostgresRoleSpec contains connection information, postgres role info etc",
+				Description: "MongoDBRoleSpec contains connection information, Mongodb role info etc",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"vaultRef": {
@@ -18328,7 +18590,7 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleSpec(ref common.ReferenceC
 					},
 					"creationStatements": {
 						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#creation_statements Specifies the database statements executed to create and configure a user.",
+							Description: "https://www.vaultproject.io/api/secret/databases/Mongodb-maria.html#creation_statements Specifies the database statements executed to create and configure a user.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -18342,21 +18604,7 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleSpec(ref common.ReferenceC
 					},
 					"revocationStatements": {
 						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#revocation_statements Specifies the database statements to be executed to revoke a user.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"rollbackStatements": {
-						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#rollback_statements Specifies the database statements to be executed rollback a create operation in the event of an error.",
+							Description: "https://www.vaultproject.io/api/secret/databases/Mongodb-maria.html#revocation_statements Specifies the database statements to be executed to revoke a user.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -18368,18 +18616,11 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleSpec(ref common.ReferenceC
 							},
 						},
 					},
-					"renewStatements": {
+					"revocationPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#renew_statements Specifies the database statements to be executed to renew a user.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Specifies what happens to the issued credentials on deletion. RevokeLeases revokes their leases, Retain keeps them until their TTL runs out and Block refuses deletion while any of them has a live lease. Defaults to Retain.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -18391,34 +18632,33 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleSpec(ref common.ReferenceC
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_PostgresRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MongoDBRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"observedGeneration": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this PostgresROle. It corresponds to the PostgresROle's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"phase": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the phase of the PostgresRole",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this MongoDBRole. It corresponds to the MongoDBRole's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Represents the latest available observations of a PostgresRoleBinding current state.",
+							Description: "Represents the latest available observations of a MongoDBRole current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleCondition"),
 									},
 								},
 							},
@@ -18428,53 +18668,75 @@ func schema_operator_apis_engine_v1alpha1_PostgresRoleStatus(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleCondition"},
+			"kubevault.dev/operator/apis/engine/v1alpha1.MongoDBRoleCondition"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_RoleRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MySQLConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RoleRef contains information that points to the role being used",
+				Description: "MySQLConfiguration defines a MySQL app configuration. https://www.vaultproject.io/api/secret/databases/index.html https://www.vaultproject.io/api/secret/databases/mysql-maria.html#configure-connection",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiGroup": {
+					"databaseRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIGroup is the group for the resource being referenced",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DatabaseRef refers to a MySQL/MariaDB database AppBinding in any namespace",
+							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
 						},
 					},
-					"kind": {
+					"pluginName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is the type of resource being referenced",
+							Description: "Specifies the name of the plugin to use for this connection. Default plugin:\n - for mysql: mysql-database-plugin",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"allowedRoles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of resource being referenced",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "List of the roles allowed to use this connection. Defaults to empty (no roles), if contains a \"*\" any role can use this connection.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"namespace": {
+					"maxOpenConnections": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of the resource being referenced",
+							Description: "Specifies the maximum number of open connections to the database.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxIdleConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum number of idle connections to the database. A zero uses the value of max_open_connections and a negative value disables idle connections. If larger than max_open_connections it will be reduced to be equal.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxConnectionLifetime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum amount of time a connection may be reused. If <= 0s connections are reused forever.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "namespace"},
+				Required: []string{"databaseRef"},
 			},
 		},
+		Dependencies: []string{
+			"kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_SecretEngine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_MySQLRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18501,19 +18763,712 @@ func schema_operator_apis_engine_v1alpha1_SecretEngine(ref common.ReferenceCallb
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineSpec"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineStatus"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineSpec", "kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleStatus"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_MySQLRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MySQLRoleCondition describes the state of a MySQLRole at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of MySQLRole condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_MySQLRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is a list of MySQLRole objects",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRole"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLRole"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_MySQLRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MySQLRoleSpec contains connection information, mysql role info etc",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"databaseRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DatabaseRef specifies the database appbinding reference in any namespace.",
+							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
+						},
+					},
+					"databaseName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the database name under which the role will be created",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where secret engine is enabled",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationStatements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "https://www.vaultproject.io/api/secret/databases/mysql-maria.html#creation_statements Specifies the database statements executed to create and configure a user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"revocationStatements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "https://www.vaultproject.io/api/secret/databases/mysql-maria.html#revocation_statements Specifies the database statements to be executed to revoke a user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"revocationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies what happens to the issued credentials on deletion. RevokeLeases revokes their leases, Retain keeps them until their TTL runs out and Block refuses deletion while any of them has a live lease. Defaults to Retain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"vaultRef", "creationStatements"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_MySQLRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this MySQLRole. It corresponds to the MySQLRole's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a MySQLRole current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/engine/v1alpha1.MySQLRoleCondition"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_PostgresConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostgresConfiguration defines a PostgreSQL app configuration. https://www.vaultproject.io/api/secret/databases/index.html https://www.vaultproject.io/api/secret/databases/postgresql.html#configure-connection",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"databaseRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the Postgres database appbinding reference",
+							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
+						},
+					},
+					"pluginName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the name of the plugin to use for this connection. Default plugin:\n\t- for postgres: postgresql-database-plugin",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowedRoles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of the roles allowed to use this connection. Defaults to empty (no roles), if contains a \"*\" any role can use this connection.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"maxOpenConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum number of open connections to the database.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxIdleConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum number of idle connections to the database. A zero uses the value of max_open_connections and a negative value disables idle connections. If larger than max_open_connections it will be reduced to be equal.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxConnectionLifetime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum amount of time a connection may be reused. If <= 0s connections are reused forever.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"databaseRef"},
+			},
+		},
+		Dependencies: []string{
+			"kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_PostgresRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleStatus"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_PostgresRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostgresRoleCondition describes the state of a PostgresRole at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of PostgresRole condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_PostgresRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is a list of PostgresRole objects",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRole"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresRole"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_PostgresRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PostgresRoleSpec contains connection information, postgres role info etc",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"databaseRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DatabaseRef specifies the database appbinding reference in any namespace",
+							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
+						},
+					},
+					"databaseName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the database name under which the role will be created",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where secret engine is enabled",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum TTL for the leases associated with this role. Accepts time suffixed strings (\"1h\") or an integer number of seconds. Defaults to system/engine default TTL time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationStatements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#creation_statements Specifies the database statements executed to create and configure a user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"revocationStatements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#revocation_statements Specifies the database statements to be executed to revoke a user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"rollbackStatements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#rollback_statements Specifies the database statements to be executed rollback a create operation in the event of an error.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"renewStatements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "https://www.vaultproject.io/api/secret/databases/postgresql.html#renew_statements Specifies the database statements to be executed to renew a user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"revocationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies what happens to the issued credentials on deletion. RevokeLeases revokes their leases, Retain keeps them until their TTL runs out and Block refuses deletion while any of them has a live lease. Defaults to Retain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"vaultRef", "creationStatements"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_PostgresRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this PostgresROle. It corresponds to the PostgresROle's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the phase of the PostgresRole",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a PostgresRoleBinding current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/engine/v1alpha1.PostgresRoleCondition"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_RestartTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RestartTarget specifies the workloads that are rolled out, whenever the credential of an access request is issued or rotated",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the workloads: Deployment, StatefulSet or DaemonSet",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the workload in the namespace of the access request",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selects the workloads in the namespace of the access request by labels",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"kind"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_RoleRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RoleRef contains information that points to the role being used",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIGroup is the group for the resource being referenced",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the type of resource being referenced",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of resource being referenced",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the resource being referenced",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "namespace"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_SecretEngine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineSpec", "kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineStatus"},
 	}
 }
 
@@ -18523,83 +19478,1085 @@ func schema_operator_apis_engine_v1alpha1_SecretEngineCondition(ref common.Refer
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_SecretEngineConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"aws": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration"),
+						},
+					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration"),
+						},
+					},
+					"gcp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration"),
+						},
+					},
+					"postgres": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"),
+						},
+					},
+					"mongodb": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration"),
+						},
+					},
+					"mysql": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_SecretEngineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngine"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.SecretEngine"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_SecretEngineMountStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretEngineMountStatus contains the mount info of a secret engine",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"accessor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"defaultLeaseTTL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"maxLeaseTTL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sealWrap": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"local": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"auditNonHMACRequestKeys": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"auditNonHMACResponseKeys": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"pluginVersion": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"runningPluginVersion": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_SecretEngineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path defines the path used to enable this secret engine. If it is changed, the secret engine is moved to the new path. Vault revokes the leases of the old path, and the roles and access requests referring to the old path are not updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the human-friendly description of the mount",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultLeaseTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the default lease duration of the mount, i.e. 1h. Defaults to the system default.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxLeaseTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum lease duration of the mount, i.e. 24h. Defaults to the system maximum.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sealWrap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enables seal wrapping for the mount. It can only be set when the secret engine is enabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"local": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies that the mount is local to this vault cluster and is not replicated. It can only be set when the secret engine is enabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"auditNonHMACRequestKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of keys that will not be HMAC'd by audit devices in the request data object",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"auditNonHMACResponseKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of keys that will not be HMAC'd by audit devices in the response data object",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies mount type specific options that are passed to the backend",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"pluginVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the semantic version of the plugin to use, i.e. v1.0.0. The running version is kept, if it is cleared.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revocationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies what happens to the issued credentials on deletion. RevokeLeases revokes their leases before the secret engine is disabled, Retain leaves them to Vault, which revokes them when the secret engine is disabled, and Block refuses deletion while any of them has a live lease. Defaults to Retain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"aws": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration"),
+						},
+					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration"),
+						},
+					},
+					"gcp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration"),
+						},
+					},
+					"postgres": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"),
+						},
+					},
+					"mongodb": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration"),
+						},
+					},
+					"mysql": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration"),
+						},
+					},
+				},
+				Required: []string{"vaultRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_SecretEngineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineCondition"),
+									},
+								},
+							},
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path where the secret engine is enabled",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains the mount info of the secret engine reported by vault",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineMountStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineCondition", "kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineMountStatus"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultAppRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleStatus"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultAppRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VaultAppRoleCondition describes the state of a VaultAppRole at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of VaultAppRole condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultAppRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is a list of VaultAppRole objects",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRole"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRole"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultAppRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VaultAppRoleSpec contains connection information, AppRole info, etc More info: https://www.vaultproject.io/api/auth/approle/index.html#create-update-approle",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path defines the path where the AppRole auth method is enabled default: approle",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of policies to encode onto tokens issued for this role",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"bindSecretID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Require secret_id to be presented when logging in using this AppRole. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretIDBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of CIDR blocks; if set, specifies blocks of IP addresses which can perform the login operation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secretIDNumUses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of times any particular secret_id can be used to fetch a token from this AppRole. A value of zero allows unlimited uses.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"secretIDTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration after which any secret_id expires, i.e. 10m, 1h",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of CIDR blocks; if set, specifies blocks of IP addresses which can use the tokens issued for this role",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tokenTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The incremental lifetime for generated tokens",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenMaxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum lifetime for generated tokens",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenNumUses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of times a generated token may be used. A value of zero allows unlimited uses.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"tokenPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, generated tokens will be periodic with the given period",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"vaultRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultAppRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this VaultAppRole. It corresponds to the VaultAppRole's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"roleID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleID is the role_id of the AppRole in vault",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a VaultAppRole current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/engine/v1alpha1.VaultAppRoleCondition"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultTokenRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestSpec", "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestStatus"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultTokenRequestCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "request approval state, currently Approved or Denied.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "brief reason for the request state",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "human readable message with details about the request state",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timestamp for the last update to this condition",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultTokenRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is a list of VaultTokenRequest objects",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequest"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequest"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultTokenRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VaultTokenRequestSpec contains information to request for a token of a VaultTokenRole",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"roleRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains vault token role info",
+							Ref:         ref("kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"),
+						},
+					},
+					"subjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains a reference to the object or user identities the role binding is applied to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/rbac/v1.Subject"),
+									},
+								},
+							},
+						},
+					},
+					"policies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of policies of the token. It must be a subset of the allowed policies of the role. If empty, the token gets the allowed policies of the role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata to be tied to the token",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The TTL period of the token, i.e. 1h. Ignored for periodic tokens.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"numUses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum uses of the token. A value of zero allows unlimited uses.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"roleRef", "subjects"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/rbac/v1.Subject", "kubevault.dev/operator/apis/engine/v1alpha1.RoleRef"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultTokenRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Conditions applied to the request, such as approval or denial.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestCondition"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Name of the secret containing the token",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"reason": {
+					"accessor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's.",
+							Description: "Accessor of the issued token",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"renewable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
+							Description: "Specifies whether the token is renewed by the operator. The operator reconciles the request to renew the token, when two thirds of its TTL are passed.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"leaseDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The TTL of the token in seconds, when it was issued or renewed last",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time when the token expires, unless it is renewed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequestCondition"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_SecretEngineConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_VaultTokenRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"aws": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"azure": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"gcp": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"postgres": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleSpec"),
 						},
 					},
-					"mongodb": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration"),
+							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleStatus"),
 						},
 					},
-					"mysql": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleSpec", "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleStatus"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_VaultTokenRoleCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VaultTokenRoleCondition describes the state of a VaultTokenRole at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration"),
+							Description: "Type of VaultTokenRole condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_SecretEngineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_VaultTokenRoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18626,11 +20583,12 @@ func schema_operator_apis_engine_v1alpha1_SecretEngineList(ref common.ReferenceC
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Items is a list of VaultTokenRole objects",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngine"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRole"),
 									},
 								},
 							},
@@ -18640,56 +20598,98 @@ func schema_operator_apis_engine_v1alpha1_SecretEngineList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.SecretEngine"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRole"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_SecretEngineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_VaultTokenRoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VaultTokenRoleSpec contains connection information, token role info, etc The tokens created against the role are always orphan tokens, otherwise they would be revoked along with the short lived token of the operator, that created them. More info: https://www.vaultproject.io/api/auth/token/index.html#create-update-token-role",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"vaultRef": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"path": {
+					"allowedPolicies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path defines the path used to enable this secret engine",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "List of policies that tokens created with this role may have. If empty, tokens may have any policies the creating token has.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"aws": {
+					"disallowedPolicies": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration"),
+							Description: "List of policies that tokens created with this role must not have",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"azure": {
+					"renewable": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration"),
+							Description: "Whether the tokens created against this role may be renewed. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"gcp": {
+					"period": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration"),
+							Description: "If set, tokens created against this role will be periodic with the given period, i.e. 24h. Periodic tokens have no max TTL and must be renewed within the period.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"postgres": {
+					"explicitMaxTTL": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"),
+							Description: "If set, tokens created against this role will have an explicit max TTL",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"mongodb": {
+					"pathSuffix": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration"),
+							Description: "If set, tokens created against this role will have the given suffix as part of their path in addition to the role name",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"mysql": {
+					"tokenBoundCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration"),
+							Description: "List of CIDR blocks; if set, specifies blocks of IP addresses which can use the tokens created against this role",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tokenType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The type of the tokens created against this role, one of service, batch or default. Batch tokens can not be renewed or revoked by accessor.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -18697,11 +20697,11 @@ func schema_operator_apis_engine_v1alpha1_SecretEngineSpec(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/engine/v1alpha1.AWSConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.AzureConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.GCPConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MongoDBConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.MySQLConfiguration", "kubevault.dev/operator/apis/engine/v1alpha1.PostgresConfiguration"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_operator_apis_engine_v1alpha1_SecretEngineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_engine_v1alpha1_VaultTokenRoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -18715,17 +20715,19 @@ func schema_operator_apis_engine_v1alpha1_SecretEngineStatus(ref common.Referenc
 					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "ObservedGeneration is the most recent generation observed for this VaultTokenRole. It corresponds to the VaultTokenRole's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Represents the latest available observations of a VaultTokenRole current state.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineCondition"),
+										Ref: ref("kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleCondition"),
 									},
 								},
 							},
@@ -18735,6 +20737,74 @@ func schema_operator_apis_engine_v1alpha1_SecretEngineStatus(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/engine/v1alpha1.SecretEngineCondition"},
+			"kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRoleCondition"},
+	}
+}
+
+func schema_operator_apis_engine_v1alpha1_WrappingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WrappingStatus contains info of the response-wrapping token of a wrapped credential",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"accessor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "accessor of the wrapping token",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "path of the request that created the wrapping token",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "time when the wrapping token was created",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ttl of the wrapping token",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"unwrapped": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies whether the wrapping token has been unwrapped",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"unwrapTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "time when the wrapping token was observed as unwrapped",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"expired": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies whether the wrapping token has expired without being unwrapped",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"revoked": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies whether the wrapped credential has been revoked before the wrapping token is unwrapped",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
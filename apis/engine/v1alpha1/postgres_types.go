@@ -84,6 +84,14 @@ type PostgresRoleSpec struct {
 	// https://www.vaultproject.io/api/secret/databases/postgresql.html#renew_statements
 	// Specifies the database statements to be executed to renew a user.
 	RenewStatements []string `json:"renewStatements,omitempty"`
+
+	// Specifies what happens to the issued credentials on deletion.
+	// RevokeLeases revokes their leases, Retain keeps them until their TTL runs out
+	// and Block refuses deletion while any of them has a live lease.
+	// Defaults to Retain.
+	// +optional
+	// +kubebuilder:validation:Enum=RevokeLeases;Retain;Block
+	RevocationPolicy RevocationPolicy `json:"revocationPolicy,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
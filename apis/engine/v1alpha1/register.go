@@ -70,6 +70,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&MySQLRoleList{},
 		&PostgresRole{},
 		&PostgresRoleList{},
+		&VaultAppRole{},
+		&VaultAppRoleList{},
+		&AppRoleSecretIDRequest{},
+		&AppRoleSecretIDRequestList{},
+		&VaultTokenRole{},
+		&VaultTokenRoleList{},
+		&VaultTokenRequest{},
+		&VaultTokenRequestList{},
+		&LeaseRevocation{},
+		&LeaseRevocationList{},
 	)
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&metav1.Status{},
@@ -49,10 +49,63 @@ type SecretEngine struct {
 type SecretEngineSpec struct {
 	VaultRef core.LocalObjectReference `json:"vaultRef"`
 
-	// Path defines the path used to enable this secret engine
+	// Path defines the path used to enable this secret engine.
+	// If it is changed, the secret engine is moved to the new path. Vault revokes
+	// the leases of the old path, and the roles and access requests referring
+	// to the old path are not updated.
 	// +optional
 	Path string `json:"path,omitempty"`
 
+	// Specifies the human-friendly description of the mount
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Specifies the default lease duration of the mount, i.e. 1h.
+	// Defaults to the system default.
+	// +optional
+	DefaultLeaseTTL string `json:"defaultLeaseTTL,omitempty"`
+
+	// Specifies the maximum lease duration of the mount, i.e. 24h.
+	// Defaults to the system maximum.
+	// +optional
+	MaxLeaseTTL string `json:"maxLeaseTTL,omitempty"`
+
+	// Enables seal wrapping for the mount.
+	// It can only be set when the secret engine is enabled.
+	// +optional
+	SealWrap bool `json:"sealWrap,omitempty"`
+
+	// Specifies that the mount is local to this vault cluster and is not replicated.
+	// It can only be set when the secret engine is enabled.
+	// +optional
+	Local bool `json:"local,omitempty"`
+
+	// List of keys that will not be HMAC'd by audit devices in the request data object
+	// +optional
+	AuditNonHMACRequestKeys []string `json:"auditNonHMACRequestKeys,omitempty"`
+
+	// List of keys that will not be HMAC'd by audit devices in the response data object
+	// +optional
+	AuditNonHMACResponseKeys []string `json:"auditNonHMACResponseKeys,omitempty"`
+
+	// Specifies mount type specific options that are passed to the backend
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+
+	// Specifies the semantic version of the plugin to use, i.e. v1.0.0.
+	// The running version is kept, if it is cleared.
+	// +optional
+	PluginVersion string `json:"pluginVersion,omitempty"`
+
+	// Specifies what happens to the issued credentials on deletion.
+	// RevokeLeases revokes their leases before the secret engine is disabled,
+	// Retain leaves them to Vault, which revokes them when the secret engine is disabled,
+	// and Block refuses deletion while any of them has a live lease.
+	// Defaults to Retain.
+	// +optional
+	// +kubebuilder:validation:Enum=RevokeLeases;Retain;Block
+	RevocationPolicy RevocationPolicy `json:"revocationPolicy,omitempty"`
+
 	SecretEngineConfiguration `json:",inline"`
 }
 
@@ -143,8 +196,31 @@ type AzureConfiguration struct {
 	//	- tenant-id: <value>, The tenant id for the Azure Active Directory.
 	//	- client-id: <value>, The OAuth2 client id to connect to Azure.
 	//	- client-secret: <value>, The OAuth2 client secret to connect to Azure.
-	// +required
-	CredentialSecret string `json:"credentialSecret"`
+	// If the secret is not specified, subscriptionID and tenantID are required
+	// and vault connects to Azure with the managed identity of its host.
+	// +optional
+	CredentialSecret string `json:"credentialSecret,omitempty"`
+
+	// The subscription id for the Azure Active Directory.
+	// Takes precedence over the subscription-id of the credential secret.
+	// +optional
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+
+	// The tenant id for the Azure Active Directory.
+	// Takes precedence over the tenant-id of the credential secret.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+
+	// The OAuth2 client id to connect to Azure.
+	// Takes precedence over the client-id of the credential secret.
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+
+	// Specifies how long the client secret of the configuration is valid,
+	// before vault rotates it, i.e. 4380h.
+	// If not specified, vault uses its default of 6 months.
+	// +optional
+	RootPasswordTTL string `json:"rootPasswordTTL,omitempty"`
 
 	// The Azure environment.
 	// If not specified, Vault will use Azure Public Cloud.
@@ -239,6 +315,39 @@ type SecretEngineStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 
 	Conditions []SecretEngineCondition `json:"conditions,omitempty"`
+
+	// Path where the secret engine is enabled
+	Path string `json:"path,omitempty"`
+
+	// Contains the mount info of the secret engine reported by vault
+	Mount *SecretEngineMountStatus `json:"mount,omitempty"`
+}
+
+// SecretEngineMountStatus contains the mount info of a secret engine
+type SecretEngineMountStatus struct {
+	Type string `json:"type,omitempty"`
+
+	Accessor string `json:"accessor,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	DefaultLeaseTTL string `json:"defaultLeaseTTL,omitempty"`
+
+	MaxLeaseTTL string `json:"maxLeaseTTL,omitempty"`
+
+	SealWrap bool `json:"sealWrap,omitempty"`
+
+	Local bool `json:"local,omitempty"`
+
+	AuditNonHMACRequestKeys []string `json:"auditNonHMACRequestKeys,omitempty"`
+
+	AuditNonHMACResponseKeys []string `json:"auditNonHMACResponseKeys,omitempty"`
+
+	Options map[string]string `json:"options,omitempty"`
+
+	PluginVersion string `json:"pluginVersion,omitempty"`
+
+	RunningPluginVersion string `json:"runningPluginVersion,omitempty"`
 }
 
 type SecretEngineCondition struct {
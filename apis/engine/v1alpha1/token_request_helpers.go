@@ -0,0 +1,65 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	"time"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+)
+
+func (d VaultTokenRequest) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourceVaultTokenRequests,
+		Singular:      ResourceVaultTokenRequest,
+		Kind:          ResourceKindVaultTokenRequest,
+		Categories:    []string{"vault", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{
+				"app": "vault",
+			},
+		},
+		SpecDefinitionName:      "kubevault.dev/operator/apis/engine/v1alpha1.VaultTokenRequest",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+	})
+}
+
+func (d VaultTokenRequest) IsValid() error {
+	return nil
+}
+
+// RenewalTime returns the time when the token should be renewed,
+// i.e. when two thirds of its lease duration are passed.
+// It returns nil, if the token is not renewable.
+func (d VaultTokenRequest) RenewalTime() *time.Time {
+	if !d.Status.Renewable || d.Status.ExpirationTime == nil {
+		return nil
+	}
+	t := d.Status.ExpirationTime.Add(-time.Duration(d.Status.LeaseDuration) * time.Second / 3)
+	return &t
+}
@@ -0,0 +1,128 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindVaultTokenRequest = "VaultTokenRequest"
+	ResourceVaultTokenRequest     = "vaulttokenrequest"
+	ResourceVaultTokenRequests    = "vaulttokenrequests"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vaulttokenrequests,singular=vaulttokenrequest,categories={vault,appscode,all}
+// +kubebuilder:subresource:status
+type VaultTokenRequest struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultTokenRequestSpec   `json:"spec,omitempty"`
+	Status            VaultTokenRequestStatus `json:"status,omitempty"`
+}
+
+// Link:
+//  - https://www.vaultproject.io/api/auth/token/index.html#create-token
+
+// VaultTokenRequestSpec contains information to request for a token of a VaultTokenRole
+type VaultTokenRequestSpec struct {
+	// Contains vault token role info
+	// +required
+	RoleRef RoleRef `json:"roleRef"`
+
+	// Contains a reference to the object or user identities the role binding is applied to
+	// +required
+	Subjects []rbac.Subject `json:"subjects"`
+
+	// List of policies of the token. It must be a subset of the allowed policies of the role.
+	// If empty, the token gets the allowed policies of the role.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+
+	// Metadata to be tied to the token
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// The TTL period of the token, i.e. 1h. Ignored for periodic tokens.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+
+	// The maximum uses of the token. A value of zero allows unlimited uses.
+	// +optional
+	NumUses int64 `json:"numUses,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+type VaultTokenRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of VaultTokenRequest objects
+	Items []VaultTokenRequest `json:"items,omitempty"`
+}
+
+type VaultTokenRequestStatus struct {
+	// Conditions applied to the request, such as approval or denial.
+	// +optional
+	Conditions []VaultTokenRequestCondition `json:"conditions,omitempty"`
+
+	// Name of the secret containing the token
+	// +optional
+	Secret *core.LocalObjectReference `json:"secret,omitempty"`
+
+	// Accessor of the issued token
+	// +optional
+	Accessor string `json:"accessor,omitempty"`
+
+	// Specifies whether the token is renewed by the operator.
+	// The operator reconciles the request to renew the token, when two thirds of its TTL are passed.
+	// +optional
+	Renewable bool `json:"renewable,omitempty"`
+
+	// The TTL of the token in seconds, when it was issued or renewed last
+	// +optional
+	LeaseDuration int64 `json:"leaseDuration,omitempty"`
+
+	// The time when the token expires, unless it is renewed
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+type VaultTokenRequestCondition struct {
+	// request approval state, currently Approved or Denied.
+	Type RequestConditionType `json:"type"`
+
+	// brief reason for the request state
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// human readable message with details about the request state
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// timestamp for the last update to this condition
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
@@ -0,0 +1,129 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindVaultTokenRole = "VaultTokenRole"
+	ResourceVaultTokenRole     = "vaulttokenrole"
+	ResourceVaultTokenRoles    = "vaulttokenroles"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vaulttokenroles,singular=vaulttokenrole,categories={vault,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+type VaultTokenRole struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultTokenRoleSpec   `json:"spec,omitempty"`
+	Status            VaultTokenRoleStatus `json:"status,omitempty"`
+}
+
+// VaultTokenRoleSpec contains connection information, token role info, etc
+// The tokens created against the role are always orphan tokens, otherwise they would be
+// revoked along with the short lived token of the operator, that created them.
+// More info: https://www.vaultproject.io/api/auth/token/index.html#create-update-token-role
+type VaultTokenRoleSpec struct {
+	// VaultRef is the name of a AppBinding referencing to a Vault Server
+	VaultRef core.LocalObjectReference `json:"vaultRef"`
+
+	// List of policies that tokens created with this role may have.
+	// If empty, tokens may have any policies the creating token has.
+	// +optional
+	AllowedPolicies []string `json:"allowedPolicies,omitempty"`
+
+	// List of policies that tokens created with this role must not have
+	// +optional
+	DisallowedPolicies []string `json:"disallowedPolicies,omitempty"`
+
+	// Whether the tokens created against this role may be renewed.
+	// Defaults to true.
+	// +optional
+	Renewable *bool `json:"renewable,omitempty"`
+
+	// If set, tokens created against this role will be periodic with the given period, i.e. 24h.
+	// Periodic tokens have no max TTL and must be renewed within the period.
+	// +optional
+	Period string `json:"period,omitempty"`
+
+	// If set, tokens created against this role will have an explicit max TTL
+	// +optional
+	ExplicitMaxTTL string `json:"explicitMaxTTL,omitempty"`
+
+	// If set, tokens created against this role will have the given suffix
+	// as part of their path in addition to the role name
+	// +optional
+	PathSuffix string `json:"pathSuffix,omitempty"`
+
+	// List of CIDR blocks; if set, specifies blocks of IP addresses
+	// which can use the tokens created against this role
+	// +optional
+	TokenBoundCIDRs []string `json:"tokenBoundCIDRs,omitempty"`
+
+	// The type of the tokens created against this role, one of service, batch or default.
+	// Batch tokens can not be renewed or revoked by accessor.
+	// +optional
+	// +kubebuilder:validation:Enum=service;batch;default
+	TokenType string `json:"tokenType,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+type VaultTokenRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of VaultTokenRole objects
+	Items []VaultTokenRole `json:"items,omitempty"`
+}
+
+type VaultTokenRolePhase string
+
+type VaultTokenRoleStatus struct {
+	Phase VaultTokenRolePhase `json:"phase,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed for this VaultTokenRole. It corresponds to the
+	// VaultTokenRole's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Represents the latest available observations of a VaultTokenRole current state.
+	Conditions []VaultTokenRoleCondition `json:"conditions,omitempty"`
+}
+
+// VaultTokenRoleCondition describes the state of a VaultTokenRole at a certain point.
+type VaultTokenRoleCondition struct {
+	// Type of VaultTokenRole condition.
+	Type string `json:"type,omitempty"`
+
+	// Status of the condition, one of True, False, Unknown.
+	Status core.ConditionStatus `json:"status,omitempty"`
+
+	// The reason for the condition's.
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
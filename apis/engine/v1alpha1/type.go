@@ -16,6 +16,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"errors"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -37,6 +40,40 @@ type RequestConditionType string
 const (
 	AccessApproved RequestConditionType = "Approved"
 	AccessDenied   RequestConditionType = "Denied"
+
+	// The lease of the credential is revoked, as the role or secret engine is deleted
+	// or the secret engine is moved to another path
+	LeaseRevoked RequestConditionType = "LeaseRevoked"
+
+	// The lease of the credential is revoked by a LeaseRevocation
+	AccessRevoked RequestConditionType = "Revoked"
+)
+
+// IsRequestApproved returns whether the request is approved.
+// The latest of the approved or denied conditions wins.
+func IsRequestApproved(conditions []RequestConditionType) bool {
+	approved := false
+	for _, c := range conditions {
+		if c == AccessApproved {
+			approved = true
+		} else if c == AccessDenied {
+			approved = false
+		}
+	}
+	return approved
+}
+
+// RevocationPolicy specifies what happens to the credentials issued from
+// a secret engine or role, when it is deleted
+type RevocationPolicy string
+
+const (
+	// Revokes the leases of the issued credentials before deletion
+	RevocationPolicyRevokeLeases RevocationPolicy = "RevokeLeases"
+	// Keeps the issued credentials until their TTL runs out
+	RevocationPolicyRetain RevocationPolicy = "Retain"
+	// Refuses deletion while any issued credential has a live lease
+	RevocationPolicyBlock RevocationPolicy = "Block"
 )
 
 // Lease contains lease info
@@ -50,3 +87,120 @@ type Lease struct {
 	// Specifies whether this lease is renewable
 	Renewable bool `json:"renewable,omitempty"`
 }
+
+// CredentialDelivery specifies how the credential of an access request is delivered
+type CredentialDelivery string
+
+const (
+	// The credential is stored in the secret as it is
+	CredentialDeliveryPlain CredentialDelivery = "plain"
+	// The credential is response-wrapped by the request issuing it and only the single-use
+	// response-wrapping token is stored in the secret. The lease of the credential is kept in the status,
+	// if it can be found by the creation time of the wrapping token.
+	CredentialDeliveryWrapped CredentialDelivery = "wrapped"
+
+	// Default TTL of the response-wrapping token
+	DefaultWrapTTL = "10m"
+)
+
+// Alert condition of a wrapped credential, i.e. the wrapping token is unwrapped
+// by someone other than the intended subjects, or it can't be verified
+const WrappingTokenAlert RequestConditionType = "WrappingTokenAlert"
+
+// WrappingStatus contains info of the response-wrapping token
+// of a wrapped credential
+type WrappingStatus struct {
+	// accessor of the wrapping token
+	Accessor string `json:"accessor,omitempty"`
+
+	// path of the request that created the wrapping token
+	CreationPath string `json:"creationPath,omitempty"`
+
+	// time when the wrapping token was created
+	CreationTime metav1.Time `json:"creationTime,omitempty"`
+
+	// ttl of the wrapping token
+	TTL metav1.Duration `json:"ttl,omitempty"`
+
+	// Specifies whether the wrapping token has been unwrapped
+	Unwrapped bool `json:"unwrapped,omitempty"`
+
+	// time when the wrapping token was observed as unwrapped
+	UnwrapTime *metav1.Time `json:"unwrapTime,omitempty"`
+
+	// Specifies whether the wrapping token has expired without being unwrapped
+	Expired bool `json:"expired,omitempty"`
+
+	// Specifies whether the wrapped credential has been revoked before the wrapping token is unwrapped
+	Revoked bool `json:"revoked,omitempty"`
+}
+
+// IsWrapped returns whether the credential is delivered as a response-wrapping token
+func (d CredentialDelivery) IsWrapped() bool {
+	return d == CredentialDeliveryWrapped
+}
+
+// validateSecretTemplate checks that the secret template is not used along with the wrapped delivery,
+// as only the wrapping token is stored in the secret
+func validateSecretTemplate(delivery CredentialDelivery, secretTemplate map[string]string) error {
+	if len(secretTemplate) > 0 && delivery.IsWrapped() {
+		return errors.New("spec.secretTemplate can not be used with wrapped delivery")
+	}
+	return nil
+}
+
+// WrapTTLOrDefault returns the given ttl, or the default one if it is empty
+func WrapTTLOrDefault(ttl string) string {
+	if ttl == "" {
+		return DefaultWrapTTL
+	}
+	return ttl
+}
+
+// ExpirationTime returns the time when the wrapping token expires
+func (w WrappingStatus) ExpirationTime() time.Time {
+	return w.CreationTime.Add(w.TTL.Duration)
+}
+
+// IsPending returns whether the wrapping token is neither unwrapped, expired nor revoked
+func (w WrappingStatus) IsPending() bool {
+	return !w.Unwrapped && !w.Expired && !w.Revoked
+}
+
+// CredentialSecretRef specifies the secret where the credential of an access request is stored
+type CredentialSecretRef struct {
+	// Name of the secret in the namespace of the access request
+	Name string `json:"name"`
+
+	// Specifies whether an existing secret, that is not controlled by any other object,
+	// is adopted by the access request. Otherwise, the request fails if the secret already exists.
+	// Only Opaque secrets are adopted, unless the secret is annotated with
+	// engine.kubevault.com/adoptable: "true".
+	// An adopted secret is deleted along with the access request.
+	// +optional
+	Adopt bool `json:"adopt,omitempty"`
+}
+
+// RestartTarget specifies the workloads that are rolled out,
+// whenever the credential of an access request is issued or rotated
+type RestartTarget struct {
+	// Kind of the workloads: Deployment, StatefulSet or DaemonSet
+	Kind string `json:"kind"`
+
+	// Name of the workload in the namespace of the access request
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Selects the workloads in the namespace of the access request by labels
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// Annotation on the pod template of a restart target, that holds the hash of the credential
+// stored in the secret, i.e. credential.engine.kubevault.com/<secret name>: <hash>.
+// The hash of the secret name is used, if the secret name is longer than 63 characters.
+const CredentialHashAnnotationPrefix = "credential.engine.kubevault.com/"
+
+// Annotation on an existing secret of a type other than Opaque, i.e. a service account token,
+// that allows an access request to adopt it, i.e. engine.kubevault.com/adoptable: "true"
+const SecretAdoptableAnnotation = "engine.kubevault.com/adoptable"
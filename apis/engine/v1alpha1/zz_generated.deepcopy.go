@@ -23,6 +23,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	appcatalogv1alpha1 "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
 )
@@ -114,6 +115,25 @@ func (in *AWSAccessKeyRequestSpec) DeepCopyInto(out *AWSAccessKeyRequestSpec) {
 		*out = make([]v1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(CredentialSecretRef)
+		**out = **in
+	}
+	if in.RestartTargets != nil {
+		in, out := &in.RestartTargets, &out.RestartTargets
+		*out = make([]RestartTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -147,6 +167,15 @@ func (in *AWSAccessKeyRequestStatus) DeepCopyInto(out *AWSAccessKeyRequestStatus
 		*out = new(Lease)
 		**out = **in
 	}
+	if in.Wrapping != nil {
+		in, out := &in.Wrapping, &out.Wrapping
+		*out = new(WrappingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -316,6 +345,151 @@ func (in *AWSRoleStatus) DeepCopy() *AWSRoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDRequest) DeepCopyInto(out *AppRoleSecretIDRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDRequest.
+func (in *AppRoleSecretIDRequest) DeepCopy() *AppRoleSecretIDRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRoleSecretIDRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDRequestCondition) DeepCopyInto(out *AppRoleSecretIDRequestCondition) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDRequestCondition.
+func (in *AppRoleSecretIDRequestCondition) DeepCopy() *AppRoleSecretIDRequestCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDRequestCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDRequestList) DeepCopyInto(out *AppRoleSecretIDRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AppRoleSecretIDRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDRequestList.
+func (in *AppRoleSecretIDRequestList) DeepCopy() *AppRoleSecretIDRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AppRoleSecretIDRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDRequestSpec) DeepCopyInto(out *AppRoleSecretIDRequestSpec) {
+	*out = *in
+	out.RoleRef = in.RoleRef
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]v1.Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CIDRList != nil {
+		in, out := &in.CIDRList, &out.CIDRList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDRequestSpec.
+func (in *AppRoleSecretIDRequestSpec) DeepCopy() *AppRoleSecretIDRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSecretIDRequestStatus) DeepCopyInto(out *AppRoleSecretIDRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AppRoleSecretIDRequestCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSecretIDRequestStatus.
+func (in *AppRoleSecretIDRequestStatus) DeepCopy() *AppRoleSecretIDRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSecretIDRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureAccessKeyRequest) DeepCopyInto(out *AzureAccessKeyRequest) {
 	*out = *in
@@ -403,6 +577,25 @@ func (in *AzureAccessKeyRequestSpec) DeepCopyInto(out *AzureAccessKeyRequestSpec
 		*out = make([]v1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(CredentialSecretRef)
+		**out = **in
+	}
+	if in.RestartTargets != nil {
+		in, out := &in.RestartTargets, &out.RestartTargets
+		*out = make([]RestartTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -436,6 +629,11 @@ func (in *AzureAccessKeyRequestStatus) DeepCopyInto(out *AzureAccessKeyRequestSt
 		*out = new(Lease)
 		**out = **in
 	}
+	if in.Wrapping != nil {
+		in, out := &in.Wrapping, &out.Wrapping
+		*out = new(WrappingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -470,7 +668,7 @@ func (in *AzureRole) DeepCopyInto(out *AzureRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -546,6 +744,11 @@ func (in *AzureRoleList) DeepCopyObject() runtime.Object {
 func (in *AzureRoleSpec) DeepCopyInto(out *AzureRoleSpec) {
 	*out = *in
 	out.VaultRef = in.VaultRef
+	if in.PermanentlyDelete != nil {
+		in, out := &in.PermanentlyDelete, &out.PermanentlyDelete
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -580,6 +783,22 @@ func (in *AzureRoleStatus) DeepCopy() *AzureRoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialSecretRef) DeepCopyInto(out *CredentialSecretRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialSecretRef.
+func (in *CredentialSecretRef) DeepCopy() *CredentialSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseAccessRequest) DeepCopyInto(out *DatabaseAccessRequest) {
 	*out = *in
@@ -667,6 +886,25 @@ func (in *DatabaseAccessRequestSpec) DeepCopyInto(out *DatabaseAccessRequestSpec
 		*out = make([]v1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(CredentialSecretRef)
+		**out = **in
+	}
+	if in.RestartTargets != nil {
+		in, out := &in.RestartTargets, &out.RestartTargets
+		*out = make([]RestartTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -700,6 +938,11 @@ func (in *DatabaseAccessRequestStatus) DeepCopyInto(out *DatabaseAccessRequestSt
 		*out = new(Lease)
 		**out = **in
 	}
+	if in.Wrapping != nil {
+		in, out := &in.Wrapping, &out.Wrapping
+		*out = new(WrappingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -800,6 +1043,25 @@ func (in *GCPAccessKeyRequestSpec) DeepCopyInto(out *GCPAccessKeyRequestSpec) {
 		*out = make([]v1.Subject, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(CredentialSecretRef)
+		**out = **in
+	}
+	if in.RestartTargets != nil {
+		in, out := &in.RestartTargets, &out.RestartTargets
+		*out = make([]RestartTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -833,6 +1095,15 @@ func (in *GCPAccessKeyRequestStatus) DeepCopyInto(out *GCPAccessKeyRequestStatus
 		*out = new(Lease)
 		**out = **in
 	}
+	if in.Wrapping != nil {
+		in, out := &in.Wrapping, &out.Wrapping
+		*out = new(WrappingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenExpiresAt != nil {
+		in, out := &in.TokenExpiresAt, &out.TokenExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1016,29 +1287,7 @@ func (in *LeaseConfig) DeepCopy() *LeaseConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBConfiguration) DeepCopyInto(out *MongoDBConfiguration) {
-	*out = *in
-	in.DatabaseRef.DeepCopyInto(&out.DatabaseRef)
-	if in.AllowedRoles != nil {
-		in, out := &in.AllowedRoles, &out.AllowedRoles
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBConfiguration.
-func (in *MongoDBConfiguration) DeepCopy() *MongoDBConfiguration {
-	if in == nil {
-		return nil
-	}
-	out := new(MongoDBConfiguration)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBRole) DeepCopyInto(out *MongoDBRole) {
+func (in *LeaseRevocation) DeepCopyInto(out *LeaseRevocation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1047,18 +1296,18 @@ func (in *MongoDBRole) DeepCopyInto(out *MongoDBRole) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRole.
-func (in *MongoDBRole) DeepCopy() *MongoDBRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaseRevocation.
+func (in *LeaseRevocation) DeepCopy() *LeaseRevocation {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBRole)
+	out := new(LeaseRevocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MongoDBRole) DeepCopyObject() runtime.Object {
+func (in *LeaseRevocation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1066,29 +1315,29 @@ func (in *MongoDBRole) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBRoleCondition) DeepCopyInto(out *MongoDBRoleCondition) {
+func (in *LeaseRevocationFailure) DeepCopyInto(out *LeaseRevocationFailure) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleCondition.
-func (in *MongoDBRoleCondition) DeepCopy() *MongoDBRoleCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaseRevocationFailure.
+func (in *LeaseRevocationFailure) DeepCopy() *LeaseRevocationFailure {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBRoleCondition)
+	out := new(LeaseRevocationFailure)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBRoleList) DeepCopyInto(out *MongoDBRoleList) {
+func (in *LeaseRevocationList) DeepCopyInto(out *LeaseRevocationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MongoDBRole, len(*in))
+		*out = make([]LeaseRevocation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1096,18 +1345,18 @@ func (in *MongoDBRoleList) DeepCopyInto(out *MongoDBRoleList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleList.
-func (in *MongoDBRoleList) DeepCopy() *MongoDBRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaseRevocationList.
+func (in *LeaseRevocationList) DeepCopy() *LeaseRevocationList {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBRoleList)
+	out := new(LeaseRevocationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MongoDBRoleList) DeepCopyObject() runtime.Object {
+func (in *LeaseRevocationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1115,60 +1364,76 @@ func (in *MongoDBRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBRoleSpec) DeepCopyInto(out *MongoDBRoleSpec) {
+func (in *LeaseRevocationScope) DeepCopyInto(out *LeaseRevocationScope) {
 	*out = *in
-	out.VaultRef = in.VaultRef
-	if in.DatabaseRef != nil {
-		in, out := &in.DatabaseRef, &out.DatabaseRef
-		*out = new(appcatalogv1alpha1.AppReference)
-		(*in).DeepCopyInto(*out)
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(RoleRef)
+		**out = **in
 	}
-	if in.CreationStatements != nil {
-		in, out := &in.CreationStatements, &out.CreationStatements
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.SecretEngineRef != nil {
+		in, out := &in.SecretEngineRef, &out.SecretEngineRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
 	}
-	if in.RevocationStatements != nil {
-		in, out := &in.RevocationStatements, &out.RevocationStatements
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaseRevocationScope.
+func (in *LeaseRevocationScope) DeepCopy() *LeaseRevocationScope {
+	if in == nil {
+		return nil
 	}
+	out := new(LeaseRevocationScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LeaseRevocationSpec) DeepCopyInto(out *LeaseRevocationSpec) {
+	*out = *in
+	in.Scope.DeepCopyInto(&out.Scope)
+	out.VaultRef = in.VaultRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleSpec.
-func (in *MongoDBRoleSpec) DeepCopy() *MongoDBRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaseRevocationSpec.
+func (in *LeaseRevocationSpec) DeepCopy() *LeaseRevocationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBRoleSpec)
+	out := new(LeaseRevocationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBRoleStatus) DeepCopyInto(out *MongoDBRoleStatus) {
+func (in *LeaseRevocationStatus) DeepCopyInto(out *LeaseRevocationStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]MongoDBRoleCondition, len(*in))
+	if in.RevocationTime != nil {
+		in, out := &in.RevocationTime, &out.RevocationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]LeaseRevocationFailure, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleStatus.
-func (in *MongoDBRoleStatus) DeepCopy() *MongoDBRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaseRevocationStatus.
+func (in *LeaseRevocationStatus) DeepCopy() *LeaseRevocationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBRoleStatus)
+	out := new(LeaseRevocationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLConfiguration) DeepCopyInto(out *MySQLConfiguration) {
+func (in *MongoDBConfiguration) DeepCopyInto(out *MongoDBConfiguration) {
 	*out = *in
 	in.DatabaseRef.DeepCopyInto(&out.DatabaseRef)
 	if in.AllowedRoles != nil {
@@ -1179,18 +1444,170 @@ func (in *MySQLConfiguration) DeepCopyInto(out *MySQLConfiguration) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLConfiguration.
-func (in *MySQLConfiguration) DeepCopy() *MySQLConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBConfiguration.
+func (in *MongoDBConfiguration) DeepCopy() *MongoDBConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLConfiguration)
+	out := new(MongoDBConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLRole) DeepCopyInto(out *MySQLRole) {
+func (in *MongoDBRole) DeepCopyInto(out *MongoDBRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRole.
+func (in *MongoDBRole) DeepCopy() *MongoDBRole {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBRoleCondition) DeepCopyInto(out *MongoDBRoleCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleCondition.
+func (in *MongoDBRoleCondition) DeepCopy() *MongoDBRoleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBRoleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBRoleList) DeepCopyInto(out *MongoDBRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MongoDBRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleList.
+func (in *MongoDBRoleList) DeepCopy() *MongoDBRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBRoleSpec) DeepCopyInto(out *MongoDBRoleSpec) {
+	*out = *in
+	out.VaultRef = in.VaultRef
+	if in.DatabaseRef != nil {
+		in, out := &in.DatabaseRef, &out.DatabaseRef
+		*out = new(appcatalogv1alpha1.AppReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreationStatements != nil {
+		in, out := &in.CreationStatements, &out.CreationStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RevocationStatements != nil {
+		in, out := &in.RevocationStatements, &out.RevocationStatements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleSpec.
+func (in *MongoDBRoleSpec) DeepCopy() *MongoDBRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBRoleStatus) DeepCopyInto(out *MongoDBRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]MongoDBRoleCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBRoleStatus.
+func (in *MongoDBRoleStatus) DeepCopy() *MongoDBRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLConfiguration) DeepCopyInto(out *MySQLConfiguration) {
+	*out = *in
+	in.DatabaseRef.DeepCopyInto(&out.DatabaseRef)
+	if in.AllowedRoles != nil {
+		in, out := &in.AllowedRoles, &out.AllowedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLConfiguration.
+func (in *MySQLConfiguration) DeepCopy() *MySQLConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLRole) DeepCopyInto(out *MySQLRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1481,6 +1898,27 @@ func (in *PostgresRoleStatus) DeepCopy() *PostgresRoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestartTarget) DeepCopyInto(out *RestartTarget) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartTarget.
+func (in *RestartTarget) DeepCopy() *RestartTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(RestartTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleRef) DeepCopyInto(out *RoleRef) {
 	*out = *in
@@ -1620,10 +2058,60 @@ func (in *SecretEngineList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretEngineMountStatus) DeepCopyInto(out *SecretEngineMountStatus) {
+	*out = *in
+	if in.AuditNonHMACRequestKeys != nil {
+		in, out := &in.AuditNonHMACRequestKeys, &out.AuditNonHMACRequestKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditNonHMACResponseKeys != nil {
+		in, out := &in.AuditNonHMACResponseKeys, &out.AuditNonHMACResponseKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretEngineMountStatus.
+func (in *SecretEngineMountStatus) DeepCopy() *SecretEngineMountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretEngineMountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretEngineSpec) DeepCopyInto(out *SecretEngineSpec) {
 	*out = *in
 	out.VaultRef = in.VaultRef
+	if in.AuditNonHMACRequestKeys != nil {
+		in, out := &in.AuditNonHMACRequestKeys, &out.AuditNonHMACRequestKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuditNonHMACResponseKeys != nil {
+		in, out := &in.AuditNonHMACResponseKeys, &out.AuditNonHMACResponseKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.SecretEngineConfiguration.DeepCopyInto(&out.SecretEngineConfiguration)
 	return
 }
@@ -1646,6 +2134,11 @@ func (in *SecretEngineStatus) DeepCopyInto(out *SecretEngineStatus) {
 		*out = make([]SecretEngineCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.Mount != nil {
+		in, out := &in.Mount, &out.Mount
+		*out = new(SecretEngineMountStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1658,3 +2151,439 @@ func (in *SecretEngineStatus) DeepCopy() *SecretEngineStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAppRole) DeepCopyInto(out *VaultAppRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAppRole.
+func (in *VaultAppRole) DeepCopy() *VaultAppRole {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAppRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultAppRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAppRoleCondition) DeepCopyInto(out *VaultAppRoleCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAppRoleCondition.
+func (in *VaultAppRoleCondition) DeepCopy() *VaultAppRoleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAppRoleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAppRoleList) DeepCopyInto(out *VaultAppRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VaultAppRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAppRoleList.
+func (in *VaultAppRoleList) DeepCopy() *VaultAppRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAppRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultAppRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAppRoleSpec) DeepCopyInto(out *VaultAppRoleSpec) {
+	*out = *in
+	out.VaultRef = in.VaultRef
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BindSecretID != nil {
+		in, out := &in.BindSecretID, &out.BindSecretID
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretIDBoundCIDRs != nil {
+		in, out := &in.SecretIDBoundCIDRs, &out.SecretIDBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAppRoleSpec.
+func (in *VaultAppRoleSpec) DeepCopy() *VaultAppRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAppRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAppRoleStatus) DeepCopyInto(out *VaultAppRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]VaultAppRoleCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAppRoleStatus.
+func (in *VaultAppRoleStatus) DeepCopy() *VaultAppRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAppRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRequest) DeepCopyInto(out *VaultTokenRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRequest.
+func (in *VaultTokenRequest) DeepCopy() *VaultTokenRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultTokenRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRequestCondition) DeepCopyInto(out *VaultTokenRequestCondition) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRequestCondition.
+func (in *VaultTokenRequestCondition) DeepCopy() *VaultTokenRequestCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRequestCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRequestList) DeepCopyInto(out *VaultTokenRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VaultTokenRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRequestList.
+func (in *VaultTokenRequestList) DeepCopy() *VaultTokenRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultTokenRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRequestSpec) DeepCopyInto(out *VaultTokenRequestSpec) {
+	*out = *in
+	out.RoleRef = in.RoleRef
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]v1.Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRequestSpec.
+func (in *VaultTokenRequestSpec) DeepCopy() *VaultTokenRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRequestStatus) DeepCopyInto(out *VaultTokenRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]VaultTokenRequestCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRequestStatus.
+func (in *VaultTokenRequestStatus) DeepCopy() *VaultTokenRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRole) DeepCopyInto(out *VaultTokenRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRole.
+func (in *VaultTokenRole) DeepCopy() *VaultTokenRole {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultTokenRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRoleCondition) DeepCopyInto(out *VaultTokenRoleCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRoleCondition.
+func (in *VaultTokenRoleCondition) DeepCopy() *VaultTokenRoleCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRoleCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRoleList) DeepCopyInto(out *VaultTokenRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VaultTokenRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRoleList.
+func (in *VaultTokenRoleList) DeepCopy() *VaultTokenRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultTokenRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRoleSpec) DeepCopyInto(out *VaultTokenRoleSpec) {
+	*out = *in
+	out.VaultRef = in.VaultRef
+	if in.AllowedPolicies != nil {
+		in, out := &in.AllowedPolicies, &out.AllowedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisallowedPolicies != nil {
+		in, out := &in.DisallowedPolicies, &out.DisallowedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Renewable != nil {
+		in, out := &in.Renewable, &out.Renewable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRoleSpec.
+func (in *VaultTokenRoleSpec) DeepCopy() *VaultTokenRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTokenRoleStatus) DeepCopyInto(out *VaultTokenRoleStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]VaultTokenRoleCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTokenRoleStatus.
+func (in *VaultTokenRoleStatus) DeepCopy() *VaultTokenRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTokenRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WrappingStatus) DeepCopyInto(out *WrappingStatus) {
+	*out = *in
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	out.TTL = in.TTL
+	if in.UnwrapTime != nil {
+		in, out := &in.UnwrapTime, &out.UnwrapTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WrappingStatus.
+func (in *WrappingStatus) DeepCopy() *WrappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WrappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -96,3 +96,65 @@ const (
 	AzureVmName            = "kubevault.com/azure.vm-name"
 	AzureVmssName          = "kubevault.com/azure.vmss-name"
 )
+
+// Pod annotations to inject the credential of an approved access request.
+// The pod mutating webhook is registered with failurePolicy Ignore, so that the pods
+// are not blocked while the operator is unavailable. In that case, the annotated pods
+// are created without the credential, and without checking the subjects of the request.
+// The webhook is only called for the pods labelled with InjectAccessRequestKey, outside
+// of kube-system and the namespace of the operator.
+const (
+	// Specifies the name of the access request in the namespace of the pod.
+	// It must be set as a label too, so that the webhook is called for the pod.
+	InjectAccessRequestKey = "kubevault.com/inject-access-request"
+
+	// Specifies the kind of the access request: DatabaseAccessRequest (default),
+	// AWSAccessKeyRequest, GCPAccessKeyRequest or AzureAccessKeyRequest
+	InjectAccessRequestKindKey = "kubevault.com/inject-access-request-kind"
+
+	// Specifies how the credential is injected: files (default) or env
+	InjectAsKey = "kubevault.com/inject-as"
+
+	// Specifies the directory where the credential files are mounted.
+	// Defaults to /var/run/secrets/kubevault.com/<access request name>
+	InjectMountPathKey = "kubevault.com/inject-mount-path"
+
+	// Specifies the comma separated names of the containers to inject into.
+	// Defaults to all the containers of the pod
+	InjectContainersKey = "kubevault.com/inject-containers"
+
+	InjectAsFiles = "files"
+	InjectAsEnv   = "env"
+)
+
+// Pod annotations to inject a vault agent
+const (
+	// Set to "true" to inject the vault agent.
+	// It must be set as a label too, so that the webhook is called for the pod.
+	AgentInjectKey = "vault.kubevault.com/agent-inject"
+
+	// Set by the injector, once the vault agent is injected
+	AgentInjectStatusKey = "vault.kubevault.com/agent-inject-status"
+
+	// Specifies the VaultServer, i.e. <name> in the namespace of the pod or <namespace>/<name>
+	AgentVaultServerKey = "vault.kubevault.com/vault-server"
+
+	// Specifies the vault role of the kubernetes auth method, used by the vault agent to login
+	AgentRoleKey = "vault.kubevault.com/role"
+
+	// Prefix of the annotations that specify the secret paths, i.e.
+	// vault.kubevault.com/agent-inject-secret-<file>: <secret path>
+	AgentInjectSecretKeyPrefix = "vault.kubevault.com/agent-inject-secret-"
+
+	// Prefix of the annotations that specify the templates of the secret files, i.e.
+	// vault.kubevault.com/agent-inject-template-<file>: <template>
+	AgentInjectTemplateKeyPrefix = "vault.kubevault.com/agent-inject-template-"
+
+	// Specifies the directory where the secret files are rendered. Defaults to /vault/secrets
+	AgentInjectMountPathKey = "vault.kubevault.com/agent-inject-mount-path"
+
+	// Set to "true" to inject only the init container, that renders the secret files once
+	AgentPrePopulateOnlyKey = "vault.kubevault.com/agent-pre-populate-only"
+
+	AgentInjectStatusInjected = "injected"
+)
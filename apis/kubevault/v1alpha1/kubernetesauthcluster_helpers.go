@@ -0,0 +1,113 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+)
+
+const DefaultTokenRefreshInterval = time.Hour
+
+func (k KubernetesAuthCluster) GetKey() string {
+	return k.Namespace + "/" + k.Name
+}
+
+// RemoteClusterName returns the name of the remote cluster
+func (k KubernetesAuthCluster) RemoteClusterName() string {
+	if k.Spec.ClusterName != "" {
+		return k.Spec.ClusterName
+	}
+	return k.Name
+}
+
+// AuthPath returns the path where the kubernetes auth method of the remote cluster is enabled.
+// The KubernetesAuthClusters of different namespaces can't use the same cluster name for a vault server,
+// as the auth method is only adopted by the KubernetesAuthCluster with the same description.
+func (k KubernetesAuthCluster) AuthPath() string {
+	return string(AuthTypeKubernetes) + "-" + k.RemoteClusterName()
+}
+
+// IsValid checks that the cluster name can be used in the path of the auth method
+func (k KubernetesAuthCluster) IsValid() error {
+	if errs := validation.IsDNS1123Label(k.RemoteClusterName()); len(errs) > 0 {
+		return fmt.Errorf("invalid cluster name %s: %s", k.RemoteClusterName(), strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// AuthDescription returns the description of the kubernetes auth method of the remote cluster.
+// It identifies the auth methods enabled for the KubernetesAuthCluster.
+func (k KubernetesAuthCluster) AuthDescription() string {
+	return "kubernetes auth for KubernetesAuthCluster " + k.Namespace + "/" + k.Name
+}
+
+// GetTokenRefreshInterval returns the interval to refresh the reviewer token
+func (k KubernetesAuthCluster) GetTokenRefreshInterval() time.Duration {
+	if k.Spec.TokenRefreshInterval != nil && k.Spec.TokenRefreshInterval.Duration > 0 {
+		return k.Spec.TokenRefreshInterval.Duration
+	}
+	return DefaultTokenRefreshInterval
+}
+
+func (k KubernetesAuthCluster) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourceKubernetesAuthClusters,
+		Singular:      ResourceKubernetesAuthCluster,
+		Kind:          ResourceKindKubernetesAuthCluster,
+		ShortNames:    []string{"kac"},
+		Categories:    []string{"vault", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "vault"},
+		},
+		SpecDefinitionName:      "kubevault.dev/operator/apis/kubevault/v1alpha1.KubernetesAuthCluster",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Path",
+				Type:     "string",
+				JSONPath: ".status.path",
+			},
+			{
+				Name:     "Status",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+}
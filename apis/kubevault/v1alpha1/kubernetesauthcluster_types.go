@@ -0,0 +1,167 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindKubernetesAuthCluster = "KubernetesAuthCluster"
+	ResourceKubernetesAuthCluster     = "kubernetesauthcluster"
+	ResourceKubernetesAuthClusters    = "kubernetesauthclusters"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KubernetesAuthCluster enables and configures a dedicated kubernetes auth method
+// in vault for the workloads of a remote Kubernetes cluster.
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=kubernetesauthclusters,singular=kubernetesauthcluster,shortName=kac,categories={vault,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Path",type="string",JSONPath=".status.path"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type KubernetesAuthCluster struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KubernetesAuthClusterSpec   `json:"spec,omitempty"`
+	Status            KubernetesAuthClusterStatus `json:"status,omitempty"`
+}
+
+// KubernetesAuthClusterSpec specifies the remote cluster and how vault reviews its tokens.
+// The connection information is taken either from a kubeconfig secret, or from
+// kubernetesHost, kubernetesCACert and tokenReviewerJWT.
+// More info: https://www.vaultproject.io/api/auth/kubernetes/index.html#configure-method
+type KubernetesAuthClusterSpec struct {
+	// VaultRef is the name of a AppBinding referencing to a Vault Server
+	VaultRef core.LocalObjectReference `json:"vaultRef"`
+
+	// ClusterName is the name of the remote cluster. It must be a DNS label.
+	// The auth method is enabled in kubernetes-<clusterName> path.
+	// default: name of the KubernetesAuthCluster
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Kubeconfig selects the key of a secret containing the kubeconfig of the
+	// remote cluster. The current context of the kubeconfig is used.
+	// +optional
+	Kubeconfig *core.SecretKeySelector `json:"kubeconfig,omitempty"`
+
+	// TokenReviewerServiceAccount is the service account in the remote cluster
+	// whose token is used by vault to access the TokenReview API. A fresh token
+	// is requested periodically using the kubeconfig. Requires kubeconfig.
+	// +optional
+	TokenReviewerServiceAccount *TokenReviewerServiceAccount `json:"tokenReviewerServiceAccount,omitempty"`
+
+	// KubernetesHost is the address of the Kubernetes API server of the remote cluster.
+	// Overrides the server of the kubeconfig.
+	// +optional
+	KubernetesHost string `json:"kubernetesHost,omitempty"`
+
+	// KubernetesCACert is the PEM encoded CA cert of the remote cluster.
+	// Overrides the certificate authority of the kubeconfig.
+	// +optional
+	KubernetesCACert string `json:"kubernetesCACert,omitempty"`
+
+	// TokenReviewerJWT selects the key of a secret containing a static
+	// service account JWT used to access the TokenReview API.
+	// +optional
+	TokenReviewerJWT *core.SecretKeySelector `json:"tokenReviewerJWT,omitempty"`
+
+	// Issuer is the JWT issuer of the service account tokens of the remote cluster.
+	// If empty, the issuer validation is disabled.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// TokenRefreshInterval specifies how often the reviewer token
+	// of the TokenReviewerServiceAccount is refreshed.
+	// default: 1h
+	// +optional
+	TokenRefreshInterval *metav1.Duration `json:"tokenRefreshInterval,omitempty"`
+}
+
+// TokenReviewerServiceAccount refers to a service account of the remote cluster
+type TokenReviewerServiceAccount struct {
+	// Name of the service account
+	Name string `json:"name"`
+
+	// Namespace of the service account
+	Namespace string `json:"namespace"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+type KubernetesAuthClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is a list of KubernetesAuthCluster objects
+	Items []KubernetesAuthCluster `json:"items,omitempty"`
+}
+
+type KubernetesAuthClusterPhase string
+
+const (
+	KubernetesAuthClusterSuccess KubernetesAuthClusterPhase = "Success"
+	KubernetesAuthClusterFailed  KubernetesAuthClusterPhase = "Failed"
+)
+
+type KubernetesAuthClusterStatus struct {
+	// ObservedGeneration is the most recent generation observed for this KubernetesAuthCluster. It corresponds to the
+	// KubernetesAuthCluster's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Status of the KubernetesAuthCluster
+	// +optional
+	Phase KubernetesAuthClusterPhase `json:"phase,omitempty"`
+
+	// Path where the kubernetes auth method is enabled
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// TokenExpirationTime is the time when the reviewer token expires,
+	// if it is requested for the TokenReviewerServiceAccount
+	// +optional
+	TokenExpirationTime *metav1.Time `json:"tokenExpirationTime,omitempty"`
+
+	// Represents the latest available observations of a KubernetesAuthCluster current state.
+	// +optional
+	Conditions []KubernetesAuthClusterCondition `json:"conditions,omitempty"`
+}
+
+// KubernetesAuthClusterCondition describes the state of a KubernetesAuthCluster at a certain point.
+type KubernetesAuthClusterCondition struct {
+	// Type of KubernetesAuthCluster condition.
+	Type string `json:"type,omitempty"`
+
+	// Status of the condition, one of True, False, Unknown.
+	Status core.ConditionStatus `json:"status,omitempty"`
+
+	// The reason for the condition's.
+	Reason string `json:"reason,omitempty"`
+
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
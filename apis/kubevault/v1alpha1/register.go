@@ -50,6 +50,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&VaultServer{},
 		&VaultServerList{},
+		&KubernetesAuthCluster{},
+		&KubernetesAuthClusterList{},
 	)
 
 	scheme.AddKnownTypes(SchemeGroupVersion,
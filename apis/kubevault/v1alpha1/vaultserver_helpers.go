@@ -65,6 +65,21 @@ func (v VaultServer) OffshootLabels() map[string]string {
 	return meta_util.FilterKeys("kubevault.com", v.OffshootSelectors(), v.Labels)
 }
 
+// ActiveServiceName returns the name of the service selecting the active vault pod
+func (v VaultServer) ActiveServiceName() string {
+	return v.OffshootName() + "-active"
+}
+
+// StandbyServiceName returns the name of the service selecting the standby vault pods
+func (v VaultServer) StandbyServiceName() string {
+	return v.OffshootName() + "-standby"
+}
+
+// InternalServiceName returns the name of the headless service used for cluster traffic
+func (v VaultServer) InternalServiceName() string {
+	return v.OffshootName() + "-internal"
+}
+
 func (v VaultServer) ConfigMapName() string {
 	return v.OffshootName() + "-vault-config"
 }
@@ -73,6 +88,14 @@ func (v VaultServer) TLSSecretName() string {
 	return v.OffshootName() + "-vault-tls"
 }
 
+func (v VaultServer) CASecretName() string {
+	return v.OffshootName() + "-vault-ca"
+}
+
+func (v VaultServer) UnsealKeySecretName(custodian string) string {
+	return v.OffshootName() + "-unseal-key-" + custodian
+}
+
 func (v VaultServer) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
 	return crdutils.NewCustomResourceDefinition(crdutils.Config{
 		Group:         SchemeGroupVersion.Group,
@@ -77,6 +77,20 @@ type VaultServerSpec struct {
 	// +optional
 	TLS *TLSPolicy `json:"tls,omitempty"`
 
+	// Listener contains the configuration of the tcp listener of vault.
+	// If specified, the ConfigSource must not define a tcp listener on port 8200.
+	// +optional
+	Listener *TCPListenerSpec `json:"listener,omitempty"`
+
+	// ServerConfig contains the server level configuration of vault.
+	// The parameters set here must not be set in the ConfigSource.
+	// +optional
+	ServerConfig *ServerConfigSpec `json:"serverConfig,omitempty"`
+
+	// HealthCheck configures the readiness probe of the vault pods
+	// +optional
+	HealthCheck *HealthCheckSpec `json:"healthCheck,omitempty"`
+
 	// backend storage configuration for vault
 	Backend BackendStorageSpec `json:"backend"`
 
@@ -158,6 +172,39 @@ type VaultServerStatus struct {
 	// Status of the vault auth methods
 	// +optional
 	AuthMethodStatus []AuthMethodStatus `json:"authMethodStatus,omitempty"`
+
+	// TLS contains the expiry of the vault server certificates
+	// +optional
+	TLS *TLSStatus `json:"tls,omitempty"`
+}
+
+// TLSStatus contains the expiry of the vault server certificates
+type TLSStatus struct {
+	// ServerCertificate is the certificate served by the vault nodes
+	// +optional
+	ServerCertificate *CertificateStatus `json:"serverCertificate,omitempty"`
+
+	// CACertificates are the certificates of the CA bundle
+	// +optional
+	CACertificates []CertificateStatus `json:"caCertificates,omitempty"`
+
+	// RenewalTime is the time of the next rotation of the operator generated certificates
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+}
+
+// CertificateStatus contains the identity and expiry of a certificate
+type CertificateStatus struct {
+	// Common name of the certificate subject
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// Serial number of the certificate
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// NotAfter is the expiry time of the certificate
+	NotAfter metav1.Time `json:"notAfter"`
 }
 
 type VaultServerConditionType string
@@ -221,11 +268,146 @@ type TLSPolicy struct {
 	// 	- localhost
 	// 	- *.<namespace>.pod
 	// 	- <vaultServer-name>.<namespace>.svc
-	TLSSecret string `json:"tlsSecret"`
+	//
+	// If not specified, operator will generate the TLS secret <vaultServer-name>-vault-tls
+	// and rotate its certificates before they expire.
+	// +optional
+	TLSSecret string `json:"tlsSecret,omitempty"`
 
 	// CABundle is a PEM encoded CA bundle which will be used to validate the serving certificate.
 	// +optional
 	CABundle []byte `json:"caBundle,omitempty"`
+
+	// IssuerRef refers to a cert-manager Issuer or ClusterIssuer.
+	// If specified, a cert-manager Certificate is created to issue
+	// the TLS secret instead of the operator generated certificates.
+	// The CA bundle is read from the ca.crt key of the TLS secret,
+	// if CABundle is not specified.
+	// +optional
+	IssuerRef *core.TypedLocalObjectReference `json:"issuerRef,omitempty"`
+
+	// RenewBefore is the time window before the expiry of the operator generated
+	// server certificate, in which it is re-issued.
+	// The operator generated CA is rotated in two stages: a new CA is added to the
+	// CA bundle twice this window before the CA expires, and it replaces the old CA
+	// this window before the CA expires. The old CA is trusted until it expires.
+	// Default: 720h
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// HealthCheckSpec defines the parameters of the readiness probe,
+// which queries the /v1/sys/health endpoint of vault.
+// Sealed and uninitialized vault pods are never ready.
+// ref: https://www.vaultproject.io/api/system/health
+type HealthCheckSpec struct {
+	// Specifies that standby vault pods are ready.
+	// The active and standby services are not affected by this parameter.
+	// +optional
+	StandbyOK bool `json:"standbyOK,omitempty"`
+
+	// Specifies that performance standby vault pods are ready.
+	// +optional
+	PerfStandbyOK bool `json:"perfStandbyOK,omitempty"`
+}
+
+// TCPListenerSpec defines the configuration of the tcp listener of vault
+// ref: https://www.vaultproject.io/docs/configuration/listener/tcp
+type TCPListenerSpec struct {
+	// Specifies the minimum supported version of TLS.
+	// Accepted values are "tls10", "tls11", "tls12" or "tls13".
+	// +optional
+	TLSMinVersion string `json:"tlsMinVersion,omitempty"`
+
+	// Specifies the list of supported ciphersuites.
+	// +optional
+	TLSCipherSuites []string `json:"tlsCipherSuites,omitempty"`
+
+	// Name of the secret containing the PEM encoded CA certificate (ca.crt)
+	// used to verify the client certificates that are presented, i.e. for the cert auth method.
+	// Client certificates are not required, because the operator, the unsealer and
+	// the readiness probe do not present them.
+	// If not specified, the system CAs are used.
+	// +optional
+	TLSClientCASecret string `json:"tlsClientCASecret,omitempty"`
+
+	// Specifies the proxy protocol behavior of the listener
+	// +optional
+	ProxyProtocol *ProxyProtocolSpec `json:"proxyProtocol,omitempty"`
+
+	// Specifies how the client address is resolved from the X-Forwarded-For header
+	// +optional
+	XForwardedFor *XForwardedForSpec `json:"xForwardedFor,omitempty"`
+}
+
+type ProxyProtocolBehavior string
+
+const (
+	ProxyProtocolBehaviorUseAlways        ProxyProtocolBehavior = "use_always"
+	ProxyProtocolBehaviorAllowAuthorized  ProxyProtocolBehavior = "allow_authorized"
+	ProxyProtocolBehaviorDenyUnauthorized ProxyProtocolBehavior = "deny_unauthorized"
+)
+
+type ProxyProtocolSpec struct {
+	// Specifies the behavior of the proxy protocol.
+	// Accepted values are "use_always", "allow_authorized" or "deny_unauthorized".
+	Behavior ProxyProtocolBehavior `json:"behavior"`
+
+	// Specifies the list of CIDRs that are authorized to send proxy protocol headers.
+	// Required if behavior is "allow_authorized" or "deny_unauthorized".
+	// +optional
+	AuthorizedAddrs []string `json:"authorizedAddrs,omitempty"`
+}
+
+type XForwardedForSpec struct {
+	// Specifies the list of CIDRs that are trusted to set the X-Forwarded-For header.
+	AuthorizedAddrs []string `json:"authorizedAddrs"`
+
+	// Specifies the number of addresses that will be skipped from the rear
+	// of the set of hops.
+	// +optional
+	HopSkips int32 `json:"hopSkips,omitempty"`
+
+	// Specifies whether to reject a request if the X-Forwarded-For header is
+	// set by an address that is not authorized.
+	// Default: true
+	// +optional
+	RejectNotAuthorized *bool `json:"rejectNotAuthorized,omitempty"`
+
+	// Specifies whether to reject a request from an authorized address
+	// if the X-Forwarded-For header is not present.
+	// Default: true
+	// +optional
+	RejectNotPresent *bool `json:"rejectNotPresent,omitempty"`
+}
+
+// ServerConfigSpec defines the server level configuration of vault
+// ref: https://www.vaultproject.io/docs/configuration
+type ServerConfigSpec struct {
+	// Enables the built-in web UI
+	// +optional
+	UI bool `json:"ui,omitempty"`
+
+	// Specifies the log level of vault.
+	// Accepted values are "trace", "debug", "info", "warn" or "err".
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// Specifies the default lease duration for tokens and secrets
+	// +optional
+	DefaultLeaseTTL *metav1.Duration `json:"defaultLeaseTTL,omitempty"`
+
+	// Specifies the maximum possible lease duration for tokens and secrets
+	// +optional
+	MaxLeaseTTL *metav1.Duration `json:"maxLeaseTTL,omitempty"`
+
+	// Disables the server from executing the mlock syscall
+	// +optional
+	DisableMlock bool `json:"disableMlock,omitempty"`
+
+	// Specifies the size of the read cache used by the physical storage subsystem
+	// +optional
+	CacheSize int64 `json:"cacheSize,omitempty"`
 }
 
 // TODO : set defaults and validation
@@ -686,6 +868,39 @@ type UnsealerSpec struct {
 	// mode contains unseal mechanism
 	// +optional
 	Mode ModeSpec `json:"mode,omitempty"`
+
+	// PGPKeys is the list of public keys of the unseal key custodians.
+	// If specified, each unseal key share is encrypted to the corresponding
+	// custodian at initialization and published to a Secret of its own.
+	// The number of keys must be equal to SecretShares.
+	// It is only supported with the kubernetesSecret mode and requires an
+	// unsealer image that supports pgp keys (spec.unsealer.pgpKeys of the VaultServerVersion).
+	// +optional
+	PGPKeys []PGPKeySource `json:"pgpKeys,omitempty"`
+
+	// RootTokenPGPKey is the public key used to encrypt the initial root token.
+	// +optional
+	RootTokenPGPKey *PGPKeySource `json:"rootTokenPGPKey,omitempty"`
+
+	// UnsealKeysSecret is the name of the secret containing the decrypted
+	// unseal key shares supplied by the custodians. It is only used when
+	// PGPKeys is specified and can be deleted once vault is unsealed.
+	// secret data:
+	//	- unseal-key-<custodian>:<value>
+	// +optional
+	UnsealKeysSecret string `json:"unsealKeysSecret,omitempty"`
+}
+
+// PGPKeySource refers to a PGP public key of an unseal key custodian
+type PGPKeySource struct {
+	// Name of the custodian owning the key. Required for unseal key shares.
+	// The encrypted unseal key share is published to the secret
+	// <vaultserver-name>-unseal-key-<custodian>.
+	// +optional
+	Custodian string `json:"custodian,omitempty"`
+
+	// Selects a key of a ConfigMap containing the base64 encoded PGP public key.
+	ConfigMapKeyRef core.ConfigMapKeySelector `json:"configMapKeyRef"`
 }
 
 // ModeSpec contain unseal mechanism
@@ -825,6 +1040,13 @@ type AuthMethod struct {
 	// Specifies if the auth method is a local only. Local auth methods are not replicated nor (if a secondary) removed by replication.
 	// +optional
 	Local bool `json:"local,omitempty"`
+
+	// Specifies the configuration of the jwt or oidc type auth method.
+	// If oidcDiscoveryURL, jwksURL and jwtValidationPubKeys are empty,
+	// the auth method is configured with the public keys of the
+	// cluster's service account issuer, which are re-synced periodically.
+	// +optional
+	JWTConfig *JWTAuthConfig `json:"jwtConfig,omitempty"`
 }
 
 type AuthMethodEnableDisableStatus string
@@ -834,6 +1056,7 @@ const (
 	AuthMethodEnableFailed     AuthMethodEnableDisableStatus = "EnableFailed"
 	AuthMethodDisableSucceeded AuthMethodEnableDisableStatus = "DisableSucceeded"
 	AuthMethodDisableFailed    AuthMethodEnableDisableStatus = "DisableFailed"
+	AuthMethodConfigureFailed  AuthMethodEnableDisableStatus = "ConfigureFailed"
 )
 
 // AuthMethodStatus specifies the status of the auth method maintained by the auth method controller
@@ -847,6 +1070,11 @@ type AuthMethodStatus struct {
 	// Specifies whether auth method is enabled or not
 	Status AuthMethodEnableDisableStatus `json:"status"`
 
+	// Accessor of the auth method mount. It is used to create the identity
+	// entity and group aliases for the users of this auth method.
+	// +optional
+	Accessor string `json:"accessor,omitempty"`
+
 	// Specifies the reason why failed to enable auth method
 	// +optional
 	Reason string `json:"reason,omitempty"`
@@ -881,3 +1109,39 @@ type AuthConfig struct {
 	// +optional
 	PassthroughRequestHeaders []string `json:"passthroughRequestHeaders,omitempty"`
 }
+
+// JWTAuthConfig specifies the configuration of the jwt or oidc type auth method
+// More info: https://www.vaultproject.io/api/auth/jwt/index.html#configure
+type JWTAuthConfig struct {
+	// The OIDC discovery URL, without any .well-known component (base path).
+	// +optional
+	OIDCDiscoveryURL string `json:"oidcDiscoveryURL,omitempty"`
+
+	// The CA certificate or chain of certificates, in PEM format,
+	// to use to validate connections to the OIDC discovery URL.
+	// +optional
+	OIDCDiscoveryCAPem string `json:"oidcDiscoveryCAPem,omitempty"`
+
+	// JWKS URL to use to authenticate signatures.
+	// +optional
+	JWKSURL string `json:"jwksURL,omitempty"`
+
+	// The CA certificate or chain of certificates, in PEM format,
+	// to use to validate connections to the JWKS URL.
+	// +optional
+	JWKSCAPem string `json:"jwksCAPem,omitempty"`
+
+	// A list of PEM-encoded public keys to use to authenticate signatures locally.
+	// +optional
+	JWTValidationPubKeys []string `json:"jwtValidationPubKeys,omitempty"`
+
+	// The value against which to match the iss claim in a JWT.
+	// Defaults to the issuer of the cluster's service account tokens,
+	// if the public keys of the service account issuer are used.
+	// +optional
+	BoundIssuer string `json:"boundIssuer,omitempty"`
+
+	// The default role to use if none is provided during login.
+	// +optional
+	DefaultRole string `json:"defaultRole,omitempty"`
+}
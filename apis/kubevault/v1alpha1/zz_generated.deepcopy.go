@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	apiv1 "kmodules.xyz/monitoring-agent-api/api/v1"
 )
@@ -65,6 +66,11 @@ func (in *AuthMethod) DeepCopyInto(out *AuthMethod) {
 		*out = new(AuthConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.JWTConfig != nil {
+		in, out := &in.JWTConfig, &out.JWTConfig
+		*out = new(JWTAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -213,6 +219,23 @@ func (in *BackendStorageSpec) DeepCopy() *BackendStorageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
+func (in *CertificateStatus) DeepCopy() *CertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConsulSpec) DeepCopyInto(out *ConsulSpec) {
 	*out = *in
@@ -309,6 +332,22 @@ func (in *GoogleKmsGcsSpec) DeepCopy() *GoogleKmsGcsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheckSpec) DeepCopyInto(out *HealthCheckSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheckSpec.
+func (in *HealthCheckSpec) DeepCopy() *HealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InmemSpec) DeepCopyInto(out *InmemSpec) {
 	*out = *in
@@ -325,6 +364,166 @@ func (in *InmemSpec) DeepCopy() *InmemSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthConfig) DeepCopyInto(out *JWTAuthConfig) {
+	*out = *in
+	if in.JWTValidationPubKeys != nil {
+		in, out := &in.JWTValidationPubKeys, &out.JWTValidationPubKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthConfig.
+func (in *JWTAuthConfig) DeepCopy() *JWTAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthCluster) DeepCopyInto(out *KubernetesAuthCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthCluster.
+func (in *KubernetesAuthCluster) DeepCopy() *KubernetesAuthCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesAuthCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthClusterCondition) DeepCopyInto(out *KubernetesAuthClusterCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthClusterCondition.
+func (in *KubernetesAuthClusterCondition) DeepCopy() *KubernetesAuthClusterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthClusterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthClusterList) DeepCopyInto(out *KubernetesAuthClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubernetesAuthCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthClusterList.
+func (in *KubernetesAuthClusterList) DeepCopy() *KubernetesAuthClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubernetesAuthClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthClusterSpec) DeepCopyInto(out *KubernetesAuthClusterSpec) {
+	*out = *in
+	out.VaultRef = in.VaultRef
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenReviewerServiceAccount != nil {
+		in, out := &in.TokenReviewerServiceAccount, &out.TokenReviewerServiceAccount
+		*out = new(TokenReviewerServiceAccount)
+		**out = **in
+	}
+	if in.TokenReviewerJWT != nil {
+		in, out := &in.TokenReviewerJWT, &out.TokenReviewerJWT
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenRefreshInterval != nil {
+		in, out := &in.TokenRefreshInterval, &out.TokenRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthClusterSpec.
+func (in *KubernetesAuthClusterSpec) DeepCopy() *KubernetesAuthClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesAuthClusterStatus) DeepCopyInto(out *KubernetesAuthClusterStatus) {
+	*out = *in
+	if in.TokenExpirationTime != nil {
+		in, out := &in.TokenExpirationTime, &out.TokenExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]KubernetesAuthClusterCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesAuthClusterStatus.
+func (in *KubernetesAuthClusterStatus) DeepCopy() *KubernetesAuthClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesAuthClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesSecretSpec) DeepCopyInto(out *KubernetesSecretSpec) {
 	*out = *in
@@ -393,6 +592,23 @@ func (in *MySQLSpec) DeepCopy() *MySQLSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PGPKeySource) DeepCopyInto(out *PGPKeySource) {
+	*out = *in
+	in.ConfigMapKeyRef.DeepCopyInto(&out.ConfigMapKeyRef)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PGPKeySource.
+func (in *PGPKeySource) DeepCopy() *PGPKeySource {
+	if in == nil {
+		return nil
+	}
+	out := new(PGPKeySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
 	*out = *in
@@ -409,6 +625,27 @@ func (in *PostgreSQLSpec) DeepCopy() *PostgreSQLSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyProtocolSpec) DeepCopyInto(out *ProxyProtocolSpec) {
+	*out = *in
+	if in.AuthorizedAddrs != nil {
+		in, out := &in.AuthorizedAddrs, &out.AuthorizedAddrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyProtocolSpec.
+func (in *ProxyProtocolSpec) DeepCopy() *ProxyProtocolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyProtocolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Spec) DeepCopyInto(out *S3Spec) {
 	*out = *in
@@ -425,6 +662,32 @@ func (in *S3Spec) DeepCopy() *S3Spec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerConfigSpec) DeepCopyInto(out *ServerConfigSpec) {
+	*out = *in
+	if in.DefaultLeaseTTL != nil {
+		in, out := &in.DefaultLeaseTTL, &out.DefaultLeaseTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxLeaseTTL != nil {
+		in, out := &in.MaxLeaseTTL, &out.MaxLeaseTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerConfigSpec.
+func (in *ServerConfigSpec) DeepCopy() *ServerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SwiftSpec) DeepCopyInto(out *SwiftSpec) {
 	*out = *in
@@ -441,6 +704,37 @@ func (in *SwiftSpec) DeepCopy() *SwiftSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPListenerSpec) DeepCopyInto(out *TCPListenerSpec) {
+	*out = *in
+	if in.TLSCipherSuites != nil {
+		in, out := &in.TLSCipherSuites, &out.TLSCipherSuites
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(ProxyProtocolSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.XForwardedFor != nil {
+		in, out := &in.XForwardedFor, &out.XForwardedFor
+		*out = new(XForwardedForSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPListenerSpec.
+func (in *TCPListenerSpec) DeepCopy() *TCPListenerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPListenerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSPolicy) DeepCopyInto(out *TLSPolicy) {
 	*out = *in
@@ -449,6 +743,16 @@ func (in *TLSPolicy) DeepCopyInto(out *TLSPolicy) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(v1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -462,10 +766,70 @@ func (in *TLSPolicy) DeepCopy() *TLSPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSStatus) DeepCopyInto(out *TLSStatus) {
+	*out = *in
+	if in.ServerCertificate != nil {
+		in, out := &in.ServerCertificate, &out.ServerCertificate
+		*out = new(CertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACertificates != nil {
+		in, out := &in.CACertificates, &out.CACertificates
+		*out = make([]CertificateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RenewalTime != nil {
+		in, out := &in.RenewalTime, &out.RenewalTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSStatus.
+func (in *TLSStatus) DeepCopy() *TLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenReviewerServiceAccount) DeepCopyInto(out *TokenReviewerServiceAccount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenReviewerServiceAccount.
+func (in *TokenReviewerServiceAccount) DeepCopy() *TokenReviewerServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenReviewerServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnsealerSpec) DeepCopyInto(out *UnsealerSpec) {
 	*out = *in
 	in.Mode.DeepCopyInto(&out.Mode)
+	if in.PGPKeys != nil {
+		in, out := &in.PGPKeys, &out.PGPKeys
+		*out = make([]PGPKeySource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RootTokenPGPKey != nil {
+		in, out := &in.RootTokenPGPKey, &out.RootTokenPGPKey
+		*out = new(PGPKeySource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -576,6 +940,21 @@ func (in *VaultServerSpec) DeepCopyInto(out *VaultServerSpec) {
 		*out = new(TLSPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Listener != nil {
+		in, out := &in.Listener, &out.Listener
+		*out = new(TCPListenerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerConfig != nil {
+		in, out := &in.ServerConfig, &out.ServerConfig
+		*out = new(ServerConfigSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheckSpec)
+		**out = **in
+	}
 	in.Backend.DeepCopyInto(&out.Backend)
 	if in.Unsealer != nil {
 		in, out := &in.Unsealer, &out.Unsealer
@@ -628,6 +1007,11 @@ func (in *VaultServerStatus) DeepCopyInto(out *VaultServerStatus) {
 		*out = make([]AuthMethodStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -671,3 +1055,34 @@ func (in *VaultStatus) DeepCopy() *VaultStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *XForwardedForSpec) DeepCopyInto(out *XForwardedForSpec) {
+	*out = *in
+	if in.AuthorizedAddrs != nil {
+		in, out := &in.AuthorizedAddrs, &out.AuthorizedAddrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RejectNotAuthorized != nil {
+		in, out := &in.RejectNotAuthorized, &out.RejectNotAuthorized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RejectNotPresent != nil {
+		in, out := &in.RejectNotPresent, &out.RejectNotPresent
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new XForwardedForSpec.
+func (in *XForwardedForSpec) DeepCopy() *XForwardedForSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(XForwardedForSpec)
+	in.DeepCopyInto(out)
+	return out
+}
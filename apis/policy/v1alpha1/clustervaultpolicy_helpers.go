@@ -0,0 +1,87 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	"fmt"
+
+	"kubevault.dev/operator/apis"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+	"kmodules.xyz/client-go/tools/clusterid"
+)
+
+func (v ClusterVaultPolicy) GetKey() string {
+	return ResourceClusterVaultPolicy + "/" + v.Name
+}
+
+// PolicyName returns the vault policy name of the ClusterVaultPolicy.
+// The default name is prefixed with "cluster_", which is not valid in kubernetes
+// names, so it never collides with the policy of a VaultPolicy.
+func (v ClusterVaultPolicy) PolicyName() string {
+	if v.Spec.VaultPolicyName != "" {
+		return v.Spec.VaultPolicyName
+	}
+
+	cluster := "-"
+	if clusterid.ClusterName() != "" {
+		cluster = clusterid.ClusterName()
+	}
+	return fmt.Sprintf("k8s.%s.cluster_%s", cluster, v.Name)
+}
+
+func (v ClusterVaultPolicy) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourceClusterVaultPolicies,
+		Singular:      ResourceClusterVaultPolicy,
+		Kind:          ResourceKindClusterVaultPolicy,
+		ShortNames:    []string{"cvp"},
+		Categories:    []string{"vault", "policy", "appscode", "all"},
+		ResourceScope: string(apiextensions.ClusterScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "vault"},
+		},
+		SpecDefinitionName:      "kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicy",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Phase",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	}, apis.SetNameSchema)
+}
+
+func (v ClusterVaultPolicy) IsValid() error {
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+const (
+	ResourceKindClusterVaultPolicy = "ClusterVaultPolicy"
+	ResourceClusterVaultPolicy     = "clustervaultpolicy"
+	ResourceClusterVaultPolicies   = "clustervaultpolicies"
+)
+
+// ClusterVaultPolicy is a cluster scoped vault policy that can be referred by VaultPolicyBindings of any namespace.
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clustervaultpolicies,singular=clustervaultpolicy,scope=Cluster,shortName=cvp,categories={vault,policy,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ClusterVaultPolicy struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterVaultPolicySpec   `json:"spec,omitempty"`
+	Status            ClusterVaultPolicyStatus `json:"status,omitempty"`
+}
+
+type ClusterVaultPolicySpec struct {
+	// VaultRef refers to the AppBinding of the Vault Server
+	VaultRef appcat.AppReference `json:"vaultRef"`
+
+	// VaultPolicyName is the policy name set inside Vault.
+	// This defaults to following format: k8s.${cluster}.cluster_${metadata.name}
+	// The prefix "k8s." is reserved for the generated policy names.
+	// +optional
+	VaultPolicyName string `json:"vaultPolicyName,omitempty"`
+
+	// PolicyDocument specifies a vault policy in hcl format.
+	// +optional
+	PolicyDocument string `json:"policyDocument,omitempty"`
+
+	// Policy specifies a vault policy in json format.
+	// +optional
+	Policy *runtime.RawExtension `json:"policy,omitempty"`
+
+	// AggregationSelector selects the ClusterVaultPolicies whose rules are aggregated into this policy.
+	// The policy set in vault is the union of the rules of this policy and the selected policies.
+	// Only the policyDocument or policy of the selected policies are aggregated, not their aggregated rules.
+	// +optional
+	AggregationSelector *metav1.LabelSelector `json:"aggregationSelector,omitempty"`
+}
+
+type ClusterVaultPolicyStatus struct {
+	// ObservedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase indicates whether the policy successfully applied in vault or not or in progress
+	// +optional
+	Phase PolicyPhase `json:"phase,omitempty"`
+
+	// AggregatedPolicies is the list of the ClusterVaultPolicies aggregated into this policy
+	// +optional
+	AggregatedPolicies []string `json:"aggregatedPolicies,omitempty"`
+
+	// Represents the latest available observations of a ClusterVaultPolicy.
+	// +optional
+	Conditions []PolicyCondition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+type ClusterVaultPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterVaultPolicy `json:"items,omitempty"`
+}
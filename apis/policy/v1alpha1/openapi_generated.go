@@ -345,12 +345,38 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kmodules.xyz/offshoot-api/api/v1.ServicePort":                                schema_kmodulesxyz_offshoot_api_api_v1_ServicePort(ref),
 		"kmodules.xyz/offshoot-api/api/v1.ServiceSpec":                                schema_kmodulesxyz_offshoot_api_api_v1_ServiceSpec(ref),
 		"kmodules.xyz/offshoot-api/api/v1.ServiceTemplateSpec":                        schema_kmodulesxyz_offshoot_api_api_v1_ServiceTemplateSpec(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.AWSSubjectRef":                   schema_operator_apis_policy_v1alpha1_AWSSubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.AppRoleSubjectRef":               schema_operator_apis_policy_v1alpha1_AppRoleSubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.AzureSubjectRef":                 schema_operator_apis_policy_v1alpha1_AzureSubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.CertSubjectRef":                  schema_operator_apis_policy_v1alpha1_CertSubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicy":              schema_operator_apis_policy_v1alpha1_ClusterVaultPolicy(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicyList":          schema_operator_apis_policy_v1alpha1_ClusterVaultPolicyList(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicySpec":          schema_operator_apis_policy_v1alpha1_ClusterVaultPolicySpec(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicyStatus":        schema_operator_apis_policy_v1alpha1_ClusterVaultPolicyStatus(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.GCPSubjectRef":                   schema_operator_apis_policy_v1alpha1_GCPSubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.IdentityAlias":                   schema_operator_apis_policy_v1alpha1_IdentityAlias(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.IdentityAliasStatus":             schema_operator_apis_policy_v1alpha1_IdentityAliasStatus(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.JWTSubjectRef":                   schema_operator_apis_policy_v1alpha1_JWTSubjectRef(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.KubernetesSubjectRef":            schema_operator_apis_policy_v1alpha1_KubernetesSubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.LDAPSubjectRef":                  schema_operator_apis_policy_v1alpha1_LDAPSubjectRef(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.PolicyBindingCondition":          schema_operator_apis_policy_v1alpha1_PolicyBindingCondition(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition":                 schema_operator_apis_policy_v1alpha1_PolicyCondition(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.PolicyIdentifier":                schema_operator_apis_policy_v1alpha1_PolicyIdentifier(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.RenderedPolicy":                  schema_operator_apis_policy_v1alpha1_RenderedPolicy(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.ServiceAccountAlias":             schema_operator_apis_policy_v1alpha1_ServiceAccountAlias(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.ServiceAccountReference":         schema_operator_apis_policy_v1alpha1_ServiceAccountReference(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.SharedSubjectRef":                schema_operator_apis_policy_v1alpha1_SharedSubjectRef(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.SubjectRef":                      schema_operator_apis_policy_v1alpha1_SubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.TokenParams":                     schema_operator_apis_policy_v1alpha1_TokenParams(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.UserPassSubjectRef":              schema_operator_apis_policy_v1alpha1_UserPassSubjectRef(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntity":             schema_operator_apis_policy_v1alpha1_VaultIdentityEntity(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntityList":         schema_operator_apis_policy_v1alpha1_VaultIdentityEntityList(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntitySpec":         schema_operator_apis_policy_v1alpha1_VaultIdentityEntitySpec(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntityStatus":       schema_operator_apis_policy_v1alpha1_VaultIdentityEntityStatus(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroup":              schema_operator_apis_policy_v1alpha1_VaultIdentityGroup(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroupList":          schema_operator_apis_policy_v1alpha1_VaultIdentityGroupList(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroupSpec":          schema_operator_apis_policy_v1alpha1_VaultIdentityGroupSpec(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroupStatus":        schema_operator_apis_policy_v1alpha1_VaultIdentityGroupStatus(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicy":                     schema_operator_apis_policy_v1alpha1_VaultPolicy(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyBinding":              schema_operator_apis_policy_v1alpha1_VaultPolicyBinding(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyBindingList":          schema_operator_apis_policy_v1alpha1_VaultPolicyBindingList(ref),
@@ -359,6 +385,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyList":                 schema_operator_apis_policy_v1alpha1_VaultPolicyList(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicySpec":                 schema_operator_apis_policy_v1alpha1_VaultPolicySpec(ref),
 		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyStatus":               schema_operator_apis_policy_v1alpha1_VaultPolicyStatus(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplate":             schema_operator_apis_policy_v1alpha1_VaultPolicyTemplate(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplateList":         schema_operator_apis_policy_v1alpha1_VaultPolicyTemplateList(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplateSpec":         schema_operator_apis_policy_v1alpha1_VaultPolicyTemplateSpec(ref),
+		"kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplateStatus":       schema_operator_apis_policy_v1alpha1_VaultPolicyTemplateStatus(ref),
 	}
 }
 
@@ -15649,23 +15679,30 @@ func schema_kmodulesxyz_offshoot_api_api_v1_ServiceTemplateSpec(ref common.Refer
 	}
 }
 
-func schema_operator_apis_policy_v1alpha1_KubernetesSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_policy_v1alpha1_AWSSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "More info: https://www.vaultproject.io/api/auth/kubernetes/index.html#create-role",
+				Description: "More info: https://www.vaultproject.io/api/auth/aws/index.html#create-role",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the path where kubernetes auth is enabled default : kubernetes",
+							Description: "Specifies the path where AWS auth is enabled default : aws",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"serviceAccountNames": {
+					"authType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the names of the service account to bind with policy",
+							Description: "Specifies the auth type permitted for this role default : iam",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"boundIAMPrincipalARNs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the IAM principal ARNs that are permitted to login. Used for iam auth type.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -15677,9 +15714,107 @@ func schema_operator_apis_policy_v1alpha1_KubernetesSubjectRef(ref common.Refere
 							},
 						},
 					},
-					"serviceAccountNamespaces": {
+					"boundAccountIDs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the namespaces of the service account",
+							Description: "Specifies the account IDs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundRegions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the regions that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundVPCIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the VPC IDs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundSubnetIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the subnet IDs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundAMIIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the AMI IDs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundIAMRoleARNs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the IAM role ARNs of the instances that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundIAMInstanceProfileARNs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the IAM instance profile ARNs of the instances that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundEC2InstanceIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the EC2 instance IDs that are permitted to login",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -15691,185 +15826,2027 @@ func schema_operator_apis_policy_v1alpha1_KubernetesSubjectRef(ref common.Refere
 							},
 						},
 					},
+					"inferredEntityType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the type of entity inferred from the IAM principal, e.g. ec2_instance",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inferredAWSRegion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the region to search for the inferred entities",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the TTL period of tokens issued using this role in seconds.",
+							Description: "Specifies the TTL period of tokens issued using this role.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"maxTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies the maximum allowed lifetime of tokens issued in seconds using this role.",
+							Description: "Specifies the maximum allowed lifetime of tokens issued using this role.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"period": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value. At each renewal, the token's TTL will be set to the value of this parameter.",
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"serviceAccountNames", "serviceAccountNamespaces"},
 			},
 		},
 	}
 }
 
-func schema_operator_apis_policy_v1alpha1_PolicyBindingCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_policy_v1alpha1_AppRoleSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PolicyBindingCondition describes the state of a VaultPolicyBinding at a certain point.",
+				Description: "More info: https://www.vaultproject.io/api/auth/approle/index.html#create-update-approle",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of PolicyBindingCondition condition.",
+							Description: "Specifies the path where AppRole auth is enabled default : approle",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"bindSecretID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
+							Description: "Specifies whether a SecretID is required to login using this role default : true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secretIDBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can perform the login operation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secretIDNumUses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the number of times a SecretID can be used to login. 0 means unlimited.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"secretIDTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL of the SecretIDs generated against this role",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's.",
+							Description: "Specifies the TTL period of tokens issued using this role.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"maxTTL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "Specifies the maximum allowed lifetime of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_operator_apis_policy_v1alpha1_PolicyCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_policy_v1alpha1_AzureSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PolicyCondition describes the state of a VaultPolicy at a certain point.",
+				Description: "More info: https://www.vaultproject.io/api/auth/azure/index.html#create-role",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of PolicyCondition condition.",
+							Description: "Specifies the path where Azure auth is enabled default : azure",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"boundServicePrincipalIDs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
+							Description: "Specifies the service principal IDs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundGroupIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the group IDs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundLocations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the locations that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundSubscriptionIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the subscription IDs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundResourceGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the resource groups that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundScaleSets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the scale sets that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL period of tokens issued using this role.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum allowed lifetime of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_CertSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/auth/cert/index.html#create-ca-certificate-role",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where TLS certificates auth is enabled default : cert",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certificate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the PEM encoded CA certificate used to verify the client certificates",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowedCommonNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the common names that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allowedDNSSANs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the DNS SANs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allowedEmailSANs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the email SANs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allowedURISANs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the URI SANs that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allowedOrganizationalUnits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the organizational units that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"requiredExtensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the extensions in oid:value format that the client certificates must have",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL period of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum allowed lifetime of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"certificate"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_ClusterVaultPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicySpec", "kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicyStatus"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_ClusterVaultPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/policy/v1alpha1.ClusterVaultPolicy"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_ClusterVaultPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef refers to the AppBinding of the Vault Server",
+							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
+						},
+					},
+					"vaultPolicyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultPolicyName is the policy name set inside Vault. This defaults to following format: k8s.${cluster}.cluster_${metadata.name} The prefix \"k8s.\" is reserved for the generated policy names.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policyDocument": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PolicyDocument specifies a vault policy in hcl format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy specifies a vault policy in json format.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"aggregationSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AggregationSelector selects the ClusterVaultPolicies whose rules are aggregated into this policy. The policy set in vault is the union of the rules of this policy and the selected policies. Only the policyDocument or policy of the selected policies are aggregated, not their aggregated rules.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"vaultRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/runtime.RawExtension", "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_ClusterVaultPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase indicates whether the policy successfully applied in vault or not or in progress",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"aggregatedPolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AggregatedPolicies is the list of the ClusterVaultPolicies aggregated into this policy",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a ClusterVaultPolicy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_GCPSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/auth/gcp/index.html#create-role",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where GCP auth is enabled default : gcp",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the type of this role default : iam",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"boundServiceAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the service account emails or IDs that are permitted to login. Required for iam type.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundProjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the projects that are permitted to login",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundZones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the zones of the instances that are permitted to login. Used for gce type.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundRegions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the regions of the instances that are permitted to login. Used for gce type.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundInstanceGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the instance groups that are permitted to login. Used for gce type.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the instance labels in key:value format that are permitted to login. Used for gce type.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"addGroupAliases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies whether to add group aliases to the auth responses",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxJWTExp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum allowed lifetime of the JWT used to login. Used for iam type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL period of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum allowed lifetime of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_IdentityAlias(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IdentityAlias maps a user of an auth method to an entity or a group",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the alias. It should be the name of the user or group returned by the auth method, e.g. the name of a github team.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountPath is the path where the auth method is enabled. The mount accessor is resolved from the VaultServer's .status.authMethodStatus or from the auth methods enabled in vault.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountAccessor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountAccessor is the accessor of the auth method. It takes precedence over the mountPath.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_IdentityAliasStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IdentityAliasStatus is an alias created in vault",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the alias",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountAccessor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountAccessor of the auth method",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID of the alias in vault",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "mountAccessor", "id"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_JWTSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/auth/jwt/index.html#create-role",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where JWT/OIDC auth is enabled default : jwt for jwt and oidc for oidc",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the claim to use to uniquely identify the user default : sub",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"boundAudiences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the list of aud claims to match against",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"boundSubject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the sub claim to match against",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"boundClaims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the claims to match against",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"groupsClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the claim to use to uniquely identify the set of groups to which the user belongs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"claimMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the mapping of claims to metadata keys",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allowedRedirectURIs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the list of allowed redirect URIs. Required for OIDC.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"oidcScopes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the OIDC scopes to be used",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL period of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum allowed lifetime of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_KubernetesSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/auth/kubernetes/index.html#create-role",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where kubernetes auth is enabled default : kubernetes",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the name of a KubernetesAuthCluster in the same namespace. If set, the role is created in the kubernetes auth method of that remote cluster, and path is ignored.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serviceAccountNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the names of the service account to bind with policy",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"serviceAccountNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the namespaces of the service account",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL period of tokens issued using this role in seconds.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum allowed lifetime of tokens issued in seconds using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value. At each renewal, the token's TTL will be set to the value of this parameter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"serviceAccountNames", "serviceAccountNamespaces"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_LDAPSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/auth/ldap/index.html#create-update-ldap-group",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where LDAP auth is enabled default : ldap",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the LDAP groups to grant the policies. The policies of the binding are added to the existing policies of the groups and users, and removed when the binding is deleted. The groups and users are never deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"users": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the LDAP users to grant the policies",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_PolicyBindingCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyBindingCondition describes the state of a VaultPolicyBinding at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of PolicyBindingCondition condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_PolicyCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PolicyCondition describes the state of a VaultPolicy at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of PolicyCondition condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_PolicyIdentifier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is a Vault server policy name. This name should be returned by `vault read sys/policy` command. More info: https://www.vaultproject.io/docs/concepts/policies.html#listing-policies",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ref": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ref is name of a VaultPolicy crd object. Actual vault policy name is spec.vaultRoleName field. More info: https://www.vaultproject.io/docs/concepts/policies.html#listing-policies",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterRef is name of a ClusterVaultPolicy crd object. Actual vault policy name is spec.vaultPolicyName field. The user creating or updating the object must be allowed to bind the ClusterVaultPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_RenderedPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RenderedPolicy is a vault policy rendered for a namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace for which the policy is rendered",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the policy in vault",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_ServiceAccountAlias(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceAccountAlias maps a kubernetes service account to an entity",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the service account",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the service account, defaults to the namespace of the entity. It must be the namespace of the entity.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountPath is the path where the kubernetes auth method is enabled. Default: kubernetes",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"aliasNameSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AliasNameSource must match the alias_name_source of the kubernetes auth role. For serviceaccount_uid, the alias name is the uid of the service account. For serviceaccount_name, the alias name is ${namespace}/${name}. Default: serviceaccount_uid",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_ServiceAccountReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceAccountReference contains name and namespace of the service account",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name", "namespace"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_SharedSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SharedSubjectRef contains the subjects of a VaultPolicyBinding, which are shared with other bindings",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userpass": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.UserPassSubjectRef"),
+						},
+					},
+					"ldap": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.LDAPSubjectRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/policy/v1alpha1.LDAPSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.UserPassSubjectRef"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_SubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes refers to Vault users who are authenticated via Kubernetes auth method More info: https://www.vaultproject.io/docs/auth/kubernetes.html#configuration",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.KubernetesSubjectRef"),
+						},
+					},
+					"appRole": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppRole refers to Vault users who are authenticated via AppRole auth method More info: https://www.vaultproject.io/docs/auth/approle.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.AppRoleSubjectRef"),
+						},
+					},
+					"jwt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JWT refers to Vault users who are authenticated via JWT auth method More info: https://www.vaultproject.io/docs/auth/jwt.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.JWTSubjectRef"),
+						},
+					},
+					"oidc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OIDC refers to Vault users who are authenticated via OIDC auth method More info: https://www.vaultproject.io/docs/auth/jwt.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.JWTSubjectRef"),
+						},
+					},
+					"aws": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AWS refers to Vault users who are authenticated via AWS auth method More info: https://www.vaultproject.io/docs/auth/aws.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.AWSSubjectRef"),
+						},
+					},
+					"gcp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCP refers to Vault users who are authenticated via GCP auth method More info: https://www.vaultproject.io/docs/auth/gcp.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.GCPSubjectRef"),
+						},
+					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure refers to Vault users who are authenticated via Azure auth method More info: https://www.vaultproject.io/docs/auth/azure.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.AzureSubjectRef"),
+						},
+					},
+					"cert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cert refers to Vault users who are authenticated via TLS certificates auth method More info: https://www.vaultproject.io/docs/auth/cert.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.CertSubjectRef"),
+						},
+					},
+					"userpass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserPass refers to Vault users who are authenticated via Userpass auth method More info: https://www.vaultproject.io/docs/auth/userpass.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.UserPassSubjectRef"),
+						},
+					},
+					"ldap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LDAP refers to Vault users who are authenticated via LDAP auth method More info: https://www.vaultproject.io/docs/auth/ldap.html",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.LDAPSubjectRef"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/policy/v1alpha1.AWSSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.AppRoleSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.AzureSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.CertSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.GCPSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.JWTSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.KubernetesSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.LDAPSubjectRef", "kubevault.dev/operator/apis/policy/v1alpha1.UserPassSubjectRef"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_TokenParams(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenParams are the parameters of the tokens issued using a role",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ttl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the TTL period of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the maximum allowed lifetime of tokens issued using this role.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, indicates that the token generated using this role should never expire. The token should be renewed within the duration specified by this value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenBoundCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_UserPassSubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/auth/userpass/index.html#update-policies-on-user",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the path where Userpass auth is enabled default : userpass",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usernames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specifies the existing users to grant the policies. The policies of the binding are added to the existing policies of these users, and removed when the binding is deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"usernames"},
+			},
+		},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultIdentityEntity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntitySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntityStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntitySpec", "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntityStatus"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultIdentityEntityList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntity"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultIdentityEntitySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/secret/identity/entity.html",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"entityName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EntityName is the name of the entity in vault. This defaults to following format: k8s.${cluster}.${metadata.namespace}.${metadata.name} If set, it replaces ${metadata.name} in the above format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policies to be tied to the entity",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.PolicyIdentifier"),
+									},
+								},
+							},
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata to be associated with the entity. It can be used in the templated policies, e.g. {{identity.entity.metadata.team}}",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled specifies whether the entity is disabled. Disabled entities' associated tokens cannot be used, but are not revoked.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"aliases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aliases of the entity in the auth methods",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.IdentityAlias"),
+									},
+								},
+							},
+						},
+					},
+					"serviceAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccounts are the kubernetes service accounts mapped to this entity. An alias is created in the kubernetes auth method for each of the service accounts.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.ServiceAccountAlias"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"vaultRef"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/policy/v1alpha1.IdentityAlias", "kubevault.dev/operator/apis/policy/v1alpha1.PolicyIdentifier", "kubevault.dev/operator/apis/policy/v1alpha1.ServiceAccountAlias"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultIdentityEntityStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase indicates whether the entity successfully applied in vault or not",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID of the entity in vault",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"aliases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aliases created in vault for this entity",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.IdentityAliasStatus"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a VaultIdentityEntity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/policy/v1alpha1.IdentityAliasStatus", "kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultIdentityGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroupStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroupSpec", "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroupStatus"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultIdentityGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroup"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultIdentityGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "More info: https://www.vaultproject.io/api/secret/identity/group.html",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef is the name of a AppBinding referencing to a Vault Server",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"groupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupName is the name of the group in vault. This defaults to following format: k8s.${cluster}.${metadata.namespace}.${metadata.name} If set, it replaces ${metadata.name} in the above format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the group, internal or external. The members of the internal groups are managed explicitly, whereas the members of the external groups are managed by the auth method via the group alias. Default: internal",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policies to be tied to the group",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.PolicyIdentifier"),
+									},
+								},
+							},
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata to be associated with the group",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"memberEntityRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberEntityRefs are the names of the VaultIdentityEntity crd objects which are members of this group. Only applicable for internal groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"memberEntityIDs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberEntityIDs are the ids of the vault entities which are members of this group. Only applicable for internal groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"memberGroupRefs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberGroupRefs are the names of the VaultIdentityGroup crd objects which are subgroups of this group. Only applicable for internal groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"memberGroupIDs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MemberGroupIDs are the ids of the vault groups which are subgroups of this group. Only applicable for internal groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"message": {
+					"alias": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Alias maps the group of an auth method to this group, e.g. a ldap group. Only applicable for external groups.",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.IdentityAlias"),
 						},
 					},
 				},
+				Required: []string{"vaultRef"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "kubevault.dev/operator/apis/policy/v1alpha1.IdentityAlias", "kubevault.dev/operator/apis/policy/v1alpha1.PolicyIdentifier"},
 	}
 }
 
-func schema_operator_apis_policy_v1alpha1_PolicyIdentifier(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_operator_apis_policy_v1alpha1_VaultIdentityGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is a Vault server policy name. This name should be returned by `vault read sys/policy` command. More info: https://www.vaultproject.io/docs/concepts/policies.html#listing-policies",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"ref": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ref is name of a VaultPolicy crd object. Actual vault policy name is spec.vaultRoleName field. More info: https://www.vaultproject.io/docs/concepts/policies.html#listing-policies",
+							Description: "Phase indicates whether the group successfully applied in vault or not",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_operator_apis_policy_v1alpha1_ServiceAccountReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ServiceAccountReference contains name and namespace of the service account",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ID of the group in vault",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"namespace": {
+					"alias": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Alias created in vault for this group",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.IdentityAliasStatus"),
 						},
 					},
-				},
-				Required: []string{"name", "namespace"},
-			},
-		},
-	}
-}
-
-func schema_operator_apis_policy_v1alpha1_SubjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kubernetes": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes refers to Vault users who are authenticated via Kubernetes auth method More info: https://www.vaultproject.io/docs/auth/kubernetes.html#configuration",
-							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.KubernetesSubjectRef"),
+							Description: "Represents the latest available observations of a VaultIdentityGroup.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/policy/v1alpha1.KubernetesSubjectRef"},
+			"kubevault.dev/operator/apis/policy/v1alpha1.IdentityAliasStatus", "kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"},
 	}
 }
 
@@ -16085,11 +18062,31 @@ func schema_operator_apis_policy_v1alpha1_VaultPolicyBindingStatus(ref common.Re
 							},
 						},
 					},
+					"sharedPolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedPolicies are the vault policies granted to the userpass and ldap subjects, which are shared with other bindings. The policies removed from the binding are revoked from these subjects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"sharedSubjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedSubjects are the userpass and ldap subjects, which are granted the SharedPolicies. The SharedPolicies are revoked from the subjects removed from the binding.",
+							Ref:         ref("kubevault.dev/operator/apis/policy/v1alpha1.SharedSubjectRef"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevault.dev/operator/apis/policy/v1alpha1.PolicyBindingCondition"},
+			"kubevault.dev/operator/apis/policy/v1alpha1.PolicyBindingCondition", "kubevault.dev/operator/apis/policy/v1alpha1.SharedSubjectRef"},
 	}
 }
 
@@ -16220,3 +18217,179 @@ func schema_operator_apis_policy_v1alpha1_VaultPolicyStatus(ref common.Reference
 			"kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"},
 	}
 }
+
+func schema_operator_apis_policy_v1alpha1_VaultPolicyTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplateStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplateSpec", "kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplateStatus"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultPolicyTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevault.dev/operator/apis/policy/v1alpha1.VaultPolicyTemplate"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultPolicyTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vaultRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VaultRef refers to the AppBinding of the Vault Server",
+							Ref:         ref("kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"),
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces for which the policy is rendered. If not specified, the policy is rendered for all namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"policyDocument": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PolicyDocument specifies a vault policy template in hcl or json format. The template is rendered using Go templates with the following data:\n\t- .Namespace: name of the namespace\n\t- .Labels: labels of the namespace\n\t- .Annotations: annotations of the namespace\nLabels and annotations whose values contain quotes, braces, backslashes or newlines can't be used. Vault identity templates (e.g. {{identity.entity.id}}) are passed to vault as is. For example: path \"kv/data/{{ .Namespace }}/*\" {\n  capabilities = [\"create\", \"read\", \"update\", \"delete\", \"list\"]\n} The rendered policy is validated like a VaultPolicy, i.e. against the policy denylist. The rendered policy is named as: k8s.${cluster}.tmpl_${namespace}.${metadata.name}",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"vaultRef", "policyDocument"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1.AppReference"},
+	}
+}
+
+func schema_operator_apis_policy_v1alpha1_VaultPolicyTemplateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this resource. It corresponds to the resource's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase indicates whether the policies are successfully applied in vault or not",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policies is the list of the vault policies rendered from the template",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.RenderedPolicy"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Represents the latest available observations of a VaultPolicyTemplate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevault.dev/operator/apis/policy/v1alpha1.PolicyCondition", "kubevault.dev/operator/apis/policy/v1alpha1.RenderedPolicy"},
+	}
+}
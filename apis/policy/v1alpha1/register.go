@@ -52,6 +52,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VaultPolicyList{},
 		&VaultPolicyBinding{},
 		&VaultPolicyBindingList{},
+		&VaultPolicyTemplate{},
+		&VaultPolicyTemplateList{},
+		&ClusterVaultPolicy{},
+		&ClusterVaultPolicyList{},
+		&VaultIdentityEntity{},
+		&VaultIdentityEntityList{},
+		&VaultIdentityGroup{},
+		&VaultIdentityGroupList{},
 	)
 
 	scheme.AddKnownTypes(SchemeGroupVersion,
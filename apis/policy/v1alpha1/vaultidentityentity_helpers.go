@@ -0,0 +1,132 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	"fmt"
+
+	"kubevault.dev/operator/apis"
+
+	"github.com/pkg/errors"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+	"kmodules.xyz/client-go/tools/clusterid"
+)
+
+func (v VaultIdentityEntity) GetKey() string {
+	return ResourceVaultIdentityEntity + "/" + v.Namespace + "/" + v.Name
+}
+
+// EntityName returns the name of the entity in vault.
+// The custom entity name is prefixed with the cluster and namespace too,
+// so that an entity of another namespace can't be taken over.
+func (v VaultIdentityEntity) EntityName() string {
+	cluster := "-"
+	if clusterid.ClusterName() != "" {
+		cluster = clusterid.ClusterName()
+	}
+	name := v.Name
+	if v.Spec.EntityName != "" {
+		name = v.Spec.EntityName
+	}
+	return fmt.Sprintf("k8s.%s.%s.%s", cluster, v.Namespace, name)
+}
+
+func (v VaultIdentityEntity) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourceVaultIdentityEntities,
+		Singular:      ResourceVaultIdentityEntity,
+		Kind:          ResourceKindVaultIdentityEntity,
+		ShortNames:    []string{"vie"},
+		Categories:    []string{"vault", "policy", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "vault"},
+		},
+		SpecDefinitionName:      "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityEntity",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Phase",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	}, apis.SetNameSchema)
+}
+
+func (v VaultIdentityEntity) IsValid() error {
+	for _, a := range v.Spec.Aliases {
+		if err := a.IsValid(); err != nil {
+			return errors.Wrap(err, "for .spec.aliases")
+		}
+	}
+	for _, sa := range v.Spec.ServiceAccounts {
+		if sa.Name == "" {
+			return errors.New("for .spec.serviceAccounts: name must be non empty")
+		}
+		if sa.Namespace != "" && sa.Namespace != v.Namespace {
+			return errors.Errorf("for .spec.serviceAccounts: service account %s/%s must be in namespace %s", sa.Namespace, sa.Name, v.Namespace)
+		}
+	}
+	return nil
+}
+
+func (a IdentityAlias) IsValid() error {
+	if a.Name == "" {
+		return errors.New("name must be non empty")
+	}
+	if a.MountPath == "" && a.MountAccessor == "" {
+		return errors.Errorf("for alias %s: one of mountPath or mountAccessor must be non empty", a.Name)
+	}
+	return nil
+}
+
+// AliasName returns the name of the alias created by the kubernetes auth method
+// for the service account
+func (sa ServiceAccountAlias) AliasName(uid string) string {
+	if sa.AliasNameSource == AliasNameSourceServiceAccountName {
+		return sa.Namespace + "/" + sa.Name
+	}
+	return uid
+}
+
+func (sa *ServiceAccountAlias) SetDefaults(namespace string) {
+	if sa.Namespace == "" {
+		sa.Namespace = namespace
+	}
+	if sa.MountPath == "" {
+		sa.MountPath = "kubernetes"
+	}
+	if sa.AliasNameSource == "" {
+		sa.AliasNameSource = AliasNameSourceServiceAccountUID
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindVaultIdentityEntity = "VaultIdentityEntity"
+	ResourceVaultIdentityEntity     = "vaultidentityentity"
+	ResourceVaultIdentityEntities   = "vaultidentityentities"
+)
+
+// VaultIdentityEntity represents an entity of the vault identity store.
+// More info: https://www.vaultproject.io/docs/secrets/identity#entities-and-aliases
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vaultidentityentities,singular=vaultidentityentity,shortName=vie,categories={vault,policy,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type VaultIdentityEntity struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultIdentityEntitySpec   `json:"spec,omitempty"`
+	Status            VaultIdentityEntityStatus `json:"status,omitempty"`
+}
+
+// More info: https://www.vaultproject.io/api/secret/identity/entity.html
+type VaultIdentityEntitySpec struct {
+	// VaultRef is the name of a AppBinding referencing to a Vault Server
+	VaultRef core.LocalObjectReference `json:"vaultRef"`
+
+	// EntityName is the name of the entity in vault.
+	// This defaults to following format: k8s.${cluster}.${metadata.namespace}.${metadata.name}
+	// If set, it replaces ${metadata.name} in the above format.
+	// +optional
+	EntityName string `json:"entityName,omitempty"`
+
+	// Policies to be tied to the entity
+	// +optional
+	Policies []PolicyIdentifier `json:"policies,omitempty"`
+
+	// Metadata to be associated with the entity.
+	// It can be used in the templated policies, e.g. {{identity.entity.metadata.team}}
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Disabled specifies whether the entity is disabled.
+	// Disabled entities' associated tokens cannot be used, but are not revoked.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Aliases of the entity in the auth methods
+	// +optional
+	Aliases []IdentityAlias `json:"aliases,omitempty"`
+
+	// ServiceAccounts are the kubernetes service accounts mapped to this entity.
+	// An alias is created in the kubernetes auth method for each of the service accounts.
+	// +optional
+	ServiceAccounts []ServiceAccountAlias `json:"serviceAccounts,omitempty"`
+}
+
+// IdentityAlias maps a user of an auth method to an entity or a group
+type IdentityAlias struct {
+	// Name of the alias. It should be the name of the user or group
+	// returned by the auth method, e.g. the name of a github team.
+	Name string `json:"name"`
+
+	// MountPath is the path where the auth method is enabled.
+	// The mount accessor is resolved from the VaultServer's .status.authMethodStatus
+	// or from the auth methods enabled in vault.
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// MountAccessor is the accessor of the auth method.
+	// It takes precedence over the mountPath.
+	// +optional
+	MountAccessor string `json:"mountAccessor,omitempty"`
+}
+
+type AliasNameSource string
+
+const (
+	AliasNameSourceServiceAccountUID  AliasNameSource = "serviceaccount_uid"
+	AliasNameSourceServiceAccountName AliasNameSource = "serviceaccount_name"
+)
+
+// ServiceAccountAlias maps a kubernetes service account to an entity
+type ServiceAccountAlias struct {
+	// Name of the service account
+	Name string `json:"name"`
+
+	// Namespace of the service account, defaults to the namespace of the entity.
+	// It must be the namespace of the entity.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// MountPath is the path where the kubernetes auth method is enabled.
+	// Default: kubernetes
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// AliasNameSource must match the alias_name_source of the kubernetes auth role.
+	// For serviceaccount_uid, the alias name is the uid of the service account.
+	// For serviceaccount_name, the alias name is ${namespace}/${name}.
+	// Default: serviceaccount_uid
+	// +optional
+	// +kubebuilder:validation:Enum=serviceaccount_uid;serviceaccount_name
+	AliasNameSource AliasNameSource `json:"aliasNameSource,omitempty"`
+}
+
+type VaultIdentityEntityStatus struct {
+	// ObservedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase indicates whether the entity successfully applied in vault or not
+	// +optional
+	Phase PolicyPhase `json:"phase,omitempty"`
+
+	// ID of the entity in vault
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Aliases created in vault for this entity
+	// +optional
+	Aliases []IdentityAliasStatus `json:"aliases,omitempty"`
+
+	// Represents the latest available observations of a VaultIdentityEntity.
+	// +optional
+	Conditions []PolicyCondition `json:"conditions,omitempty"`
+}
+
+// IdentityAliasStatus is an alias created in vault
+type IdentityAliasStatus struct {
+	// Name of the alias
+	Name string `json:"name"`
+
+	// MountAccessor of the auth method
+	MountAccessor string `json:"mountAccessor"`
+
+	// ID of the alias in vault
+	ID string `json:"id"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+type VaultIdentityEntityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultIdentityEntity `json:"items,omitempty"`
+}
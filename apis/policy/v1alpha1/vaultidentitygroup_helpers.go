@@ -0,0 +1,118 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	"fmt"
+
+	"kubevault.dev/operator/apis"
+
+	"github.com/pkg/errors"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
+	"kmodules.xyz/client-go/tools/clusterid"
+)
+
+func (v VaultIdentityGroup) GetKey() string {
+	return ResourceVaultIdentityGroup + "/" + v.Namespace + "/" + v.Name
+}
+
+// GroupName returns the name of the group in vault.
+// The custom group name is prefixed with the cluster and namespace too,
+// so that a group of another namespace can't be taken over.
+func (v VaultIdentityGroup) GroupName() string {
+	cluster := "-"
+	if clusterid.ClusterName() != "" {
+		cluster = clusterid.ClusterName()
+	}
+	name := v.Name
+	if v.Spec.GroupName != "" {
+		name = v.Spec.GroupName
+	}
+	return fmt.Sprintf("k8s.%s.%s.%s", cluster, v.Namespace, name)
+}
+
+func (v VaultIdentityGroup) GroupType() IdentityGroupType {
+	if v.Spec.Type == "" {
+		return IdentityGroupTypeInternal
+	}
+	return v.Spec.Type
+}
+
+func (v VaultIdentityGroup) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourceVaultIdentityGroups,
+		Singular:      ResourceVaultIdentityGroup,
+		Kind:          ResourceKindVaultIdentityGroup,
+		ShortNames:    []string{"vig"},
+		Categories:    []string{"vault", "policy", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "vault"},
+		},
+		SpecDefinitionName:      "kubevault.dev/operator/apis/policy/v1alpha1.VaultIdentityGroup",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: true,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Type",
+				Type:     "string",
+				JSONPath: ".spec.type",
+			},
+			{
+				Name:     "Phase",
+				Type:     "string",
+				JSONPath: ".status.phase",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	}, apis.SetNameSchema)
+}
+
+func (v VaultIdentityGroup) IsValid() error {
+	switch v.GroupType() {
+	case IdentityGroupTypeInternal:
+		if v.Spec.Alias != nil {
+			return errors.New(".spec.alias is only applicable for external groups")
+		}
+	case IdentityGroupTypeExternal:
+		if len(v.Spec.MemberEntityRefs) > 0 || len(v.Spec.MemberEntityIDs) > 0 ||
+			len(v.Spec.MemberGroupRefs) > 0 || len(v.Spec.MemberGroupIDs) > 0 {
+			return errors.New("members are only applicable for internal groups")
+		}
+		if v.Spec.Alias != nil {
+			if err := v.Spec.Alias.IsValid(); err != nil {
+				return errors.Wrap(err, "for .spec.alias")
+			}
+		}
+	default:
+		return errors.Errorf("unknown group type %s", v.Spec.Type)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindVaultIdentityGroup = "VaultIdentityGroup"
+	ResourceVaultIdentityGroup     = "vaultidentitygroup"
+	ResourceVaultIdentityGroups    = "vaultidentitygroups"
+)
+
+// VaultIdentityGroup represents a group of the vault identity store.
+// More info: https://www.vaultproject.io/docs/secrets/identity#identity-groups
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vaultidentitygroups,singular=vaultidentitygroup,shortName=vig,categories={vault,policy,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type VaultIdentityGroup struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultIdentityGroupSpec   `json:"spec,omitempty"`
+	Status            VaultIdentityGroupStatus `json:"status,omitempty"`
+}
+
+type IdentityGroupType string
+
+const (
+	IdentityGroupTypeInternal IdentityGroupType = "internal"
+	IdentityGroupTypeExternal IdentityGroupType = "external"
+)
+
+// More info: https://www.vaultproject.io/api/secret/identity/group.html
+type VaultIdentityGroupSpec struct {
+	// VaultRef is the name of a AppBinding referencing to a Vault Server
+	VaultRef core.LocalObjectReference `json:"vaultRef"`
+
+	// GroupName is the name of the group in vault.
+	// This defaults to following format: k8s.${cluster}.${metadata.namespace}.${metadata.name}
+	// If set, it replaces ${metadata.name} in the above format.
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+
+	// Type of the group, internal or external.
+	// The members of the internal groups are managed explicitly, whereas
+	// the members of the external groups are managed by the auth method via the group alias.
+	// Default: internal
+	// +optional
+	// +kubebuilder:validation:Enum=internal;external
+	Type IdentityGroupType `json:"type,omitempty"`
+
+	// Policies to be tied to the group
+	// +optional
+	Policies []PolicyIdentifier `json:"policies,omitempty"`
+
+	// Metadata to be associated with the group
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// MemberEntityRefs are the names of the VaultIdentityEntity crd objects
+	// which are members of this group. Only applicable for internal groups.
+	// +optional
+	MemberEntityRefs []string `json:"memberEntityRefs,omitempty"`
+
+	// MemberEntityIDs are the ids of the vault entities which are members of this group.
+	// Only applicable for internal groups.
+	// +optional
+	MemberEntityIDs []string `json:"memberEntityIDs,omitempty"`
+
+	// MemberGroupRefs are the names of the VaultIdentityGroup crd objects
+	// which are subgroups of this group. Only applicable for internal groups.
+	// +optional
+	MemberGroupRefs []string `json:"memberGroupRefs,omitempty"`
+
+	// MemberGroupIDs are the ids of the vault groups which are subgroups of this group.
+	// Only applicable for internal groups.
+	// +optional
+	MemberGroupIDs []string `json:"memberGroupIDs,omitempty"`
+
+	// Alias maps the group of an auth method to this group, e.g. a ldap group.
+	// Only applicable for external groups.
+	// +optional
+	Alias *IdentityAlias `json:"alias,omitempty"`
+}
+
+type VaultIdentityGroupStatus struct {
+	// ObservedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase indicates whether the group successfully applied in vault or not
+	// +optional
+	Phase PolicyPhase `json:"phase,omitempty"`
+
+	// ID of the group in vault
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Alias created in vault for this group
+	// +optional
+	Alias *IdentityAliasStatus `json:"alias,omitempty"`
+
+	// Represents the latest available observations of a VaultIdentityGroup.
+	// +optional
+	Conditions []PolicyCondition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+type VaultIdentityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultIdentityGroup `json:"items,omitempty"`
+}
@@ -104,7 +104,69 @@ func (v *VaultPolicyBinding) SetDefaults() {
 		v.Spec.VaultRoleName = v.PolicyBindingName()
 	}
 
-	if v.Spec.SubjectRef.Kubernetes != nil && v.Spec.SubjectRef.Kubernetes.Path == "" {
-		v.Spec.SubjectRef.Kubernetes.Path = "kubernetes"
+	v.Spec.SubjectRef.SetDefaults()
+}
+
+// SetDefaults sets the default path and the auth method specific defaults of the subjects
+func (s *SubjectRef) SetDefaults() {
+	if s == nil {
+		return
+	}
+
+	if s.Kubernetes != nil && s.Kubernetes.Path == "" && s.Kubernetes.ClusterRef == "" {
+		s.Kubernetes.Path = "kubernetes"
+	}
+	if s.AppRole != nil {
+		if s.AppRole.Path == "" {
+			s.AppRole.Path = "approle"
+		}
+		if s.AppRole.BindSecretID == nil {
+			bindSecretID := true
+			s.AppRole.BindSecretID = &bindSecretID
+		}
+	}
+	if s.JWT != nil {
+		if s.JWT.Path == "" {
+			s.JWT.Path = "jwt"
+		}
+		if s.JWT.UserClaim == "" {
+			s.JWT.UserClaim = "sub"
+		}
+	}
+	if s.OIDC != nil {
+		if s.OIDC.Path == "" {
+			s.OIDC.Path = "oidc"
+		}
+		if s.OIDC.UserClaim == "" {
+			s.OIDC.UserClaim = "sub"
+		}
+	}
+	if s.AWS != nil {
+		if s.AWS.Path == "" {
+			s.AWS.Path = "aws"
+		}
+		if s.AWS.AuthType == "" {
+			s.AWS.AuthType = AWSAuthTypeIAM
+		}
+	}
+	if s.GCP != nil {
+		if s.GCP.Path == "" {
+			s.GCP.Path = "gcp"
+		}
+		if s.GCP.Type == "" {
+			s.GCP.Type = GCPAuthTypeIAM
+		}
+	}
+	if s.Azure != nil && s.Azure.Path == "" {
+		s.Azure.Path = "azure"
+	}
+	if s.Cert != nil && s.Cert.Path == "" {
+		s.Cert.Path = "cert"
+	}
+	if s.UserPass != nil && s.UserPass.Path == "" {
+		s.UserPass.Path = "userpass"
+	}
+	if s.LDAP != nil && s.LDAP.Path == "" {
+		s.LDAP.Path = "ldap"
 	}
 }
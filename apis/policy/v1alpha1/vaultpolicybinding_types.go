@@ -70,12 +70,52 @@ type PolicyIdentifier struct {
 	// Ref is name of a VaultPolicy crd object. Actual vault policy name is spec.vaultRoleName field.
 	// More info: https://www.vaultproject.io/docs/concepts/policies.html#listing-policies
 	Ref string `json:"ref,omitempty"`
+
+	// ClusterRef is name of a ClusterVaultPolicy crd object. Actual vault policy name is spec.vaultPolicyName field.
+	// The user creating or updating the object must be allowed to bind the ClusterVaultPolicy.
+	ClusterRef string `json:"clusterRef,omitempty"`
 }
 
 type SubjectRef struct {
 	// Kubernetes refers to Vault users who are authenticated via Kubernetes auth method
 	// More info: https://www.vaultproject.io/docs/auth/kubernetes.html#configuration
 	Kubernetes *KubernetesSubjectRef `json:"kubernetes,omitempty"`
+
+	// AppRole refers to Vault users who are authenticated via AppRole auth method
+	// More info: https://www.vaultproject.io/docs/auth/approle.html
+	AppRole *AppRoleSubjectRef `json:"appRole,omitempty"`
+
+	// JWT refers to Vault users who are authenticated via JWT auth method
+	// More info: https://www.vaultproject.io/docs/auth/jwt.html
+	JWT *JWTSubjectRef `json:"jwt,omitempty"`
+
+	// OIDC refers to Vault users who are authenticated via OIDC auth method
+	// More info: https://www.vaultproject.io/docs/auth/jwt.html
+	OIDC *JWTSubjectRef `json:"oidc,omitempty"`
+
+	// AWS refers to Vault users who are authenticated via AWS auth method
+	// More info: https://www.vaultproject.io/docs/auth/aws.html
+	AWS *AWSSubjectRef `json:"aws,omitempty"`
+
+	// GCP refers to Vault users who are authenticated via GCP auth method
+	// More info: https://www.vaultproject.io/docs/auth/gcp.html
+	GCP *GCPSubjectRef `json:"gcp,omitempty"`
+
+	// Azure refers to Vault users who are authenticated via Azure auth method
+	// More info: https://www.vaultproject.io/docs/auth/azure.html
+	Azure *AzureSubjectRef `json:"azure,omitempty"`
+
+	// Cert refers to Vault users who are authenticated via TLS certificates auth method
+	// More info: https://www.vaultproject.io/docs/auth/cert.html
+	Cert *CertSubjectRef `json:"cert,omitempty"`
+
+	// UserPass refers to Vault users who are authenticated via Userpass auth method
+	// More info: https://www.vaultproject.io/docs/auth/userpass.html
+	UserPass *UserPassSubjectRef `json:"userpass,omitempty"`
+
+	// LDAP refers to Vault users who are authenticated via LDAP auth method
+	// More info: https://www.vaultproject.io/docs/auth/ldap.html
+	LDAP *LDAPSubjectRef `json:"ldap,omitempty"`
 }
 
 // More info: https://www.vaultproject.io/api/auth/kubernetes/index.html#create-role
@@ -85,6 +125,12 @@ type KubernetesSubjectRef struct {
 	// +optional
 	Path string `json:"path,omitempty"`
 
+	// Specifies the name of a KubernetesAuthCluster in the same namespace.
+	// If set, the role is created in the kubernetes auth method of that
+	// remote cluster, and path is ignored.
+	// +optional
+	ClusterRef string `json:"clusterRef,omitempty"`
+
 	// Specifies the names of the service account to bind with policy
 	ServiceAccountNames []string `json:"serviceAccountNames"`
 
@@ -106,6 +152,318 @@ type KubernetesSubjectRef struct {
 	Period string `json:"period,omitempty"`
 }
 
+// TokenParams are the parameters of the tokens issued using a role
+type TokenParams struct {
+	// Specifies the TTL period of tokens issued using this role.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+
+	// Specifies the maximum allowed lifetime of tokens issued using this role.
+	// +optional
+	MaxTTL string `json:"maxTTL,omitempty"`
+
+	// If set, indicates that the token generated using this role should never expire.
+	// The token should be renewed within the duration specified by this value.
+	// +optional
+	Period string `json:"period,omitempty"`
+
+	// Specifies the CIDR blocks of the IP addresses which can use the tokens issued using this role.
+	// +optional
+	TokenBoundCIDRs []string `json:"tokenBoundCIDRs,omitempty"`
+}
+
+// More info: https://www.vaultproject.io/api/auth/approle/index.html#create-update-approle
+type AppRoleSubjectRef struct {
+	// Specifies the path where AppRole auth is enabled
+	// default : approle
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies whether a SecretID is required to login using this role
+	// default : true
+	// +optional
+	BindSecretID *bool `json:"bindSecretID,omitempty"`
+
+	// Specifies the CIDR blocks of the IP addresses which can perform the login operation
+	// +optional
+	SecretIDBoundCIDRs []string `json:"secretIDBoundCIDRs,omitempty"`
+
+	// Specifies the number of times a SecretID can be used to login. 0 means unlimited.
+	// +optional
+	SecretIDNumUses int64 `json:"secretIDNumUses,omitempty"`
+
+	// Specifies the TTL of the SecretIDs generated against this role
+	// +optional
+	SecretIDTTL string `json:"secretIDTTL,omitempty"`
+
+	TokenParams `json:",inline"`
+}
+
+// More info: https://www.vaultproject.io/api/auth/jwt/index.html#create-role
+type JWTSubjectRef struct {
+	// Specifies the path where JWT/OIDC auth is enabled
+	// default : jwt for jwt and oidc for oidc
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies the claim to use to uniquely identify the user
+	// default : sub
+	// +optional
+	UserClaim string `json:"userClaim,omitempty"`
+
+	// Specifies the list of aud claims to match against
+	// +optional
+	BoundAudiences []string `json:"boundAudiences,omitempty"`
+
+	// Specifies the sub claim to match against
+	// +optional
+	BoundSubject string `json:"boundSubject,omitempty"`
+
+	// Specifies the claims to match against
+	// +optional
+	BoundClaims map[string]string `json:"boundClaims,omitempty"`
+
+	// Specifies the claim to use to uniquely identify the set of groups to which the user belongs
+	// +optional
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+
+	// Specifies the mapping of claims to metadata keys
+	// +optional
+	ClaimMappings map[string]string `json:"claimMappings,omitempty"`
+
+	// Specifies the list of allowed redirect URIs. Required for OIDC.
+	// +optional
+	AllowedRedirectURIs []string `json:"allowedRedirectURIs,omitempty"`
+
+	// Specifies the OIDC scopes to be used
+	// +optional
+	OIDCScopes []string `json:"oidcScopes,omitempty"`
+
+	TokenParams `json:",inline"`
+}
+
+type AWSAuthType string
+
+const (
+	AWSAuthTypeIAM AWSAuthType = "iam"
+	AWSAuthTypeEC2 AWSAuthType = "ec2"
+)
+
+// More info: https://www.vaultproject.io/api/auth/aws/index.html#create-role
+type AWSSubjectRef struct {
+	// Specifies the path where AWS auth is enabled
+	// default : aws
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies the auth type permitted for this role
+	// default : iam
+	// +optional
+	AuthType AWSAuthType `json:"authType,omitempty"`
+
+	// Specifies the IAM principal ARNs that are permitted to login. Used for iam auth type.
+	// +optional
+	BoundIAMPrincipalARNs []string `json:"boundIAMPrincipalARNs,omitempty"`
+
+	// Specifies the account IDs that are permitted to login
+	// +optional
+	BoundAccountIDs []string `json:"boundAccountIDs,omitempty"`
+
+	// Specifies the regions that are permitted to login
+	// +optional
+	BoundRegions []string `json:"boundRegions,omitempty"`
+
+	// Specifies the VPC IDs that are permitted to login
+	// +optional
+	BoundVPCIDs []string `json:"boundVPCIDs,omitempty"`
+
+	// Specifies the subnet IDs that are permitted to login
+	// +optional
+	BoundSubnetIDs []string `json:"boundSubnetIDs,omitempty"`
+
+	// Specifies the AMI IDs that are permitted to login
+	// +optional
+	BoundAMIIDs []string `json:"boundAMIIDs,omitempty"`
+
+	// Specifies the IAM role ARNs of the instances that are permitted to login
+	// +optional
+	BoundIAMRoleARNs []string `json:"boundIAMRoleARNs,omitempty"`
+
+	// Specifies the IAM instance profile ARNs of the instances that are permitted to login
+	// +optional
+	BoundIAMInstanceProfileARNs []string `json:"boundIAMInstanceProfileARNs,omitempty"`
+
+	// Specifies the EC2 instance IDs that are permitted to login
+	// +optional
+	BoundEC2InstanceIDs []string `json:"boundEC2InstanceIDs,omitempty"`
+
+	// Specifies the type of entity inferred from the IAM principal, e.g. ec2_instance
+	// +optional
+	InferredEntityType string `json:"inferredEntityType,omitempty"`
+
+	// Specifies the region to search for the inferred entities
+	// +optional
+	InferredAWSRegion string `json:"inferredAWSRegion,omitempty"`
+
+	TokenParams `json:",inline"`
+}
+
+type GCPAuthType string
+
+const (
+	GCPAuthTypeIAM GCPAuthType = "iam"
+	GCPAuthTypeGCE GCPAuthType = "gce"
+)
+
+// More info: https://www.vaultproject.io/api/auth/gcp/index.html#create-role
+type GCPSubjectRef struct {
+	// Specifies the path where GCP auth is enabled
+	// default : gcp
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies the type of this role
+	// default : iam
+	// +optional
+	Type GCPAuthType `json:"type,omitempty"`
+
+	// Specifies the service account emails or IDs that are permitted to login. Required for iam type.
+	// +optional
+	BoundServiceAccounts []string `json:"boundServiceAccounts,omitempty"`
+
+	// Specifies the projects that are permitted to login
+	// +optional
+	BoundProjects []string `json:"boundProjects,omitempty"`
+
+	// Specifies the zones of the instances that are permitted to login. Used for gce type.
+	// +optional
+	BoundZones []string `json:"boundZones,omitempty"`
+
+	// Specifies the regions of the instances that are permitted to login. Used for gce type.
+	// +optional
+	BoundRegions []string `json:"boundRegions,omitempty"`
+
+	// Specifies the instance groups that are permitted to login. Used for gce type.
+	// +optional
+	BoundInstanceGroups []string `json:"boundInstanceGroups,omitempty"`
+
+	// Specifies the instance labels in key:value format that are permitted to login. Used for gce type.
+	// +optional
+	BoundLabels []string `json:"boundLabels,omitempty"`
+
+	// Specifies whether to add group aliases to the auth responses
+	// +optional
+	AddGroupAliases bool `json:"addGroupAliases,omitempty"`
+
+	// Specifies the maximum allowed lifetime of the JWT used to login. Used for iam type.
+	// +optional
+	MaxJWTExp string `json:"maxJWTExp,omitempty"`
+
+	TokenParams `json:",inline"`
+}
+
+// More info: https://www.vaultproject.io/api/auth/azure/index.html#create-role
+type AzureSubjectRef struct {
+	// Specifies the path where Azure auth is enabled
+	// default : azure
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies the service principal IDs that are permitted to login
+	// +optional
+	BoundServicePrincipalIDs []string `json:"boundServicePrincipalIDs,omitempty"`
+
+	// Specifies the group IDs that are permitted to login
+	// +optional
+	BoundGroupIDs []string `json:"boundGroupIDs,omitempty"`
+
+	// Specifies the locations that are permitted to login
+	// +optional
+	BoundLocations []string `json:"boundLocations,omitempty"`
+
+	// Specifies the subscription IDs that are permitted to login
+	// +optional
+	BoundSubscriptionIDs []string `json:"boundSubscriptionIDs,omitempty"`
+
+	// Specifies the resource groups that are permitted to login
+	// +optional
+	BoundResourceGroups []string `json:"boundResourceGroups,omitempty"`
+
+	// Specifies the scale sets that are permitted to login
+	// +optional
+	BoundScaleSets []string `json:"boundScaleSets,omitempty"`
+
+	TokenParams `json:",inline"`
+}
+
+// More info: https://www.vaultproject.io/api/auth/cert/index.html#create-ca-certificate-role
+type CertSubjectRef struct {
+	// Specifies the path where TLS certificates auth is enabled
+	// default : cert
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies the PEM encoded CA certificate used to verify the client certificates
+	Certificate string `json:"certificate"`
+
+	// Specifies the common names that are permitted to login
+	// +optional
+	AllowedCommonNames []string `json:"allowedCommonNames,omitempty"`
+
+	// Specifies the DNS SANs that are permitted to login
+	// +optional
+	AllowedDNSSANs []string `json:"allowedDNSSANs,omitempty"`
+
+	// Specifies the email SANs that are permitted to login
+	// +optional
+	AllowedEmailSANs []string `json:"allowedEmailSANs,omitempty"`
+
+	// Specifies the URI SANs that are permitted to login
+	// +optional
+	AllowedURISANs []string `json:"allowedURISANs,omitempty"`
+
+	// Specifies the organizational units that are permitted to login
+	// +optional
+	AllowedOrganizationalUnits []string `json:"allowedOrganizationalUnits,omitempty"`
+
+	// Specifies the extensions in oid:value format that the client certificates must have
+	// +optional
+	RequiredExtensions []string `json:"requiredExtensions,omitempty"`
+
+	TokenParams `json:",inline"`
+}
+
+// More info: https://www.vaultproject.io/api/auth/userpass/index.html#update-policies-on-user
+type UserPassSubjectRef struct {
+	// Specifies the path where Userpass auth is enabled
+	// default : userpass
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies the existing users to grant the policies.
+	// The policies of the binding are added to the existing policies of these users,
+	// and removed when the binding is deleted.
+	Usernames []string `json:"usernames"`
+}
+
+// More info: https://www.vaultproject.io/api/auth/ldap/index.html#create-update-ldap-group
+type LDAPSubjectRef struct {
+	// Specifies the path where LDAP auth is enabled
+	// default : ldap
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Specifies the LDAP groups to grant the policies.
+	// The policies of the binding are added to the existing policies of the groups and users,
+	// and removed when the binding is deleted. The groups and users are never deleted.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// Specifies the LDAP users to grant the policies
+	// +optional
+	Users []string `json:"users,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
 
@@ -141,6 +499,26 @@ type VaultPolicyBindingStatus struct {
 	// Represents the latest available observations of a VaultPolicyBinding.
 	// +optional
 	Conditions []PolicyBindingCondition `json:"conditions,omitempty"`
+
+	// SharedPolicies are the vault policies granted to the userpass and ldap subjects,
+	// which are shared with other bindings. The policies removed from the binding
+	// are revoked from these subjects.
+	// +optional
+	SharedPolicies []string `json:"sharedPolicies,omitempty"`
+
+	// SharedSubjects are the userpass and ldap subjects, which are granted the SharedPolicies.
+	// The SharedPolicies are revoked from the subjects removed from the binding.
+	// +optional
+	SharedSubjects *SharedSubjectRef `json:"sharedSubjects,omitempty"`
+}
+
+// SharedSubjectRef contains the subjects of a VaultPolicyBinding, which are shared with other bindings
+type SharedSubjectRef struct {
+	// +optional
+	UserPass *UserPassSubjectRef `json:"userpass,omitempty"`
+
+	// +optional
+	LDAP *LDAPSubjectRef `json:"ldap,omitempty"`
 }
 
 type PolicyBindingConditionType string
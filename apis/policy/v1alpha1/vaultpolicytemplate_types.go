@@ -0,0 +1,108 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+const (
+	ResourceKindVaultPolicyTemplate = "VaultPolicyTemplate"
+	ResourceVaultPolicyTemplate     = "vaultpolicytemplate"
+	ResourceVaultPolicyTemplates    = "vaultpolicytemplates"
+)
+
+// VaultPolicyTemplate renders a vault policy for each of the selected namespaces.
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=vaultpolicytemplates,singular=vaultpolicytemplate,scope=Cluster,shortName=vpt,categories={vault,policy,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type VaultPolicyTemplate struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VaultPolicyTemplateSpec   `json:"spec,omitempty"`
+	Status            VaultPolicyTemplateStatus `json:"status,omitempty"`
+}
+
+type VaultPolicyTemplateSpec struct {
+	// VaultRef refers to the AppBinding of the Vault Server
+	VaultRef appcat.AppReference `json:"vaultRef"`
+
+	// NamespaceSelector selects the namespaces for which the policy is rendered.
+	// If not specified, the policy is rendered for all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// PolicyDocument specifies a vault policy template in hcl or json format.
+	// The template is rendered using Go templates with the following data:
+	//	- .Namespace: name of the namespace
+	//	- .Labels: labels of the namespace
+	//	- .Annotations: annotations of the namespace
+	// Labels and annotations whose values contain quotes, braces, backslashes or newlines can't be used.
+	// Vault identity templates (e.g. {{identity.entity.id}}) are passed to vault as is.
+	// For example:
+	// path "kv/data/{{ .Namespace }}/*" {
+	//   capabilities = ["create", "read", "update", "delete", "list"]
+	// }
+	// The rendered policy is validated like a VaultPolicy, i.e. against the policy denylist.
+	// The rendered policy is named as: k8s.${cluster}.tmpl_${namespace}.${metadata.name}
+	PolicyDocument string `json:"policyDocument"`
+}
+
+type VaultPolicyTemplateStatus struct {
+	// ObservedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase indicates whether the policies are successfully applied in vault or not
+	// +optional
+	Phase PolicyPhase `json:"phase,omitempty"`
+
+	// Policies is the list of the vault policies rendered from the template
+	// +optional
+	Policies []RenderedPolicy `json:"policies,omitempty"`
+
+	// Represents the latest available observations of a VaultPolicyTemplate.
+	// +optional
+	Conditions []PolicyCondition `json:"conditions,omitempty"`
+}
+
+// RenderedPolicy is a vault policy rendered for a namespace
+type RenderedPolicy struct {
+	// Namespace for which the policy is rendered
+	Namespace string `json:"namespace"`
+
+	// Name of the policy in vault
+	Name string `json:"name"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+type VaultPolicyTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultPolicyTemplate `json:"items,omitempty"`
+}
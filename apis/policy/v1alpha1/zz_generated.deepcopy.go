@@ -21,116 +21,977 @@ limitations under the License.
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSubjectRef) DeepCopyInto(out *AWSSubjectRef) {
+	*out = *in
+	if in.BoundIAMPrincipalARNs != nil {
+		in, out := &in.BoundIAMPrincipalARNs, &out.BoundIAMPrincipalARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundAccountIDs != nil {
+		in, out := &in.BoundAccountIDs, &out.BoundAccountIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundRegions != nil {
+		in, out := &in.BoundRegions, &out.BoundRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundVPCIDs != nil {
+		in, out := &in.BoundVPCIDs, &out.BoundVPCIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundSubnetIDs != nil {
+		in, out := &in.BoundSubnetIDs, &out.BoundSubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundAMIIDs != nil {
+		in, out := &in.BoundAMIIDs, &out.BoundAMIIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundIAMRoleARNs != nil {
+		in, out := &in.BoundIAMRoleARNs, &out.BoundIAMRoleARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundIAMInstanceProfileARNs != nil {
+		in, out := &in.BoundIAMInstanceProfileARNs, &out.BoundIAMInstanceProfileARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundEC2InstanceIDs != nil {
+		in, out := &in.BoundEC2InstanceIDs, &out.BoundEC2InstanceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParams.DeepCopyInto(&out.TokenParams)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSubjectRef.
+func (in *AWSSubjectRef) DeepCopy() *AWSSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRoleSubjectRef) DeepCopyInto(out *AppRoleSubjectRef) {
+	*out = *in
+	if in.BindSecretID != nil {
+		in, out := &in.BindSecretID, &out.BindSecretID
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretIDBoundCIDRs != nil {
+		in, out := &in.SecretIDBoundCIDRs, &out.SecretIDBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParams.DeepCopyInto(&out.TokenParams)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRoleSubjectRef.
+func (in *AppRoleSubjectRef) DeepCopy() *AppRoleSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRoleSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSubjectRef) DeepCopyInto(out *AzureSubjectRef) {
+	*out = *in
+	if in.BoundServicePrincipalIDs != nil {
+		in, out := &in.BoundServicePrincipalIDs, &out.BoundServicePrincipalIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundGroupIDs != nil {
+		in, out := &in.BoundGroupIDs, &out.BoundGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundLocations != nil {
+		in, out := &in.BoundLocations, &out.BoundLocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundSubscriptionIDs != nil {
+		in, out := &in.BoundSubscriptionIDs, &out.BoundSubscriptionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundResourceGroups != nil {
+		in, out := &in.BoundResourceGroups, &out.BoundResourceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundScaleSets != nil {
+		in, out := &in.BoundScaleSets, &out.BoundScaleSets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParams.DeepCopyInto(&out.TokenParams)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSubjectRef.
+func (in *AzureSubjectRef) DeepCopy() *AzureSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertSubjectRef) DeepCopyInto(out *CertSubjectRef) {
+	*out = *in
+	if in.AllowedCommonNames != nil {
+		in, out := &in.AllowedCommonNames, &out.AllowedCommonNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedDNSSANs != nil {
+		in, out := &in.AllowedDNSSANs, &out.AllowedDNSSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedEmailSANs != nil {
+		in, out := &in.AllowedEmailSANs, &out.AllowedEmailSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedURISANs != nil {
+		in, out := &in.AllowedURISANs, &out.AllowedURISANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedOrganizationalUnits != nil {
+		in, out := &in.AllowedOrganizationalUnits, &out.AllowedOrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequiredExtensions != nil {
+		in, out := &in.RequiredExtensions, &out.RequiredExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParams.DeepCopyInto(&out.TokenParams)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertSubjectRef.
+func (in *CertSubjectRef) DeepCopy() *CertSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVaultPolicy) DeepCopyInto(out *ClusterVaultPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultPolicy.
+func (in *ClusterVaultPolicy) DeepCopy() *ClusterVaultPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVaultPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVaultPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVaultPolicyList) DeepCopyInto(out *ClusterVaultPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterVaultPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultPolicyList.
+func (in *ClusterVaultPolicyList) DeepCopy() *ClusterVaultPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVaultPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVaultPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVaultPolicySpec) DeepCopyInto(out *ClusterVaultPolicySpec) {
+	*out = *in
+	in.VaultRef.DeepCopyInto(&out.VaultRef)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AggregationSelector != nil {
+		in, out := &in.AggregationSelector, &out.AggregationSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultPolicySpec.
+func (in *ClusterVaultPolicySpec) DeepCopy() *ClusterVaultPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVaultPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVaultPolicyStatus) DeepCopyInto(out *ClusterVaultPolicyStatus) {
+	*out = *in
+	if in.AggregatedPolicies != nil {
+		in, out := &in.AggregatedPolicies, &out.AggregatedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PolicyCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVaultPolicyStatus.
+func (in *ClusterVaultPolicyStatus) DeepCopy() *ClusterVaultPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVaultPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPSubjectRef) DeepCopyInto(out *GCPSubjectRef) {
+	*out = *in
+	if in.BoundServiceAccounts != nil {
+		in, out := &in.BoundServiceAccounts, &out.BoundServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundProjects != nil {
+		in, out := &in.BoundProjects, &out.BoundProjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundZones != nil {
+		in, out := &in.BoundZones, &out.BoundZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundRegions != nil {
+		in, out := &in.BoundRegions, &out.BoundRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundInstanceGroups != nil {
+		in, out := &in.BoundInstanceGroups, &out.BoundInstanceGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundLabels != nil {
+		in, out := &in.BoundLabels, &out.BoundLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParams.DeepCopyInto(&out.TokenParams)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPSubjectRef.
+func (in *GCPSubjectRef) DeepCopy() *GCPSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityAlias) DeepCopyInto(out *IdentityAlias) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityAlias.
+func (in *IdentityAlias) DeepCopy() *IdentityAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityAliasStatus) DeepCopyInto(out *IdentityAliasStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityAliasStatus.
+func (in *IdentityAliasStatus) DeepCopy() *IdentityAliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityAliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTSubjectRef) DeepCopyInto(out *JWTSubjectRef) {
+	*out = *in
+	if in.BoundAudiences != nil {
+		in, out := &in.BoundAudiences, &out.BoundAudiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BoundClaims != nil {
+		in, out := &in.BoundClaims, &out.BoundClaims
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ClaimMappings != nil {
+		in, out := &in.ClaimMappings, &out.ClaimMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AllowedRedirectURIs != nil {
+		in, out := &in.AllowedRedirectURIs, &out.AllowedRedirectURIs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OIDCScopes != nil {
+		in, out := &in.OIDCScopes, &out.OIDCScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenParams.DeepCopyInto(&out.TokenParams)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTSubjectRef.
+func (in *JWTSubjectRef) DeepCopy() *JWTSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesSubjectRef) DeepCopyInto(out *KubernetesSubjectRef) {
 	*out = *in
-	if in.ServiceAccountNames != nil {
-		in, out := &in.ServiceAccountNames, &out.ServiceAccountNames
+	if in.ServiceAccountNames != nil {
+		in, out := &in.ServiceAccountNames, &out.ServiceAccountNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccountNamespaces != nil {
+		in, out := &in.ServiceAccountNamespaces, &out.ServiceAccountNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSubjectRef.
+func (in *KubernetesSubjectRef) DeepCopy() *KubernetesSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPSubjectRef) DeepCopyInto(out *LDAPSubjectRef) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSubjectRef.
+func (in *LDAPSubjectRef) DeepCopy() *LDAPSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyBindingCondition) DeepCopyInto(out *PolicyBindingCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBindingCondition.
+func (in *PolicyBindingCondition) DeepCopy() *PolicyBindingCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyBindingCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyCondition) DeepCopyInto(out *PolicyCondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyCondition.
+func (in *PolicyCondition) DeepCopy() *PolicyCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyIdentifier) DeepCopyInto(out *PolicyIdentifier) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyIdentifier.
+func (in *PolicyIdentifier) DeepCopy() *PolicyIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderedPolicy) DeepCopyInto(out *RenderedPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderedPolicy.
+func (in *RenderedPolicy) DeepCopy() *RenderedPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderedPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountAlias) DeepCopyInto(out *ServiceAccountAlias) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountAlias.
+func (in *ServiceAccountAlias) DeepCopy() *ServiceAccountAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountReference) DeepCopyInto(out *ServiceAccountReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountReference.
+func (in *ServiceAccountReference) DeepCopy() *ServiceAccountReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedSubjectRef) DeepCopyInto(out *SharedSubjectRef) {
+	*out = *in
+	if in.UserPass != nil {
+		in, out := &in.UserPass, &out.UserPass
+		*out = new(UserPassSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(LDAPSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedSubjectRef.
+func (in *SharedSubjectRef) DeepCopy() *SharedSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubjectRef) DeepCopyInto(out *SubjectRef) {
+	*out = *in
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppRole != nil {
+		in, out := &in.AppRole, &out.AppRole
+		*out = new(AppRoleSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = new(JWTSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(JWTSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCP != nil {
+		in, out := &in.GCP, &out.GCP
+		*out = new(GCPSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(CertSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserPass != nil {
+		in, out := &in.UserPass, &out.UserPass
+		*out = new(UserPassSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(LDAPSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubjectRef.
+func (in *SubjectRef) DeepCopy() *SubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenParams) DeepCopyInto(out *TokenParams) {
+	*out = *in
+	if in.TokenBoundCIDRs != nil {
+		in, out := &in.TokenBoundCIDRs, &out.TokenBoundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenParams.
+func (in *TokenParams) DeepCopy() *TokenParams {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserPassSubjectRef) DeepCopyInto(out *UserPassSubjectRef) {
+	*out = *in
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ServiceAccountNamespaces != nil {
-		in, out := &in.ServiceAccountNamespaces, &out.ServiceAccountNamespaces
-		*out = make([]string, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPassSubjectRef.
+func (in *UserPassSubjectRef) DeepCopy() *UserPassSubjectRef {
+	if in == nil {
+		return nil
+	}
+	out := new(UserPassSubjectRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultIdentityEntity) DeepCopyInto(out *VaultIdentityEntity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityEntity.
+func (in *VaultIdentityEntity) DeepCopy() *VaultIdentityEntity {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultIdentityEntity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultIdentityEntity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultIdentityEntityList) DeepCopyInto(out *VaultIdentityEntityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VaultIdentityEntity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityEntityList.
+func (in *VaultIdentityEntityList) DeepCopy() *VaultIdentityEntityList {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultIdentityEntityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultIdentityEntityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultIdentityEntitySpec) DeepCopyInto(out *VaultIdentityEntitySpec) {
+	*out = *in
+	out.VaultRef = in.VaultRef
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicyIdentifier, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]IdentityAlias, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]ServiceAccountAlias, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesSubjectRef.
-func (in *KubernetesSubjectRef) DeepCopy() *KubernetesSubjectRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityEntitySpec.
+func (in *VaultIdentityEntitySpec) DeepCopy() *VaultIdentityEntitySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KubernetesSubjectRef)
+	out := new(VaultIdentityEntitySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyBindingCondition) DeepCopyInto(out *PolicyBindingCondition) {
+func (in *VaultIdentityEntityStatus) DeepCopyInto(out *VaultIdentityEntityStatus) {
 	*out = *in
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]IdentityAliasStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PolicyCondition, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyBindingCondition.
-func (in *PolicyBindingCondition) DeepCopy() *PolicyBindingCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityEntityStatus.
+func (in *VaultIdentityEntityStatus) DeepCopy() *VaultIdentityEntityStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyBindingCondition)
+	out := new(VaultIdentityEntityStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyCondition) DeepCopyInto(out *PolicyCondition) {
+func (in *VaultIdentityGroup) DeepCopyInto(out *VaultIdentityGroup) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyCondition.
-func (in *PolicyCondition) DeepCopy() *PolicyCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityGroup.
+func (in *VaultIdentityGroup) DeepCopy() *VaultIdentityGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyCondition)
+	out := new(VaultIdentityGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultIdentityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyIdentifier) DeepCopyInto(out *PolicyIdentifier) {
+func (in *VaultIdentityGroupList) DeepCopyInto(out *VaultIdentityGroupList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VaultIdentityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyIdentifier.
-func (in *PolicyIdentifier) DeepCopy() *PolicyIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityGroupList.
+func (in *VaultIdentityGroupList) DeepCopy() *VaultIdentityGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyIdentifier)
+	out := new(VaultIdentityGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultIdentityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceAccountReference) DeepCopyInto(out *ServiceAccountReference) {
+func (in *VaultIdentityGroupSpec) DeepCopyInto(out *VaultIdentityGroupSpec) {
 	*out = *in
+	out.VaultRef = in.VaultRef
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]PolicyIdentifier, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MemberEntityRefs != nil {
+		in, out := &in.MemberEntityRefs, &out.MemberEntityRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemberEntityIDs != nil {
+		in, out := &in.MemberEntityIDs, &out.MemberEntityIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemberGroupRefs != nil {
+		in, out := &in.MemberGroupRefs, &out.MemberGroupRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemberGroupIDs != nil {
+		in, out := &in.MemberGroupIDs, &out.MemberGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(IdentityAlias)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountReference.
-func (in *ServiceAccountReference) DeepCopy() *ServiceAccountReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityGroupSpec.
+func (in *VaultIdentityGroupSpec) DeepCopy() *VaultIdentityGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ServiceAccountReference)
+	out := new(VaultIdentityGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubjectRef) DeepCopyInto(out *SubjectRef) {
+func (in *VaultIdentityGroupStatus) DeepCopyInto(out *VaultIdentityGroupStatus) {
 	*out = *in
-	if in.Kubernetes != nil {
-		in, out := &in.Kubernetes, &out.Kubernetes
-		*out = new(KubernetesSubjectRef)
-		(*in).DeepCopyInto(*out)
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(IdentityAliasStatus)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PolicyCondition, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubjectRef.
-func (in *SubjectRef) DeepCopy() *SubjectRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultIdentityGroupStatus.
+func (in *VaultIdentityGroupStatus) DeepCopy() *VaultIdentityGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SubjectRef)
+	out := new(VaultIdentityGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -255,6 +1116,16 @@ func (in *VaultPolicyBindingStatus) DeepCopyInto(out *VaultPolicyBindingStatus)
 		*out = make([]PolicyBindingCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.SharedPolicies != nil {
+		in, out := &in.SharedPolicies, &out.SharedPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SharedSubjects != nil {
+		in, out := &in.SharedSubjects, &out.SharedSubjects
+		*out = new(SharedSubjectRef)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -343,3 +1214,112 @@ func (in *VaultPolicyStatus) DeepCopy() *VaultPolicyStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPolicyTemplate) DeepCopyInto(out *VaultPolicyTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPolicyTemplate.
+func (in *VaultPolicyTemplate) DeepCopy() *VaultPolicyTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPolicyTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultPolicyTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPolicyTemplateList) DeepCopyInto(out *VaultPolicyTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VaultPolicyTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPolicyTemplateList.
+func (in *VaultPolicyTemplateList) DeepCopy() *VaultPolicyTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPolicyTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VaultPolicyTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPolicyTemplateSpec) DeepCopyInto(out *VaultPolicyTemplateSpec) {
+	*out = *in
+	in.VaultRef.DeepCopyInto(&out.VaultRef)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPolicyTemplateSpec.
+func (in *VaultPolicyTemplateSpec) DeepCopy() *VaultPolicyTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPolicyTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPolicyTemplateStatus) DeepCopyInto(out *VaultPolicyTemplateStatus) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]RenderedPolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PolicyCondition, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPolicyTemplateStatus.
+func (in *VaultPolicyTemplateStatus) DeepCopy() *VaultPolicyTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPolicyTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
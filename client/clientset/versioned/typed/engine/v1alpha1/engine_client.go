@@ -30,15 +30,20 @@ type EngineV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AWSAccessKeyRequestsGetter
 	AWSRolesGetter
+	AppRoleSecretIDRequestsGetter
 	AzureAccessKeyRequestsGetter
 	AzureRolesGetter
 	DatabaseAccessRequestsGetter
 	GCPAccessKeyRequestsGetter
 	GCPRolesGetter
+	LeaseRevocationsGetter
 	MongoDBRolesGetter
 	MySQLRolesGetter
 	PostgresRolesGetter
 	SecretEnginesGetter
+	VaultAppRolesGetter
+	VaultTokenRequestsGetter
+	VaultTokenRolesGetter
 }
 
 // EngineV1alpha1Client is used to interact with features provided by the engine.kubevault.com group.
@@ -54,6 +59,10 @@ func (c *EngineV1alpha1Client) AWSRoles(namespace string) AWSRoleInterface {
 	return newAWSRoles(c, namespace)
 }
 
+func (c *EngineV1alpha1Client) AppRoleSecretIDRequests(namespace string) AppRoleSecretIDRequestInterface {
+	return newAppRoleSecretIDRequests(c, namespace)
+}
+
 func (c *EngineV1alpha1Client) AzureAccessKeyRequests(namespace string) AzureAccessKeyRequestInterface {
 	return newAzureAccessKeyRequests(c, namespace)
 }
@@ -74,6 +83,10 @@ func (c *EngineV1alpha1Client) GCPRoles(namespace string) GCPRoleInterface {
 	return newGCPRoles(c, namespace)
 }
 
+func (c *EngineV1alpha1Client) LeaseRevocations(namespace string) LeaseRevocationInterface {
+	return newLeaseRevocations(c, namespace)
+}
+
 func (c *EngineV1alpha1Client) MongoDBRoles(namespace string) MongoDBRoleInterface {
 	return newMongoDBRoles(c, namespace)
 }
@@ -90,6 +103,18 @@ func (c *EngineV1alpha1Client) SecretEngines(namespace string) SecretEngineInter
 	return newSecretEngines(c, namespace)
 }
 
+func (c *EngineV1alpha1Client) VaultAppRoles(namespace string) VaultAppRoleInterface {
+	return newVaultAppRoles(c, namespace)
+}
+
+func (c *EngineV1alpha1Client) VaultTokenRequests(namespace string) VaultTokenRequestInterface {
+	return newVaultTokenRequests(c, namespace)
+}
+
+func (c *EngineV1alpha1Client) VaultTokenRoles(namespace string) VaultTokenRoleInterface {
+	return newVaultTokenRoles(c, namespace)
+}
+
 // NewForConfig creates a new EngineV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*EngineV1alpha1Client, error) {
 	config := *c
@@ -37,6 +37,10 @@ func (c *FakeEngineV1alpha1) AWSRoles(namespace string) v1alpha1.AWSRoleInterfac
 	return &FakeAWSRoles{c, namespace}
 }
 
+func (c *FakeEngineV1alpha1) AppRoleSecretIDRequests(namespace string) v1alpha1.AppRoleSecretIDRequestInterface {
+	return &FakeAppRoleSecretIDRequests{c, namespace}
+}
+
 func (c *FakeEngineV1alpha1) AzureAccessKeyRequests(namespace string) v1alpha1.AzureAccessKeyRequestInterface {
 	return &FakeAzureAccessKeyRequests{c, namespace}
 }
@@ -57,6 +61,10 @@ func (c *FakeEngineV1alpha1) GCPRoles(namespace string) v1alpha1.GCPRoleInterfac
 	return &FakeGCPRoles{c, namespace}
 }
 
+func (c *FakeEngineV1alpha1) LeaseRevocations(namespace string) v1alpha1.LeaseRevocationInterface {
+	return &FakeLeaseRevocations{c, namespace}
+}
+
 func (c *FakeEngineV1alpha1) MongoDBRoles(namespace string) v1alpha1.MongoDBRoleInterface {
 	return &FakeMongoDBRoles{c, namespace}
 }
@@ -73,6 +81,18 @@ func (c *FakeEngineV1alpha1) SecretEngines(namespace string) v1alpha1.SecretEngi
 	return &FakeSecretEngines{c, namespace}
 }
 
+func (c *FakeEngineV1alpha1) VaultAppRoles(namespace string) v1alpha1.VaultAppRoleInterface {
+	return &FakeVaultAppRoles{c, namespace}
+}
+
+func (c *FakeEngineV1alpha1) VaultTokenRequests(namespace string) v1alpha1.VaultTokenRequestInterface {
+	return &FakeVaultTokenRequests{c, namespace}
+}
+
+func (c *FakeEngineV1alpha1) VaultTokenRoles(namespace string) v1alpha1.VaultTokenRoleInterface {
+	return &FakeVaultTokenRoles{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeEngineV1alpha1) RESTClient() rest.Interface {
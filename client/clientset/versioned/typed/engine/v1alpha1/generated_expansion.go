@@ -22,6 +22,8 @@ type AWSAccessKeyRequestExpansion interface{}
 
 type AWSRoleExpansion interface{}
 
+type AppRoleSecretIDRequestExpansion interface{}
+
 type AzureAccessKeyRequestExpansion interface{}
 
 type AzureRoleExpansion interface{}
@@ -32,6 +34,8 @@ type GCPAccessKeyRequestExpansion interface{}
 
 type GCPRoleExpansion interface{}
 
+type LeaseRevocationExpansion interface{}
+
 type MongoDBRoleExpansion interface{}
 
 type MySQLRoleExpansion interface{}
@@ -39,3 +43,9 @@ type MySQLRoleExpansion interface{}
 type PostgresRoleExpansion interface{}
 
 type SecretEngineExpansion interface{}
+
+type VaultAppRoleExpansion interface{}
+
+type VaultTokenRequestExpansion interface{}
+
+type VaultTokenRoleExpansion interface{}
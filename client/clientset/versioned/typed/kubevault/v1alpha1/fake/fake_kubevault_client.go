@@ -29,6 +29,10 @@ type FakeKubevaultV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeKubevaultV1alpha1) KubernetesAuthClusters(namespace string) v1alpha1.KubernetesAuthClusterInterface {
+	return &FakeKubernetesAuthClusters{c, namespace}
+}
+
 func (c *FakeKubevaultV1alpha1) VaultServers(namespace string) v1alpha1.VaultServerInterface {
 	return &FakeVaultServers{c, namespace}
 }
@@ -28,6 +28,7 @@ import (
 
 type KubevaultV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	KubernetesAuthClustersGetter
 	VaultServersGetter
 }
 
@@ -36,6 +37,10 @@ type KubevaultV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *KubevaultV1alpha1Client) KubernetesAuthClusters(namespace string) KubernetesAuthClusterInterface {
+	return newKubernetesAuthClusters(c, namespace)
+}
+
 func (c *KubevaultV1alpha1Client) VaultServers(namespace string) VaultServerInterface {
 	return newVaultServers(c, namespace)
 }
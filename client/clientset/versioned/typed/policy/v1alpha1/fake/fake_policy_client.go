@@ -29,6 +29,18 @@ type FakePolicyV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakePolicyV1alpha1) ClusterVaultPolicies() v1alpha1.ClusterVaultPolicyInterface {
+	return &FakeClusterVaultPolicies{c}
+}
+
+func (c *FakePolicyV1alpha1) VaultIdentityEntities(namespace string) v1alpha1.VaultIdentityEntityInterface {
+	return &FakeVaultIdentityEntities{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) VaultIdentityGroups(namespace string) v1alpha1.VaultIdentityGroupInterface {
+	return &FakeVaultIdentityGroups{c, namespace}
+}
+
 func (c *FakePolicyV1alpha1) VaultPolicies(namespace string) v1alpha1.VaultPolicyInterface {
 	return &FakeVaultPolicies{c, namespace}
 }
@@ -37,6 +49,10 @@ func (c *FakePolicyV1alpha1) VaultPolicyBindings(namespace string) v1alpha1.Vaul
 	return &FakeVaultPolicyBindings{c, namespace}
 }
 
+func (c *FakePolicyV1alpha1) VaultPolicyTemplates() v1alpha1.VaultPolicyTemplateInterface {
+	return &FakeVaultPolicyTemplates{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakePolicyV1alpha1) RESTClient() rest.Interface {
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+type ClusterVaultPolicyExpansion interface{}
+
+type VaultIdentityEntityExpansion interface{}
+
+type VaultIdentityGroupExpansion interface{}
+
 type VaultPolicyExpansion interface{}
 
 type VaultPolicyBindingExpansion interface{}
+
+type VaultPolicyTemplateExpansion interface{}
@@ -28,8 +28,12 @@ import (
 
 type PolicyV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ClusterVaultPoliciesGetter
+	VaultIdentityEntitiesGetter
+	VaultIdentityGroupsGetter
 	VaultPoliciesGetter
 	VaultPolicyBindingsGetter
+	VaultPolicyTemplatesGetter
 }
 
 // PolicyV1alpha1Client is used to interact with features provided by the policy.kubevault.com group.
@@ -37,6 +41,18 @@ type PolicyV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *PolicyV1alpha1Client) ClusterVaultPolicies() ClusterVaultPolicyInterface {
+	return newClusterVaultPolicies(c)
+}
+
+func (c *PolicyV1alpha1Client) VaultIdentityEntities(namespace string) VaultIdentityEntityInterface {
+	return newVaultIdentityEntities(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) VaultIdentityGroups(namespace string) VaultIdentityGroupInterface {
+	return newVaultIdentityGroups(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) VaultPolicies(namespace string) VaultPolicyInterface {
 	return newVaultPolicies(c, namespace)
 }
@@ -45,6 +61,10 @@ func (c *PolicyV1alpha1Client) VaultPolicyBindings(namespace string) VaultPolicy
 	return newVaultPolicyBindings(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) VaultPolicyTemplates() VaultPolicyTemplateInterface {
+	return newVaultPolicyTemplates(c)
+}
+
 // NewForConfig creates a new PolicyV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*PolicyV1alpha1Client, error) {
 	config := *c
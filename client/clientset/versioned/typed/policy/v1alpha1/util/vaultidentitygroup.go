@@ -0,0 +1,172 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+	cs "kubevault.dev/operator/client/clientset/versioned/typed/policy/v1alpha1"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kutil "kmodules.xyz/client-go"
+)
+
+func CreateOrPatchVaultIdentityGroup(c cs.PolicyV1alpha1Interface, meta metav1.ObjectMeta, transform func(alert *api.VaultIdentityGroup) *api.VaultIdentityGroup) (*api.VaultIdentityGroup, kutil.VerbType, error) {
+	cur, err := c.VaultIdentityGroups(meta.Namespace).Get(meta.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		glog.V(3).Infof("Creating VaultIdentityGroup %s/%s.", meta.Namespace, meta.Name)
+		out, err := c.VaultIdentityGroups(meta.Namespace).Create(transform(&api.VaultIdentityGroup{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       api.ResourceKindVaultIdentityGroup,
+				APIVersion: api.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: meta,
+		}))
+		return out, kutil.VerbCreated, err
+	} else if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+	return PatchVaultIdentityGroup(c, cur, transform)
+}
+
+func PatchVaultIdentityGroup(c cs.PolicyV1alpha1Interface, cur *api.VaultIdentityGroup, transform func(*api.VaultIdentityGroup) *api.VaultIdentityGroup) (*api.VaultIdentityGroup, kutil.VerbType, error) {
+	return PatchVaultIdentityGroupObject(c, cur, transform(cur.DeepCopy()))
+}
+
+func PatchVaultIdentityGroupObject(c cs.PolicyV1alpha1Interface, cur, mod *api.VaultIdentityGroup) (*api.VaultIdentityGroup, kutil.VerbType, error) {
+	curJson, err := json.Marshal(cur)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+
+	modJson, err := json.Marshal(mod)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+
+	patch, err := jsonpatch.CreateMergePatch(curJson, modJson)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+	if len(patch) == 0 || string(patch) == "{}" {
+		return cur, kutil.VerbUnchanged, nil
+	}
+	glog.V(3).Infof("Patching VaultIdentityGroup %s/%s with %s.", cur.Namespace, cur.Name, string(patch))
+	out, err := c.VaultIdentityGroups(cur.Namespace).Patch(cur.Name, types.MergePatchType, patch)
+	return out, kutil.VerbPatched, err
+}
+
+func TryPatchVaultIdentityGroup(c cs.PolicyV1alpha1Interface, cur *api.VaultIdentityGroup, transform func(*api.VaultIdentityGroup) *api.VaultIdentityGroup) (*api.VaultIdentityGroup, error) {
+	var (
+		out *api.VaultIdentityGroup
+		e2  error
+	)
+	attempt := 0
+	err := wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+		attempt++
+		cur, e2 = c.VaultIdentityGroups(cur.Namespace).Get(cur.Name, metav1.GetOptions{})
+		if kerr.IsNotFound(e2) {
+			return false, e2
+		} else if e2 == nil {
+			out, _, e2 = PatchVaultIdentityGroupObject(c, cur, transform(cur.DeepCopy()))
+			return e2 == nil, nil
+		}
+		glog.Errorf("Attempt %d failed to patch VaultIdentityGroup %s/%s due to %v.", attempt, cur.Namespace, cur.Name, e2)
+		return false, nil
+	})
+
+	if err != nil {
+		return nil, errors.Errorf("failed to patch VaultIdentityGroup %s/%s after %d attempts due to %v", cur.Namespace, cur.Name, attempt, err)
+	}
+	return out, nil
+}
+
+func TryUpdateVaultIdentityGroup(c cs.PolicyV1alpha1Interface, meta metav1.ObjectMeta, transform func(*api.VaultIdentityGroup) *api.VaultIdentityGroup) (result *api.VaultIdentityGroup, err error) {
+	attempt := 0
+	err = wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+		attempt++
+		cur, e2 := c.VaultIdentityGroups(meta.Namespace).Get(meta.Name, metav1.GetOptions{})
+		if kerr.IsNotFound(e2) {
+			return false, e2
+		} else if e2 == nil {
+			result, e2 = c.VaultIdentityGroups(cur.Namespace).Update(transform(cur.DeepCopy()))
+			return e2 == nil, nil
+		}
+		glog.Errorf("Attempt %d failed to update VaultIdentityGroup %s/%s due to %v.", attempt, cur.Namespace, cur.Name, e2)
+		return false, nil
+	})
+
+	if err != nil {
+		err = errors.Errorf("failed to update VaultIdentityGroup %s/%s after %d attempts due to %v", meta.Namespace, meta.Name, attempt, err)
+	}
+	return
+}
+
+func UpdateVaultIdentityGroupStatus(
+	c cs.PolicyV1alpha1Interface,
+	in *api.VaultIdentityGroup,
+	transform func(*api.VaultIdentityGroupStatus) *api.VaultIdentityGroupStatus,
+) (result *api.VaultIdentityGroup, err error) {
+	apply := func(x *api.VaultIdentityGroup, copy bool) *api.VaultIdentityGroup {
+		out := &api.VaultIdentityGroup{
+			TypeMeta:   x.TypeMeta,
+			ObjectMeta: x.ObjectMeta,
+			Spec:       x.Spec,
+		}
+		if copy {
+			out.Status = *transform(in.Status.DeepCopy())
+		} else {
+			out.Status = *transform(&in.Status)
+		}
+		return out
+	}
+
+	attempt := 0
+	cur := in.DeepCopy()
+	err = wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+		attempt++
+		var e2 error
+		result, e2 = c.VaultIdentityGroups(in.Namespace).UpdateStatus(apply(cur, false))
+		if kerr.IsConflict(e2) {
+			latest, e3 := c.VaultIdentityGroups(in.Namespace).Get(in.Name, metav1.GetOptions{})
+			switch {
+			case e3 == nil:
+				cur = latest
+				return false, nil
+			case kutil.IsRequestRetryable(e3):
+				return false, nil
+			default:
+				return false, e3
+			}
+		} else if err != nil && !kutil.IsRequestRetryable(e2) {
+			return false, e2
+		}
+		return e2 == nil, nil
+	})
+
+	if err != nil {
+		err = fmt.Errorf("failed to update status of VaultIdentityGroup %s/%s after %d attempts due to %v", in.Namespace, in.Name, attempt, err)
+	}
+	return
+}
@@ -0,0 +1,172 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+	cs "kubevault.dev/operator/client/clientset/versioned/typed/policy/v1alpha1"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kutil "kmodules.xyz/client-go"
+)
+
+func CreateOrPatchVaultPolicyTemplate(c cs.PolicyV1alpha1Interface, meta metav1.ObjectMeta, transform func(alert *api.VaultPolicyTemplate) *api.VaultPolicyTemplate) (*api.VaultPolicyTemplate, kutil.VerbType, error) {
+	cur, err := c.VaultPolicyTemplates().Get(meta.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		glog.V(3).Infof("Creating VaultPolicyTemplate %s.", meta.Name)
+		out, err := c.VaultPolicyTemplates().Create(transform(&api.VaultPolicyTemplate{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       api.ResourceKindVaultPolicyTemplate,
+				APIVersion: api.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: meta,
+		}))
+		return out, kutil.VerbCreated, err
+	} else if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+	return PatchVaultPolicyTemplate(c, cur, transform)
+}
+
+func PatchVaultPolicyTemplate(c cs.PolicyV1alpha1Interface, cur *api.VaultPolicyTemplate, transform func(*api.VaultPolicyTemplate) *api.VaultPolicyTemplate) (*api.VaultPolicyTemplate, kutil.VerbType, error) {
+	return PatchVaultPolicyTemplateObject(c, cur, transform(cur.DeepCopy()))
+}
+
+func PatchVaultPolicyTemplateObject(c cs.PolicyV1alpha1Interface, cur, mod *api.VaultPolicyTemplate) (*api.VaultPolicyTemplate, kutil.VerbType, error) {
+	curJson, err := json.Marshal(cur)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+
+	modJson, err := json.Marshal(mod)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+
+	patch, err := jsonpatch.CreateMergePatch(curJson, modJson)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+	if len(patch) == 0 || string(patch) == "{}" {
+		return cur, kutil.VerbUnchanged, nil
+	}
+	glog.V(3).Infof("Patching VaultPolicyTemplate %s with %s.", cur.Name, string(patch))
+	out, err := c.VaultPolicyTemplates().Patch(cur.Name, types.MergePatchType, patch)
+	return out, kutil.VerbPatched, err
+}
+
+func TryPatchVaultPolicyTemplate(c cs.PolicyV1alpha1Interface, cur *api.VaultPolicyTemplate, transform func(*api.VaultPolicyTemplate) *api.VaultPolicyTemplate) (*api.VaultPolicyTemplate, error) {
+	var (
+		out *api.VaultPolicyTemplate
+		e2  error
+	)
+	attempt := 0
+	err := wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+		attempt++
+		cur, e2 = c.VaultPolicyTemplates().Get(cur.Name, metav1.GetOptions{})
+		if kerr.IsNotFound(e2) {
+			return false, e2
+		} else if e2 == nil {
+			out, _, e2 = PatchVaultPolicyTemplateObject(c, cur, transform(cur.DeepCopy()))
+			return e2 == nil, nil
+		}
+		glog.Errorf("Attempt %d failed to patch VaultPolicyTemplate %s due to %v.", attempt, cur.Name, e2)
+		return false, nil
+	})
+
+	if err != nil {
+		return nil, errors.Errorf("failed to patch VaultPolicyTemplate %s after %d attempts due to %v", cur.Name, attempt, err)
+	}
+	return out, nil
+}
+
+func TryUpdateVaultPolicyTemplate(c cs.PolicyV1alpha1Interface, meta metav1.ObjectMeta, transform func(*api.VaultPolicyTemplate) *api.VaultPolicyTemplate) (result *api.VaultPolicyTemplate, err error) {
+	attempt := 0
+	err = wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+		attempt++
+		cur, e2 := c.VaultPolicyTemplates().Get(meta.Name, metav1.GetOptions{})
+		if kerr.IsNotFound(e2) {
+			return false, e2
+		} else if e2 == nil {
+			result, e2 = c.VaultPolicyTemplates().Update(transform(cur.DeepCopy()))
+			return e2 == nil, nil
+		}
+		glog.Errorf("Attempt %d failed to update VaultPolicyTemplate %s due to %v.", attempt, cur.Name, e2)
+		return false, nil
+	})
+
+	if err != nil {
+		err = errors.Errorf("failed to update VaultPolicyTemplate %s after %d attempts due to %v", meta.Name, attempt, err)
+	}
+	return
+}
+
+func UpdateVaultPolicyTemplateStatus(
+	c cs.PolicyV1alpha1Interface,
+	in *api.VaultPolicyTemplate,
+	transform func(*api.VaultPolicyTemplateStatus) *api.VaultPolicyTemplateStatus,
+) (result *api.VaultPolicyTemplate, err error) {
+	apply := func(x *api.VaultPolicyTemplate, copy bool) *api.VaultPolicyTemplate {
+		out := &api.VaultPolicyTemplate{
+			TypeMeta:   x.TypeMeta,
+			ObjectMeta: x.ObjectMeta,
+			Spec:       x.Spec,
+		}
+		if copy {
+			out.Status = *transform(in.Status.DeepCopy())
+		} else {
+			out.Status = *transform(&in.Status)
+		}
+		return out
+	}
+
+	attempt := 0
+	cur := in.DeepCopy()
+	err = wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+		attempt++
+		var e2 error
+		result, e2 = c.VaultPolicyTemplates().UpdateStatus(apply(cur, false))
+		if kerr.IsConflict(e2) {
+			latest, e3 := c.VaultPolicyTemplates().Get(in.Name, metav1.GetOptions{})
+			switch {
+			case e3 == nil:
+				cur = latest
+				return false, nil
+			case kutil.IsRequestRetryable(e3):
+				return false, nil
+			default:
+				return false, e3
+			}
+		} else if err != nil && !kutil.IsRequestRetryable(e2) {
+			return false, e2
+		}
+		return e2 == nil, nil
+	})
+
+	if err != nil {
+		err = fmt.Errorf("failed to update status of VaultPolicyTemplate %s after %d attempts due to %v", in.Name, attempt, err)
+	}
+	return
+}
@@ -28,6 +28,8 @@ type Interface interface {
 	AWSAccessKeyRequests() AWSAccessKeyRequestInformer
 	// AWSRoles returns a AWSRoleInformer.
 	AWSRoles() AWSRoleInformer
+	// AppRoleSecretIDRequests returns a AppRoleSecretIDRequestInformer.
+	AppRoleSecretIDRequests() AppRoleSecretIDRequestInformer
 	// AzureAccessKeyRequests returns a AzureAccessKeyRequestInformer.
 	AzureAccessKeyRequests() AzureAccessKeyRequestInformer
 	// AzureRoles returns a AzureRoleInformer.
@@ -38,6 +40,8 @@ type Interface interface {
 	GCPAccessKeyRequests() GCPAccessKeyRequestInformer
 	// GCPRoles returns a GCPRoleInformer.
 	GCPRoles() GCPRoleInformer
+	// LeaseRevocations returns a LeaseRevocationInformer.
+	LeaseRevocations() LeaseRevocationInformer
 	// MongoDBRoles returns a MongoDBRoleInformer.
 	MongoDBRoles() MongoDBRoleInformer
 	// MySQLRoles returns a MySQLRoleInformer.
@@ -46,6 +50,12 @@ type Interface interface {
 	PostgresRoles() PostgresRoleInformer
 	// SecretEngines returns a SecretEngineInformer.
 	SecretEngines() SecretEngineInformer
+	// VaultAppRoles returns a VaultAppRoleInformer.
+	VaultAppRoles() VaultAppRoleInformer
+	// VaultTokenRequests returns a VaultTokenRequestInformer.
+	VaultTokenRequests() VaultTokenRequestInformer
+	// VaultTokenRoles returns a VaultTokenRoleInformer.
+	VaultTokenRoles() VaultTokenRoleInformer
 }
 
 type version struct {
@@ -69,6 +79,11 @@ func (v *version) AWSRoles() AWSRoleInformer {
 	return &aWSRoleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// AppRoleSecretIDRequests returns a AppRoleSecretIDRequestInformer.
+func (v *version) AppRoleSecretIDRequests() AppRoleSecretIDRequestInformer {
+	return &appRoleSecretIDRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // AzureAccessKeyRequests returns a AzureAccessKeyRequestInformer.
 func (v *version) AzureAccessKeyRequests() AzureAccessKeyRequestInformer {
 	return &azureAccessKeyRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -94,6 +109,11 @@ func (v *version) GCPRoles() GCPRoleInformer {
 	return &gCPRoleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// LeaseRevocations returns a LeaseRevocationInformer.
+func (v *version) LeaseRevocations() LeaseRevocationInformer {
+	return &leaseRevocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // MongoDBRoles returns a MongoDBRoleInformer.
 func (v *version) MongoDBRoles() MongoDBRoleInformer {
 	return &mongoDBRoleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -113,3 +133,18 @@ func (v *version) PostgresRoles() PostgresRoleInformer {
 func (v *version) SecretEngines() SecretEngineInformer {
 	return &secretEngineInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// VaultAppRoles returns a VaultAppRoleInformer.
+func (v *version) VaultAppRoles() VaultAppRoleInformer {
+	return &vaultAppRoleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VaultTokenRequests returns a VaultTokenRequestInformer.
+func (v *version) VaultTokenRequests() VaultTokenRequestInformer {
+	return &vaultTokenRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VaultTokenRoles returns a VaultTokenRoleInformer.
+func (v *version) VaultTokenRoles() VaultTokenRoleInformer {
+	return &vaultTokenRoleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
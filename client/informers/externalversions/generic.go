@@ -65,6 +65,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().AWSAccessKeyRequests().Informer()}, nil
 	case enginev1alpha1.SchemeGroupVersion.WithResource("awsroles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().AWSRoles().Informer()}, nil
+	case enginev1alpha1.SchemeGroupVersion.WithResource("approlesecretidrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().AppRoleSecretIDRequests().Informer()}, nil
 	case enginev1alpha1.SchemeGroupVersion.WithResource("azureaccesskeyrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().AzureAccessKeyRequests().Informer()}, nil
 	case enginev1alpha1.SchemeGroupVersion.WithResource("azureroles"):
@@ -75,6 +77,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().GCPAccessKeyRequests().Informer()}, nil
 	case enginev1alpha1.SchemeGroupVersion.WithResource("gcproles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().GCPRoles().Informer()}, nil
+	case enginev1alpha1.SchemeGroupVersion.WithResource("leaserevocations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().LeaseRevocations().Informer()}, nil
 	case enginev1alpha1.SchemeGroupVersion.WithResource("mongodbroles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().MongoDBRoles().Informer()}, nil
 	case enginev1alpha1.SchemeGroupVersion.WithResource("mysqlroles"):
@@ -83,16 +87,32 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().PostgresRoles().Informer()}, nil
 	case enginev1alpha1.SchemeGroupVersion.WithResource("secretengines"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().SecretEngines().Informer()}, nil
+	case enginev1alpha1.SchemeGroupVersion.WithResource("vaultapproles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().VaultAppRoles().Informer()}, nil
+	case enginev1alpha1.SchemeGroupVersion.WithResource("vaulttokenrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().VaultTokenRequests().Informer()}, nil
+	case enginev1alpha1.SchemeGroupVersion.WithResource("vaulttokenroles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Engine().V1alpha1().VaultTokenRoles().Informer()}, nil
 
 		// Group=kubevault.com, Version=v1alpha1
+	case kubevaultv1alpha1.SchemeGroupVersion.WithResource("kubernetesauthclusters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubevault().V1alpha1().KubernetesAuthClusters().Informer()}, nil
 	case kubevaultv1alpha1.SchemeGroupVersion.WithResource("vaultservers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubevault().V1alpha1().VaultServers().Informer()}, nil
 
 		// Group=policy.kubevault.com, Version=v1alpha1
+	case policyv1alpha1.SchemeGroupVersion.WithResource("clustervaultpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().ClusterVaultPolicies().Informer()}, nil
+	case policyv1alpha1.SchemeGroupVersion.WithResource("vaultidentityentities"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().VaultIdentityEntities().Informer()}, nil
+	case policyv1alpha1.SchemeGroupVersion.WithResource("vaultidentitygroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().VaultIdentityGroups().Informer()}, nil
 	case policyv1alpha1.SchemeGroupVersion.WithResource("vaultpolicies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().VaultPolicies().Informer()}, nil
 	case policyv1alpha1.SchemeGroupVersion.WithResource("vaultpolicybindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().VaultPolicyBindings().Informer()}, nil
+	case policyv1alpha1.SchemeGroupVersion.WithResource("vaultpolicytemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().VaultPolicyTemplates().Informer()}, nil
 
 	}
 
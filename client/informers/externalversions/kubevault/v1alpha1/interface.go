@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// KubernetesAuthClusters returns a KubernetesAuthClusterInformer.
+	KubernetesAuthClusters() KubernetesAuthClusterInformer
 	// VaultServers returns a VaultServerInformer.
 	VaultServers() VaultServerInformer
 }
@@ -39,6 +41,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// KubernetesAuthClusters returns a KubernetesAuthClusterInformer.
+func (v *version) KubernetesAuthClusters() KubernetesAuthClusterInformer {
+	return &kubernetesAuthClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VaultServers returns a VaultServerInformer.
 func (v *version) VaultServers() VaultServerInformer {
 	return &vaultServerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
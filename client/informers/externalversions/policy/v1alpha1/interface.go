@@ -24,10 +24,18 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterVaultPolicies returns a ClusterVaultPolicyInformer.
+	ClusterVaultPolicies() ClusterVaultPolicyInformer
+	// VaultIdentityEntities returns a VaultIdentityEntityInformer.
+	VaultIdentityEntities() VaultIdentityEntityInformer
+	// VaultIdentityGroups returns a VaultIdentityGroupInformer.
+	VaultIdentityGroups() VaultIdentityGroupInformer
 	// VaultPolicies returns a VaultPolicyInformer.
 	VaultPolicies() VaultPolicyInformer
 	// VaultPolicyBindings returns a VaultPolicyBindingInformer.
 	VaultPolicyBindings() VaultPolicyBindingInformer
+	// VaultPolicyTemplates returns a VaultPolicyTemplateInformer.
+	VaultPolicyTemplates() VaultPolicyTemplateInformer
 }
 
 type version struct {
@@ -41,6 +49,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterVaultPolicies returns a ClusterVaultPolicyInformer.
+func (v *version) ClusterVaultPolicies() ClusterVaultPolicyInformer {
+	return &clusterVaultPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// VaultIdentityEntities returns a VaultIdentityEntityInformer.
+func (v *version) VaultIdentityEntities() VaultIdentityEntityInformer {
+	return &vaultIdentityEntityInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VaultIdentityGroups returns a VaultIdentityGroupInformer.
+func (v *version) VaultIdentityGroups() VaultIdentityGroupInformer {
+	return &vaultIdentityGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VaultPolicies returns a VaultPolicyInformer.
 func (v *version) VaultPolicies() VaultPolicyInformer {
 	return &vaultPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -50,3 +73,8 @@ func (v *version) VaultPolicies() VaultPolicyInformer {
 func (v *version) VaultPolicyBindings() VaultPolicyBindingInformer {
 	return &vaultPolicyBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// VaultPolicyTemplates returns a VaultPolicyTemplateInformer.
+func (v *version) VaultPolicyTemplates() VaultPolicyTemplateInformer {
+	return &vaultPolicyTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
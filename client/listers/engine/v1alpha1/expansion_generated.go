@@ -34,6 +34,14 @@ type AWSRoleListerExpansion interface{}
 // AWSRoleNamespaceLister.
 type AWSRoleNamespaceListerExpansion interface{}
 
+// AppRoleSecretIDRequestListerExpansion allows custom methods to be added to
+// AppRoleSecretIDRequestLister.
+type AppRoleSecretIDRequestListerExpansion interface{}
+
+// AppRoleSecretIDRequestNamespaceListerExpansion allows custom methods to be added to
+// AppRoleSecretIDRequestNamespaceLister.
+type AppRoleSecretIDRequestNamespaceListerExpansion interface{}
+
 // AzureAccessKeyRequestListerExpansion allows custom methods to be added to
 // AzureAccessKeyRequestLister.
 type AzureAccessKeyRequestListerExpansion interface{}
@@ -74,6 +82,14 @@ type GCPRoleListerExpansion interface{}
 // GCPRoleNamespaceLister.
 type GCPRoleNamespaceListerExpansion interface{}
 
+// LeaseRevocationListerExpansion allows custom methods to be added to
+// LeaseRevocationLister.
+type LeaseRevocationListerExpansion interface{}
+
+// LeaseRevocationNamespaceListerExpansion allows custom methods to be added to
+// LeaseRevocationNamespaceLister.
+type LeaseRevocationNamespaceListerExpansion interface{}
+
 // MongoDBRoleListerExpansion allows custom methods to be added to
 // MongoDBRoleLister.
 type MongoDBRoleListerExpansion interface{}
@@ -105,3 +121,27 @@ type SecretEngineListerExpansion interface{}
 // SecretEngineNamespaceListerExpansion allows custom methods to be added to
 // SecretEngineNamespaceLister.
 type SecretEngineNamespaceListerExpansion interface{}
+
+// VaultAppRoleListerExpansion allows custom methods to be added to
+// VaultAppRoleLister.
+type VaultAppRoleListerExpansion interface{}
+
+// VaultAppRoleNamespaceListerExpansion allows custom methods to be added to
+// VaultAppRoleNamespaceLister.
+type VaultAppRoleNamespaceListerExpansion interface{}
+
+// VaultTokenRequestListerExpansion allows custom methods to be added to
+// VaultTokenRequestLister.
+type VaultTokenRequestListerExpansion interface{}
+
+// VaultTokenRequestNamespaceListerExpansion allows custom methods to be added to
+// VaultTokenRequestNamespaceLister.
+type VaultTokenRequestNamespaceListerExpansion interface{}
+
+// VaultTokenRoleListerExpansion allows custom methods to be added to
+// VaultTokenRoleLister.
+type VaultTokenRoleListerExpansion interface{}
+
+// VaultTokenRoleNamespaceListerExpansion allows custom methods to be added to
+// VaultTokenRoleNamespaceLister.
+type VaultTokenRoleNamespaceListerExpansion interface{}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// KubernetesAuthClusterListerExpansion allows custom methods to be added to
+// KubernetesAuthClusterLister.
+type KubernetesAuthClusterListerExpansion interface{}
+
+// KubernetesAuthClusterNamespaceListerExpansion allows custom methods to be added to
+// KubernetesAuthClusterNamespaceLister.
+type KubernetesAuthClusterNamespaceListerExpansion interface{}
+
 // VaultServerListerExpansion allows custom methods to be added to
 // VaultServerLister.
 type VaultServerListerExpansion interface{}
@@ -18,6 +18,26 @@ limitations under the License.
 
 package v1alpha1
 
+// ClusterVaultPolicyListerExpansion allows custom methods to be added to
+// ClusterVaultPolicyLister.
+type ClusterVaultPolicyListerExpansion interface{}
+
+// VaultIdentityEntityListerExpansion allows custom methods to be added to
+// VaultIdentityEntityLister.
+type VaultIdentityEntityListerExpansion interface{}
+
+// VaultIdentityEntityNamespaceListerExpansion allows custom methods to be added to
+// VaultIdentityEntityNamespaceLister.
+type VaultIdentityEntityNamespaceListerExpansion interface{}
+
+// VaultIdentityGroupListerExpansion allows custom methods to be added to
+// VaultIdentityGroupLister.
+type VaultIdentityGroupListerExpansion interface{}
+
+// VaultIdentityGroupNamespaceListerExpansion allows custom methods to be added to
+// VaultIdentityGroupNamespaceLister.
+type VaultIdentityGroupNamespaceListerExpansion interface{}
+
 // VaultPolicyListerExpansion allows custom methods to be added to
 // VaultPolicyLister.
 type VaultPolicyListerExpansion interface{}
@@ -33,3 +53,7 @@ type VaultPolicyBindingListerExpansion interface{}
 // VaultPolicyBindingNamespaceListerExpansion allows custom methods to be added to
 // VaultPolicyBindingNamespaceLister.
 type VaultPolicyBindingNamespaceListerExpansion interface{}
+
+// VaultPolicyTemplateListerExpansion allows custom methods to be added to
+// VaultPolicyTemplateLister.
+type VaultPolicyTemplateListerExpansion interface{}
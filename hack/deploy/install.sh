@@ -18,9 +18,14 @@ set -eou pipefail
 
 crds=(
     vaultservers.kubevault.com
+    kubernetesauthclusters.kubevault.com
     vaultserverversions.catalog.kubevault.com
     vaultpolicies.policy.kubevault.com
     vaultpolicybindings.policy.kubevault.com
+    vaultpolicytemplates.policy.kubevault.com
+    clustervaultpolicies.policy.kubevault.com
+    vaultidentityentities.policy.kubevault.com
+    vaultidentitygroups.policy.kubevault.com
     databaseaccessrequests.engine.kubevault.com
     mongodbroles.engine.kubevault.com
     mysqlroles.engine.kubevault.com
@@ -32,6 +37,11 @@ crds=(
     azureroles.engine.kubevault.com
     azureaccesskeyrequests.engine.kubevault.com
     secretengines.engine.kubevault.com
+    vaultapproles.engine.kubevault.com
+    approlesecretidrequests.engine.kubevault.com
+    vaulttokenroles.engine.kubevault.com
+    vaulttokenrequests.engine.kubevault.com
+    leaserevocations.engine.kubevault.com
 )
 apiServices=(
     v1alpha1.validators.kubevault.com
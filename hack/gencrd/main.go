@@ -74,9 +74,14 @@ func generateSwaggerJson() {
 		//nolint:govet
 		Resources: []openapi.TypeInfo{
 			{vaultv1alpha1.SchemeGroupVersion, vaultv1alpha1.ResourceVaultServers, vaultv1alpha1.ResourceKindVaultServer, true},
+			{vaultv1alpha1.SchemeGroupVersion, vaultv1alpha1.ResourceKubernetesAuthClusters, vaultv1alpha1.ResourceKindKubernetesAuthCluster, true},
 			{catalogv1alpha1.SchemeGroupVersion, catalogv1alpha1.ResourceVaultServerVersions, catalogv1alpha1.ResourceKindVaultServerVersion, false},
 			{policyv1alpha1.SchemeGroupVersion, policyv1alpha1.ResourceVaultPolicies, policyv1alpha1.ResourceKindVaultPolicy, true},
 			{policyv1alpha1.SchemeGroupVersion, policyv1alpha1.ResourceVaultPolicyBindings, policyv1alpha1.ResourceKindVaultPolicyBinding, true},
+			{policyv1alpha1.SchemeGroupVersion, policyv1alpha1.ResourceVaultPolicyTemplates, policyv1alpha1.ResourceKindVaultPolicyTemplate, false},
+			{policyv1alpha1.SchemeGroupVersion, policyv1alpha1.ResourceClusterVaultPolicies, policyv1alpha1.ResourceKindClusterVaultPolicy, false},
+			{policyv1alpha1.SchemeGroupVersion, policyv1alpha1.ResourceVaultIdentityEntities, policyv1alpha1.ResourceKindVaultIdentityEntity, true},
+			{policyv1alpha1.SchemeGroupVersion, policyv1alpha1.ResourceVaultIdentityGroups, policyv1alpha1.ResourceKindVaultIdentityGroup, true},
 			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceSecretEngines, enginev1alpha1.ResourceKindSecretEngine, true},
 			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceAWSRoles, enginev1alpha1.ResourceKindAWSRole, true},
 			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceAWSAccessKeyRequests, enginev1alpha1.ResourceKindAWSAccessKeyRequest, true},
@@ -88,6 +93,11 @@ func generateSwaggerJson() {
 			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceMongoDBRoles, enginev1alpha1.ResourceKindMongoDBRole, true},
 			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceMySQLRoles, enginev1alpha1.ResourceKindMySQLRole, true},
 			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourcePostgresRoles, enginev1alpha1.ResourceKindPostgresRole, true},
+			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceVaultAppRoles, enginev1alpha1.ResourceKindVaultAppRole, true},
+			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceAppRoleSecretIDRequests, enginev1alpha1.ResourceKindAppRoleSecretIDRequest, true},
+			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceVaultTokenRoles, enginev1alpha1.ResourceKindVaultTokenRole, true},
+			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceVaultTokenRequests, enginev1alpha1.ResourceKindVaultTokenRequest, true},
+			{enginev1alpha1.SchemeGroupVersion, enginev1alpha1.ResourceLeaseRevocations, enginev1alpha1.ResourceKindLeaseRevocation, true},
 		},
 	})
 	if err != nil {
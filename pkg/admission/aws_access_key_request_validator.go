@@ -19,9 +19,12 @@ import (
 	"sync"
 
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	cs "kubevault.dev/operator/client/clientset/versioned"
 
 	"github.com/pkg/errors"
 	admission "k8s.io/api/admission/v1beta1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	meta_util "kmodules.xyz/client-go/meta"
@@ -34,6 +37,7 @@ const (
 )
 
 type AWSAccessKeyRequestValidator struct {
+	extClient   cs.Interface
 	lock        sync.RWMutex
 	initialized bool
 }
@@ -53,6 +57,10 @@ func (v *AWSAccessKeyRequestValidator) Initialize(config *rest.Config, stopCh <-
 	v.lock.Lock()
 	defer v.lock.Unlock()
 
+	var err error
+	if v.extClient, err = cs.NewForConfig(config); err != nil {
+		return err
+	}
 	v.initialized = true
 	return nil
 }
@@ -60,7 +68,7 @@ func (v *AWSAccessKeyRequestValidator) Initialize(config *rest.Config, stopCh <-
 func (v *AWSAccessKeyRequestValidator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
 	status := &admission.AdmissionResponse{}
 
-	if req.Operation != admission.Update ||
+	if (req.Operation != admission.Create && req.Operation != admission.Update) ||
 		len(req.SubResource) != 0 ||
 		req.Kind.Group != api.SchemeGroupVersion.Group ||
 		req.Kind.Kind != api.ResourceKindAWSAccessKeyRequest {
@@ -74,18 +82,36 @@ func (v *AWSAccessKeyRequestValidator) Admit(req *admission.AdmissionRequest) *a
 		return hookapi.StatusUninitialized()
 	}
 
-	if req.Operation == admission.Update {
-		obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
-		if err != nil {
-			return hookapi.StatusBadRequest(err)
+	obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	awsAKReq := obj.(*api.AWSAccessKeyRequest).DeepCopy()
+	if err := awsAKReq.IsValid(); err != nil {
+		return hookapi.StatusForbidden(err)
+	}
+
+	if awsAKReq.Spec.TTL != "" {
+		// the limit of the ttl depends on the credential type of the role,
+		// the role may not be created yet, that is checked while issuing the credential
+		role, err := v.extClient.EngineV1alpha1().AWSRoles(awsAKReq.Spec.RoleRef.Namespace).Get(awsAKReq.Spec.RoleRef.Name, metav1.GetOptions{})
+		if err != nil && !kerr.IsNotFound(err) {
+			return hookapi.StatusInternalServerError(err)
+		}
+		if err == nil {
+			if err := awsAKReq.IsValidFor(role.Spec.CredentialType); err != nil {
+				return hookapi.StatusForbidden(err)
+			}
 		}
+	}
+
+	if req.Operation == admission.Update {
 		// validate changes made by user
 		oldObject, err := meta_util.UnmarshalFromJSON(req.OldObject.Raw, api.SchemeGroupVersion)
 		if err != nil {
 			return hookapi.StatusBadRequest(err)
 		}
 
-		awsAKReq := obj.(*api.AWSAccessKeyRequest).DeepCopy()
 		oldAwsAKReq := oldObject.(*api.AWSAccessKeyRequest).DeepCopy()
 
 		isApprovedOrDenied := false
@@ -0,0 +1,136 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	csfake "kubevault.dev/operator/client/clientset/versioned/fake"
+
+	"github.com/stretchr/testify/assert"
+	admission "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAWSAccessKeyRequestValidator_Admit(t *testing.T) {
+	role := func(name string, credentialType api.AWSCredentialType) *api.AWSRole {
+		return &api.AWSRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "demo",
+			},
+			Spec: api.AWSRoleSpec{
+				CredentialType: credentialType,
+			},
+		}
+	}
+	v := &AWSAccessKeyRequestValidator{
+		extClient:   csfake.NewSimpleClientset(role("assumed", api.AWSCredentialAssumedRole), role("federated", api.AWSCredentialFederationToken), role("iam", api.AWSCredentialIAMUser)),
+		initialized: true,
+	}
+
+	cases := []struct {
+		testName string
+		roleName string
+		ttl      string
+		allowed  bool
+	}{
+		{
+			testName: "assumed role, ttl within bounds, expect allowed",
+			roleName: "assumed",
+			ttl:      "12h",
+			allowed:  true,
+		},
+		{
+			testName: "assumed role, ttl out of bounds, expect denied",
+			roleName: "assumed",
+			ttl:      "13h",
+			allowed:  false,
+		},
+		{
+			testName: "federation token, ttl within bounds, expect allowed",
+			roleName: "federated",
+			ttl:      "36h",
+			allowed:  true,
+		},
+		{
+			testName: "federation token, ttl out of bounds, expect denied",
+			roleName: "federated",
+			ttl:      "37h",
+			allowed:  false,
+		},
+		{
+			testName: "assumed role, ttl below bounds, expect denied",
+			roleName: "assumed",
+			ttl:      "10m",
+			allowed:  false,
+		},
+		{
+			testName: "iam user, ttl out of sts bounds, expect allowed",
+			roleName: "iam",
+			ttl:      "5m",
+			allowed:  true,
+		},
+		{
+			testName: "role not found, expect allowed",
+			roleName: "unknown",
+			ttl:      "13h",
+			allowed:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			awsAKReq := &api.AWSAccessKeyRequest{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: api.SchemeGroupVersion.String(),
+					Kind:       api.ResourceKindAWSAccessKeyRequest,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-cred",
+					Namespace: "demo",
+				},
+				Spec: api.AWSAccessKeyRequestSpec{
+					RoleRef: api.RoleRef{
+						Name:      c.roleName,
+						Namespace: "demo",
+					},
+					TTL: c.ttl,
+				},
+			}
+			raw, err := json.Marshal(awsAKReq)
+			if !assert.Nil(t, err) {
+				return
+			}
+			resp := v.Admit(&admission.AdmissionRequest{
+				Operation: admission.Create,
+				Kind: metav1.GroupVersionKind{
+					Group:   api.SchemeGroupVersion.Group,
+					Version: api.SchemeGroupVersion.Version,
+					Kind:    api.ResourceKindAWSAccessKeyRequest,
+				},
+				Object: runtime.RawExtension{
+					Raw: raw,
+				},
+			})
+			assert.Equal(t, c.allowed, resp.Allowed)
+		})
+	}
+}
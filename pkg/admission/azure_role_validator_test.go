@@ -0,0 +1,120 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package admission
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	admission "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newAzureRoleAdmissionRequest(t *testing.T, spec api.AzureRoleSpec) *admission.AdmissionRequest {
+	role := &api.AzureRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: api.SchemeGroupVersion.String(),
+			Kind:       api.ResourceKindAzureRole,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "azure-role",
+			Namespace: "demo",
+		},
+		Spec: spec,
+	}
+	raw, err := json.Marshal(role)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	return &admission.AdmissionRequest{
+		Operation: admission.Create,
+		Kind: metav1.GroupVersionKind{
+			Group:   api.SchemeGroupVersion.Group,
+			Version: api.SchemeGroupVersion.Version,
+			Kind:    api.ResourceKindAzureRole,
+		},
+		Object: runtime.RawExtension{
+			Raw: raw,
+		},
+	}
+}
+
+func TestAzureRoleValidator_Admit(t *testing.T) {
+	trueVar := true
+	cases := []struct {
+		testName string
+		spec     api.AzureRoleSpec
+		allowed  bool
+	}{
+		{
+			testName: "azure roles, expect allowed",
+			spec: api.AzureRoleSpec{
+				AzureRoles: `[{"role_name": "Contributor", "scope": "/subscriptions/<uuid>"}]`,
+			},
+			allowed: true,
+		},
+		{
+			testName: "azure groups, permanently deleted, expect allowed",
+			spec: api.AzureRoleSpec{
+				AzureGroups:       `[{"group_name": "foo"}]`,
+				PermanentlyDelete: &trueVar,
+			},
+			allowed: true,
+		},
+		{
+			testName: "existing service principal, expect allowed",
+			spec: api.AzureRoleSpec{
+				ApplicationObjectID: "3454-435-435-34",
+			},
+			allowed: true,
+		},
+		{
+			testName: "existing service principal, permanently deleted, expect denied",
+			spec: api.AzureRoleSpec{
+				ApplicationObjectID: "3454-435-435-34",
+				PermanentlyDelete:   &trueVar,
+			},
+			allowed: false,
+		},
+		{
+			testName: "azure groups is not a json array, expect denied",
+			spec: api.AzureRoleSpec{
+				AzureGroups: `{"group_name": "foo"}`,
+			},
+			allowed: false,
+		},
+		{
+			testName: "neither azure roles, azure groups nor existing service principal, expect denied",
+			spec:     api.AzureRoleSpec{},
+			allowed:  false,
+		},
+	}
+
+	v := &AzureRoleValidator{}
+	if !assert.Nil(t, v.Initialize(nil, nil)) {
+		return
+	}
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			resp := v.Admit(newAzureRoleAdmissionRequest(t, c.spec))
+			assert.Equal(t, c.allowed, resp.Allowed, "%v", resp.Result)
+		})
+	}
+}
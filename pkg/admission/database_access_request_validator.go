@@ -60,7 +60,7 @@ func (v *DatabaseAccessRequestValidator) Initialize(config *rest.Config, stopCh
 func (v *DatabaseAccessRequestValidator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
 	status := &admission.AdmissionResponse{}
 
-	if req.Operation != admission.Update ||
+	if (req.Operation != admission.Create && req.Operation != admission.Update) ||
 		len(req.SubResource) != 0 ||
 		req.Kind.Group != api.SchemeGroupVersion.Group ||
 		req.Kind.Kind != api.ResourceKindDatabaseAccessRequest {
@@ -74,18 +74,22 @@ func (v *DatabaseAccessRequestValidator) Admit(req *admission.AdmissionRequest)
 		return hookapi.StatusUninitialized()
 	}
 
+	obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	dbAReq := obj.(*api.DatabaseAccessRequest).DeepCopy()
+	if err := dbAReq.IsValid(); err != nil {
+		return hookapi.StatusForbidden(err)
+	}
+
 	if req.Operation == admission.Update {
-		obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
-		if err != nil {
-			return hookapi.StatusBadRequest(err)
-		}
 		// validate changes made by user
 		oldObject, err := meta_util.UnmarshalFromJSON(req.OldObject.Raw, api.SchemeGroupVersion)
 		if err != nil {
 			return hookapi.StatusBadRequest(err)
 		}
 
-		dbAReq := obj.(*api.DatabaseAccessRequest).DeepCopy()
 		oldDbAReq := oldObject.(*api.DatabaseAccessRequest).DeepCopy()
 
 		isApprovedOrDenied := false
@@ -55,7 +55,7 @@ func (v *GCPAccessKeyRequestValidator) Initialize(config *rest.Config, stopCh <-
 func (v *GCPAccessKeyRequestValidator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
 	status := &admission.AdmissionResponse{}
 
-	if req.Operation != admission.Update ||
+	if (req.Operation != admission.Create && req.Operation != admission.Update) ||
 		len(req.SubResource) != 0 ||
 		req.Kind.Group != api.SchemeGroupVersion.Group ||
 		req.Kind.Kind != api.ResourceKindGCPAccessKeyRequest {
@@ -69,18 +69,22 @@ func (v *GCPAccessKeyRequestValidator) Admit(req *admission.AdmissionRequest) *a
 		return hookapi.StatusUninitialized()
 	}
 
+	obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	gcpAKReq := obj.(*api.GCPAccessKeyRequest).DeepCopy()
+	if err := gcpAKReq.IsValid(); err != nil {
+		return hookapi.StatusForbidden(err)
+	}
+
 	if req.Operation == admission.Update {
-		obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
-		if err != nil {
-			return hookapi.StatusBadRequest(err)
-		}
 		// validate changes made by user
 		oldObject, err := meta_util.UnmarshalFromJSON(req.OldObject.Raw, api.SchemeGroupVersion)
 		if err != nil {
 			return hookapi.StatusBadRequest(err)
 		}
 
-		gcpAKReq := obj.(*api.GCPAccessKeyRequest).DeepCopy()
 		oldGcpAKReq := oldObject.(*api.GCPAccessKeyRequest).DeepCopy()
 
 		isApprovedOrDenied := false
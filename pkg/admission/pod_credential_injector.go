@@ -0,0 +1,377 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package admission
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"kubevault.dev/operator/apis"
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	cs "kubevault.dev/operator/client/clientset/versioned"
+
+	"github.com/pkg/errors"
+	admission "k8s.io/api/admission/v1beta1"
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
+	core_util "kmodules.xyz/client-go/core/v1"
+	meta_util "kmodules.xyz/client-go/meta"
+	hookapi "kmodules.xyz/webhook-runtime/admission/v1beta1"
+)
+
+const (
+	// directory where the credential files are mounted by default
+	credentialMountDir = "/var/run/secrets/kubevault.com"
+	// prefix of the name of the volume that contains the credential files
+	credentialVolumePrefix = "kubevault-"
+)
+
+// PodCredentialInjector injects the credential of an approved access request into a pod,
+// if the pod is annotated with kubevault.com/inject-access-request
+type PodCredentialInjector struct {
+	extClient   cs.Interface
+	lock        sync.RWMutex
+	initialized bool
+}
+
+var _ hookapi.AdmissionHook = &PodCredentialInjector{}
+
+func (a *PodCredentialInjector) Resource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+			Group:    mutatorGroup,
+			Version:  mutatorVersion,
+			Resource: "podcredentialinjectors",
+		},
+		"podcredentialinjector"
+}
+
+func (a *PodCredentialInjector) Initialize(config *rest.Config, stopCh <-chan struct{}) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	var err error
+	if a.extClient, err = cs.NewForConfig(config); err != nil {
+		return err
+	}
+	a.initialized = true
+	return nil
+}
+
+func (a *PodCredentialInjector) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	status := &admission.AdmissionResponse{}
+
+	if req.Operation != admission.Create ||
+		len(req.SubResource) != 0 ||
+		req.Kind.Group != core.GroupName ||
+		req.Kind.Kind != "Pod" {
+		status.Allowed = true
+		return status
+	}
+
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if !a.initialized {
+		return hookapi.StatusUninitialized()
+	}
+
+	obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, core.SchemeGroupVersion)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	pod := obj.(*core.Pod).DeepCopy()
+
+	name := pod.Annotations[apis.InjectAccessRequestKey]
+	if name == "" {
+		status.Allowed = true
+		return status
+	}
+
+	ar, err := a.getAccessRequest(req.Namespace, pod.Annotations[apis.InjectAccessRequestKindKey], name)
+	if err != nil {
+		return hookapi.StatusForbidden(err)
+	}
+	if err := ar.canInject(req.Namespace, pod); err != nil {
+		return hookapi.StatusForbidden(err)
+	}
+	if err := ar.inject(pod); err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+
+	patch, err := meta_util.CreateJSONPatch(req.Object.Raw, pod)
+	if err != nil {
+		return hookapi.StatusInternalServerError(err)
+	}
+	status.Patch = patch
+	patchType := admission.PatchTypeJSONPatch
+	status.PatchType = &patchType
+
+	status.Allowed = true
+	return status
+}
+
+// accessRequest contains the info of an access request, that is required to inject its credential
+type accessRequest struct {
+	kind     string
+	name     string
+	subjects []rbac.Subject
+	approved bool
+	// name of the secret where the credential is stored
+	secretName string
+	// keys of the secret that are rendered from the secretTemplate
+	templateKeys []string
+}
+
+func (a *PodCredentialInjector) getAccessRequest(ns, kind, name string) (*accessRequest, error) {
+	var (
+		ar = &accessRequest{
+			kind: kind,
+			name: name,
+		}
+		conditions []api.RequestConditionType
+		secret     *core.LocalObjectReference
+		secretRef  *api.CredentialSecretRef
+		template   map[string]string
+	)
+
+	switch kind {
+	case "", api.ResourceKindDatabaseAccessRequest:
+		r, err := a.extClient.EngineV1alpha1().DatabaseAccessRequests(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ar.kind = api.ResourceKindDatabaseAccessRequest
+		ar.subjects = r.Spec.Subjects
+		for _, c := range r.Status.Conditions {
+			conditions = append(conditions, c.Type)
+		}
+		secret, secretRef, template = r.Status.Secret, r.Spec.SecretRef, r.Spec.SecretTemplate
+	case api.ResourceKindAWSAccessKeyRequest:
+		r, err := a.extClient.EngineV1alpha1().AWSAccessKeyRequests(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ar.subjects = r.Spec.Subjects
+		for _, c := range r.Status.Conditions {
+			conditions = append(conditions, c.Type)
+		}
+		secret, secretRef, template = r.Status.Secret, r.Spec.SecretRef, r.Spec.SecretTemplate
+	case api.ResourceKindGCPAccessKeyRequest:
+		r, err := a.extClient.EngineV1alpha1().GCPAccessKeyRequests(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ar.subjects = r.Spec.Subjects
+		for _, c := range r.Status.Conditions {
+			conditions = append(conditions, c.Type)
+		}
+		secret, secretRef, template = r.Status.Secret, r.Spec.SecretRef, r.Spec.SecretTemplate
+	case api.ResourceKindAzureAccessKeyRequest:
+		r, err := a.extClient.EngineV1alpha1().AzureAccessKeyRequests(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ar.subjects = r.Spec.Subjects
+		for _, c := range r.Status.Conditions {
+			conditions = append(conditions, c.Type)
+		}
+		secret, secretRef, template = r.Status.Secret, r.Spec.SecretRef, r.Spec.SecretTemplate
+	default:
+		return nil, errors.Errorf("unknown access request kind %s", kind)
+	}
+
+	ar.approved = api.IsRequestApproved(conditions)
+	if secret != nil {
+		ar.secretName = secret.Name
+	} else if secretRef != nil {
+		// the credential is written into the user-chosen secret once it is issued
+		ar.secretName = secretRef.Name
+	}
+	for k := range template {
+		ar.templateKeys = append(ar.templateKeys, k)
+	}
+	sort.Strings(ar.templateKeys)
+	return ar, nil
+}
+
+// canInject checks whether the credential can be injected into the pod.
+// The service account of the pod must be among the subjects of the access request.
+func (ar *accessRequest) canInject(ns string, pod *core.Pod) error {
+	if !ar.approved {
+		return errors.Errorf("%s %s/%s is not approved", ar.kind, ns, ar.name)
+	}
+	if ar.secretName == "" {
+		return errors.Errorf("credential of %s %s/%s is not issued yet", ar.kind, ns, ar.name)
+	}
+
+	sa := pod.Spec.ServiceAccountName
+	if sa == "" {
+		sa = "default"
+	}
+	for _, s := range ar.subjects {
+		// subject without namespace belongs to the namespace of the access request
+		if s.Kind == rbac.ServiceAccountKind && s.Name == sa && (s.Namespace == "" || s.Namespace == ns) {
+			return nil
+		}
+	}
+	return errors.Errorf("service account %s/%s is not a subject of %s %s/%s", ns, sa, ar.kind, ns, ar.name)
+}
+
+// credentialVolumeName returns the name of the volume that contains the credential files of the access request.
+// The access request name may contain dots or be too long for a volume name, which must be a DNS-1123 label,
+// so it is truncated and suffixed with its hash in that case.
+func credentialVolumeName(name string) string {
+	if vn := credentialVolumePrefix + name; len(validation.IsDNS1123Label(vn)) == 0 {
+		return vn
+	}
+
+	h := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(h[:])[:10]
+	name = strings.Replace(name, ".", "-", -1)
+	if n := validation.DNS1123LabelMaxLength - len(credentialVolumePrefix) - len(suffix) - 1; len(name) > n {
+		name = name[:n]
+	}
+	return credentialVolumePrefix + strings.TrimRight(name, "-") + "-" + suffix
+}
+
+// inject mounts the secret of the credential into the selected containers as files or env.
+// If the secret is templated, only the rendered keys are injected.
+func (ar *accessRequest) inject(pod *core.Pod) error {
+	containers, err := selectContainers(pod)
+	if err != nil {
+		return err
+	}
+
+	switch as := pod.Annotations[apis.InjectAsKey]; as {
+	case "", apis.InjectAsFiles:
+		mountPath := pod.Annotations[apis.InjectMountPathKey]
+		if mountPath == "" {
+			mountPath = filepath.Join(credentialMountDir, ar.name)
+		}
+
+		volume := core.Volume{
+			Name: credentialVolumeName(ar.name),
+			VolumeSource: core.VolumeSource{
+				Secret: &core.SecretVolumeSource{
+					SecretName: ar.secretName,
+				},
+			},
+		}
+		for _, k := range ar.templateKeys {
+			volume.Secret.Items = append(volume.Secret.Items, core.KeyToPath{
+				Key:  k,
+				Path: k,
+			})
+		}
+		pod.Spec.Volumes = core_util.UpsertVolume(pod.Spec.Volumes, volume)
+
+		for _, c := range containers {
+			c.VolumeMounts = core_util.UpsertVolumeMount(c.VolumeMounts, core.VolumeMount{
+				Name:      volume.Name,
+				MountPath: mountPath,
+				ReadOnly:  true,
+			})
+		}
+	case apis.InjectAsEnv:
+		for _, c := range containers {
+			if len(ar.templateKeys) == 0 {
+				c.EnvFrom = upsertSecretEnvFrom(c.EnvFrom, ar.secretName)
+				continue
+			}
+			for _, k := range ar.templateKeys {
+				c.Env = core_util.UpsertEnvVars(c.Env, core.EnvVar{
+					Name: envVarName(k),
+					ValueFrom: &core.EnvVarSource{
+						SecretKeyRef: &core.SecretKeySelector{
+							LocalObjectReference: core.LocalObjectReference{
+								Name: ar.secretName,
+							},
+							Key: k,
+						},
+					},
+				})
+			}
+		}
+	default:
+		return errors.Errorf("unknown value %s of annotation %s, expected %s or %s", as, apis.InjectAsKey, apis.InjectAsFiles, apis.InjectAsEnv)
+	}
+	return nil
+}
+
+// selectContainers returns the containers of the pod listed in the
+// kubevault.com/inject-containers annotation, or all the containers if it is not set
+func selectContainers(pod *core.Pod) ([]*core.Container, error) {
+	var containers []*core.Container
+	names := pod.Annotations[apis.InjectContainersKey]
+	if names == "" {
+		for i := range pod.Spec.Containers {
+			containers = append(containers, &pod.Spec.Containers[i])
+		}
+		return containers, nil
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for i := range pod.Spec.Containers {
+			if pod.Spec.Containers[i].Name == name {
+				containers = append(containers, &pod.Spec.Containers[i])
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("container %s not found in pod", name)
+		}
+	}
+	return containers, nil
+}
+
+func upsertSecretEnvFrom(envFrom []core.EnvFromSource, secretName string) []core.EnvFromSource {
+	for _, e := range envFrom {
+		if e.SecretRef != nil && e.SecretRef.Name == secretName {
+			return envFrom
+		}
+	}
+	return append(envFrom, core.EnvFromSource{
+		SecretRef: &core.SecretEnvSource{
+			LocalObjectReference: core.LocalObjectReference{
+				Name: secretName,
+			},
+		},
+	})
+}
+
+// envVarName converts the key of the secret to an environment variable name, i.e. postgres-uri to POSTGRES_URI
+func envVarName(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+}
@@ -0,0 +1,286 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"kubevault.dev/operator/apis"
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	csfake "kubevault.dev/operator/client/clientset/versioned/fake"
+
+	"github.com/stretchr/testify/assert"
+	admission "k8s.io/api/admission/v1beta1"
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func TestPodCredentialInjector_Admit(t *testing.T) {
+	dbReq := func(name string, cond api.RequestConditionType) *api.DatabaseAccessRequest {
+		return &api.DatabaseAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "demo",
+			},
+			Spec: api.DatabaseAccessRequestSpec{
+				Subjects: []rbac.Subject{
+					{
+						Kind: rbac.ServiceAccountKind,
+						Name: "app",
+					},
+				},
+			},
+			Status: api.DatabaseAccessRequestStatus{
+				Conditions: []api.DatabaseAccessRequestCondition{
+					{
+						Type: cond,
+					},
+				},
+				Secret: &core.LocalObjectReference{
+					Name: name + "-cred",
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		testName      string
+		annotations   map[string]string
+		sa            string
+		expectAllowed bool
+	}{
+		{
+			testName:      "pod is not annotated",
+			sa:            "app",
+			expectAllowed: true,
+		},
+		{
+			testName:      "service account is not a subject",
+			annotations:   map[string]string{apis.InjectAccessRequestKey: "approved"},
+			sa:            "other",
+			expectAllowed: false,
+		},
+		{
+			testName:      "default service account is not a subject",
+			annotations:   map[string]string{apis.InjectAccessRequestKey: "approved"},
+			expectAllowed: false,
+		},
+		{
+			testName:      "request is denied",
+			annotations:   map[string]string{apis.InjectAccessRequestKey: "denied"},
+			sa:            "app",
+			expectAllowed: false,
+		},
+		{
+			testName:      "request does not exist",
+			annotations:   map[string]string{apis.InjectAccessRequestKey: "unknown"},
+			sa:            "app",
+			expectAllowed: false,
+		},
+		{
+			testName: "unknown injection mode",
+			annotations: map[string]string{
+				apis.InjectAccessRequestKey: "approved",
+				apis.InjectAsKey:            "configmap",
+			},
+			sa:            "app",
+			expectAllowed: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			inj := &PodCredentialInjector{
+				extClient:   csfake.NewSimpleClientset(dbReq("approved", api.AccessApproved), dbReq("denied", api.AccessDenied)),
+				initialized: true,
+			}
+			pod := &core.Pod{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "Pod",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "app",
+					Namespace:   "demo",
+					Annotations: c.annotations,
+				},
+				Spec: core.PodSpec{
+					ServiceAccountName: c.sa,
+					Containers: []core.Container{
+						{
+							Name:  "app",
+							Image: "app",
+						},
+					},
+				},
+			}
+			raw, err := json.Marshal(pod)
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			resp := inj.Admit(&admission.AdmissionRequest{
+				Operation: admission.Create,
+				Kind: metav1.GroupVersionKind{
+					Version: "v1",
+					Kind:    "Pod",
+				},
+				Namespace: "demo",
+				Object: runtime.RawExtension{
+					Raw: raw,
+				},
+			})
+			assert.Equal(t, c.expectAllowed, resp.Allowed)
+			if c.expectAllowed {
+				assert.Nil(t, resp.Patch)
+			}
+		})
+	}
+}
+
+func TestPodCredentialInjector_getAccessRequest(t *testing.T) {
+	req := &api.DatabaseAccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db",
+			Namespace: "demo",
+		},
+		Spec: api.DatabaseAccessRequestSpec{
+			Subjects: []rbac.Subject{
+				{
+					Kind:      rbac.ServiceAccountKind,
+					Name:      "app",
+					Namespace: "demo",
+				},
+			},
+			SecretRef: &api.CredentialSecretRef{
+				Name: "db-cred",
+			},
+			SecretTemplate: map[string]string{
+				"uri": `{{ template "postgres-uri" . }}`,
+			},
+		},
+		Status: api.DatabaseAccessRequestStatus{
+			Conditions: []api.DatabaseAccessRequestCondition{
+				{
+					Type: api.AccessApproved,
+				},
+			},
+		},
+	}
+	inj := &PodCredentialInjector{
+		extClient:   csfake.NewSimpleClientset(req),
+		initialized: true,
+	}
+
+	ar, err := inj.getAccessRequest("demo", "", "db")
+	if assert.Nil(t, err) {
+		assert.Equal(t, api.ResourceKindDatabaseAccessRequest, ar.kind)
+		assert.True(t, ar.approved)
+		assert.Equal(t, "db-cred", ar.secretName)
+		assert.Equal(t, []string{"uri"}, ar.templateKeys)
+
+		pod := &core.Pod{
+			Spec: core.PodSpec{
+				ServiceAccountName: "app",
+			},
+		}
+		assert.Nil(t, ar.canInject("demo", pod))
+		assert.NotNil(t, ar.canInject("other", pod), "service account of another namespace")
+	}
+
+	_, err = inj.getAccessRequest("demo", api.ResourceKindAWSAccessKeyRequest, "db")
+	assert.NotNil(t, err, "request of another kind")
+
+	_, err = inj.getAccessRequest("demo", "VaultPolicy", "db")
+	assert.NotNil(t, err, "unknown kind")
+}
+
+func TestAccessRequestInject(t *testing.T) {
+	newPod := func(annotations map[string]string) *core.Pod {
+		return &core.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: annotations,
+			},
+			Spec: core.PodSpec{
+				Containers: []core.Container{
+					{Name: "app"},
+					{Name: "sidecar"},
+				},
+			},
+		}
+	}
+
+	ar := &accessRequest{
+		kind:       api.ResourceKindDatabaseAccessRequest,
+		name:       "db",
+		secretName: "db-cred",
+	}
+
+	pod := newPod(nil)
+	if assert.Nil(t, ar.inject(pod)) {
+		assert.Equal(t, "db-cred", pod.Spec.Volumes[0].Secret.SecretName)
+		assert.Nil(t, pod.Spec.Volumes[0].Secret.Items)
+		for _, c := range pod.Spec.Containers {
+			assert.Equal(t, "/var/run/secrets/kubevault.com/db", c.VolumeMounts[0].MountPath)
+		}
+	}
+
+	pod = newPod(map[string]string{apis.InjectAsKey: apis.InjectAsEnv, apis.InjectContainersKey: "app"})
+	if assert.Nil(t, ar.inject(pod)) {
+		assert.Equal(t, "db-cred", pod.Spec.Containers[0].EnvFrom[0].SecretRef.Name)
+		assert.Nil(t, pod.Spec.Containers[1].EnvFrom)
+	}
+
+	templated := &accessRequest{
+		kind:         api.ResourceKindDatabaseAccessRequest,
+		name:         "db",
+		secretName:   "db-cred",
+		templateKeys: []string{"postgres-uri"},
+	}
+
+	pod = newPod(map[string]string{apis.InjectMountPathKey: "/etc/db"})
+	if assert.Nil(t, templated.inject(pod)) {
+		assert.Equal(t, []core.KeyToPath{{Key: "postgres-uri", Path: "postgres-uri"}}, pod.Spec.Volumes[0].Secret.Items)
+		assert.Equal(t, "/etc/db", pod.Spec.Containers[0].VolumeMounts[0].MountPath)
+	}
+
+	pod = newPod(map[string]string{apis.InjectAsKey: apis.InjectAsEnv})
+	if assert.Nil(t, templated.inject(pod)) {
+		env := pod.Spec.Containers[1].Env[0]
+		assert.Equal(t, "POSTGRES_URI", env.Name)
+		assert.Equal(t, "postgres-uri", env.ValueFrom.SecretKeyRef.Key)
+	}
+
+	pod = newPod(map[string]string{apis.InjectContainersKey: "app,unknown"})
+	assert.NotNil(t, ar.inject(pod), "unknown container")
+}
+
+func TestCredentialVolumeName(t *testing.T) {
+	assert.Equal(t, "kubevault-db-cred", credentialVolumeName("db-cred"))
+
+	for _, name := range []string{"db.cred", strings.Repeat("a", 60), strings.Repeat("a.", 40)} {
+		vn := credentialVolumeName(name)
+		assert.Empty(t, validation.IsDNS1123Label(vn), name)
+		assert.True(t, strings.HasPrefix(vn, credentialVolumePrefix), name)
+	}
+	assert.NotEqual(t, credentialVolumeName("db.cred"), credentialVolumeName("db-cred"))
+}
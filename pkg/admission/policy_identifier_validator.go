@@ -0,0 +1,145 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package admission
+
+import (
+	"sync"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	"github.com/pkg/errors"
+	admission "k8s.io/api/admission/v1beta1"
+	authorization "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	meta_util "kmodules.xyz/client-go/meta"
+	hookapi "kmodules.xyz/webhook-runtime/admission/v1beta1"
+)
+
+// verbBind is the verb that a user must be allowed on a ClusterVaultPolicy
+// to refer it from a policy identifier
+const verbBind = "bind"
+
+// PolicyIdentifierValidator validates the policy identifiers of
+// VaultPolicyBinding, VaultIdentityEntity and VaultIdentityGroup
+type PolicyIdentifierValidator struct {
+	client      kubernetes.Interface
+	lock        sync.RWMutex
+	initialized bool
+}
+
+var _ hookapi.AdmissionHook = &PolicyIdentifierValidator{}
+
+func (v *PolicyIdentifierValidator) Resource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+			Group:    validatorGroup,
+			Version:  validatorVersion,
+			Resource: "policyidentifiervalidators",
+		},
+		"policyidentifiervalidator"
+}
+
+func (v *PolicyIdentifierValidator) Initialize(config *rest.Config, stopCh <-chan struct{}) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.initialized = true
+
+	var err error
+	if v.client, err = kubernetes.NewForConfig(config); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (v *PolicyIdentifierValidator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	status := &admission.AdmissionResponse{}
+
+	if (req.Operation != admission.Create && req.Operation != admission.Update) ||
+		len(req.SubResource) != 0 ||
+		req.Kind.Group != api.SchemeGroupVersion.Group ||
+		(req.Kind.Kind != api.ResourceKindVaultPolicyBinding &&
+			req.Kind.Kind != api.ResourceKindVaultIdentityEntity &&
+			req.Kind.Kind != api.ResourceKindVaultIdentityGroup) {
+		status.Allowed = true
+		return status
+	}
+
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	if !v.initialized {
+		return hookapi.StatusUninitialized()
+	}
+
+	obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	var policies []api.PolicyIdentifier
+	switch o := obj.(type) {
+	case *api.VaultPolicyBinding:
+		policies = o.Spec.Policies
+	case *api.VaultIdentityEntity:
+		policies = o.Spec.Policies
+	case *api.VaultIdentityGroup:
+		policies = o.Spec.Policies
+	}
+	if err := ValidateClusterPolicyRefs(v.client, req, policies); err != nil {
+		return hookapi.StatusForbidden(err)
+	}
+
+	status.Allowed = true
+	return status
+}
+
+// ValidateClusterPolicyRefs checks that the requesting user is allowed to bind
+// each of the ClusterVaultPolicies referred by the policy identifiers.
+// Otherwise, anyone who can create a VaultPolicyBinding in a namespace could
+// grant any cluster wide policy.
+func ValidateClusterPolicyRefs(kc kubernetes.Interface, req *admission.AdmissionRequest, policies []api.PolicyIdentifier) error {
+	extra := map[string]authorization.ExtraValue{}
+	for k, v := range req.UserInfo.Extra {
+		extra[k] = authorization.ExtraValue(v)
+	}
+
+	for i, p := range policies {
+		if p.ClusterRef == "" {
+			continue
+		}
+		review, err := kc.AuthorizationV1().SubjectAccessReviews().Create(&authorization.SubjectAccessReview{
+			Spec: authorization.SubjectAccessReviewSpec{
+				User:   req.UserInfo.Username,
+				Groups: req.UserInfo.Groups,
+				UID:    req.UserInfo.UID,
+				Extra:  extra,
+				ResourceAttributes: &authorization.ResourceAttributes{
+					Group:    api.SchemeGroupVersion.Group,
+					Resource: api.ResourceClusterVaultPolicies,
+					Name:     p.ClusterRef,
+					Verb:     verbBind,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to review access to ClusterVaultPolicy %s", p.ClusterRef)
+		}
+		if !review.Status.Allowed {
+			return errors.Errorf("spec.policies[%d].clusterRef: user %s is not allowed to %s ClusterVaultPolicy %s", i, req.UserInfo.Username, verbBind, p.ClusterRef)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package admission
+
+import (
+	"testing"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	admission "k8s.io/api/admission/v1beta1"
+	authentication "k8s.io/api/authentication/v1"
+	authorization "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+func TestValidateClusterPolicyRefs(t *testing.T) {
+	req := &admission.AdmissionRequest{
+		UserInfo: authentication.UserInfo{
+			Username: "alice",
+			Groups:   []string{"system:authenticated"},
+		},
+	}
+	// alice is allowed to bind the ClusterVaultPolicy "shared" only
+	kc := kfake.NewSimpleClientset()
+	kc.PrependReactor("create", "subjectaccessreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		review := action.(clientgotesting.CreateAction).GetObject().(*authorization.SubjectAccessReview)
+		attr := review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == "alice" &&
+			attr.Group == api.SchemeGroupVersion.Group &&
+			attr.Resource == api.ResourceClusterVaultPolicies &&
+			attr.Verb == verbBind &&
+			attr.Name == "shared"
+		return true, review, nil
+	})
+
+	cases := []struct {
+		testName  string
+		policies  []api.PolicyIdentifier
+		expectErr bool
+	}{
+		{
+			testName:  "namespaced policy, expect no error",
+			policies:  []api.PolicyIdentifier{{Ref: "read"}},
+			expectErr: false,
+		},
+		{
+			testName:  "allowed cluster policy, expect no error",
+			policies:  []api.PolicyIdentifier{{Ref: "read"}, {ClusterRef: "shared"}},
+			expectErr: false,
+		},
+		{
+			testName:  "not allowed cluster policy, expect error",
+			policies:  []api.PolicyIdentifier{{ClusterRef: "shared"}, {ClusterRef: "admin"}},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			err := ValidateClusterPolicyRefs(kc, req, c.policies)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package admission
+
+import (
+	"sync"
+
+	"kubevault.dev/operator/apis"
+	cs "kubevault.dev/operator/client/clientset/versioned"
+	"kubevault.dev/operator/pkg/vault/agent"
+
+	"github.com/pkg/errors"
+	admission "k8s.io/api/admission/v1beta1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	meta_util "kmodules.xyz/client-go/meta"
+	appcat_cs "kmodules.xyz/custom-resources/client/clientset/versioned"
+	hookapi "kmodules.xyz/webhook-runtime/admission/v1beta1"
+)
+
+// VaultAgentInjector injects the vault agent init container and sidecar into a pod,
+// if the pod is annotated with vault.kubevault.com/agent-inject: "true"
+type VaultAgentInjector struct {
+	extClient   cs.Interface
+	appClient   appcat_cs.Interface
+	lock        sync.RWMutex
+	initialized bool
+}
+
+var _ hookapi.AdmissionHook = &VaultAgentInjector{}
+
+func (a *VaultAgentInjector) Resource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+			Group:    mutatorGroup,
+			Version:  mutatorVersion,
+			Resource: "vaultagentinjectors",
+		},
+		"vaultagentinjector"
+}
+
+func (a *VaultAgentInjector) Initialize(config *rest.Config, stopCh <-chan struct{}) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	var err error
+	if a.extClient, err = cs.NewForConfig(config); err != nil {
+		return err
+	}
+	if a.appClient, err = appcat_cs.NewForConfig(config); err != nil {
+		return err
+	}
+	a.initialized = true
+	return nil
+}
+
+func (a *VaultAgentInjector) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	status := &admission.AdmissionResponse{}
+
+	if req.Operation != admission.Create ||
+		len(req.SubResource) != 0 ||
+		req.Kind.Group != core.GroupName ||
+		req.Kind.Kind != "Pod" {
+		status.Allowed = true
+		return status
+	}
+
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	if !a.initialized {
+		return hookapi.StatusUninitialized()
+	}
+
+	obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, core.SchemeGroupVersion)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	pod := obj.(*core.Pod).DeepCopy()
+
+	// inject only once, if the annotation is set to true
+	if inject, _ := meta_util.GetBoolValue(pod.Annotations, apis.AgentInjectKey); !inject ||
+		pod.Annotations[apis.AgentInjectStatusKey] == apis.AgentInjectStatusInjected {
+		status.Allowed = true
+		return status
+	}
+
+	opts, err := agent.OptionsFromAnnotations(req.Namespace, pod.Annotations)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	srv, err := a.getServer(opts)
+	if err != nil {
+		return hookapi.StatusInternalServerError(err)
+	}
+	if err := agent.Inject(pod, opts, srv); err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+
+	patch, err := meta_util.CreateJSONPatch(req.Object.Raw, pod)
+	if err != nil {
+		return hookapi.StatusInternalServerError(err)
+	}
+	status.Patch = patch
+	patchType := admission.PatchTypeJSONPatch
+	status.PatchType = &patchType
+
+	status.Allowed = true
+	return status
+}
+
+// getServer returns the info of the VaultServer from its AppBinding, and
+// the image of the vault agent resolved from its VaultServerVersion
+func (a *VaultAgentInjector) getServer(opts *agent.Options) (*agent.Server, error) {
+	vs, err := a.extClient.KubevaultV1alpha1().VaultServers(opts.VaultServerNamespace).Get(opts.VaultServerName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get VaultServer %s/%s", opts.VaultServerNamespace, opts.VaultServerName)
+	}
+
+	app, err := a.appClient.AppcatalogV1alpha1().AppBindings(vs.Namespace).Get(vs.AppBindingName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get AppBinding %s/%s", vs.Namespace, vs.AppBindingName())
+	}
+	srv, err := agent.ServerFromAppBinding(app)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := a.extClient.CatalogV1alpha1().VaultServerVersions().Get(string(vs.Spec.Version), metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get VaultServerVersion %s", vs.Spec.Version)
+	}
+	srv.Image, err = agent.ResolveImage(version.Spec.Agent.Image, version.Spec.Vault.Image)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid VaultServerVersion %s", vs.Spec.Version)
+	}
+	return srv, nil
+}
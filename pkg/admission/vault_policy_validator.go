@@ -0,0 +1,379 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package admission
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
+	"github.com/pkg/errors"
+	admission "k8s.io/api/admission/v1beta1"
+	authentication "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/rest"
+	meta_util "kmodules.xyz/client-go/meta"
+	hookapi "kmodules.xyz/webhook-runtime/admission/v1beta1"
+)
+
+const (
+	capabilityDeny = "deny"
+	capabilitySudo = "sudo"
+
+	// the policy names of the VaultPolicies, VaultPolicyTemplates and ClusterVaultPolicies
+	// are generated as k8s.${cluster}.*
+	generatedPolicyNamePrefix = "k8s."
+)
+
+// DefaultPolicyDenylist contains the overly broad grants rejected by default
+var DefaultPolicyDenylist = []string{
+	"*:" + capabilitySudo,
+	"sys/*",
+}
+
+// knownCapabilities are the capabilities accepted by the vault versions of the catalog
+// ref: https://www.vaultproject.io/docs/concepts/policies#capabilities
+var knownCapabilities = map[string]bool{
+	capabilityDeny: true,
+	"create":       true,
+	"read":         true,
+	"update":       true,
+	"delete":       true,
+	"list":         true,
+	capabilitySudo: true,
+}
+
+// policyCapabilities maps the deprecated policy field to the equivalent capabilities
+var policyCapabilities = map[string][]string{
+	"deny":  {capabilityDeny},
+	"read":  {"read", "list"},
+	"write": {"create", "read", "update", "delete", "list"},
+	"sudo":  {"create", "read", "update", "delete", "list", capabilitySudo},
+}
+
+type VaultPolicyValidator struct {
+	// PolicyDenylist contains the grants that a VaultPolicy must not contain.
+	// Each entry is formatted as <path>[:<capability>]. If the capability is omitted,
+	// the path must not be granted any capability other than deny.
+	// The policy paths matching any path of the entry are rejected.
+	PolicyDenylist []string
+
+	// the policies of the operator, i.e. for the auth method controller,
+	// are created by the service accounts of this namespace
+	operatorNamespace string
+	lock              sync.RWMutex
+	initialized       bool
+}
+
+var _ hookapi.AdmissionHook = &VaultPolicyValidator{}
+
+func (v *VaultPolicyValidator) Resource() (plural schema.GroupVersionResource, singular string) {
+	return schema.GroupVersionResource{
+			Group:    validatorGroup,
+			Version:  validatorVersion,
+			Resource: "vaultpolicyvalidators",
+		},
+		"vaultpolicyvalidator"
+}
+
+func (v *VaultPolicyValidator) Initialize(config *rest.Config, stopCh <-chan struct{}) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	v.operatorNamespace = meta_util.Namespace()
+	v.initialized = true
+	return nil
+}
+
+func (v *VaultPolicyValidator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
+	status := &admission.AdmissionResponse{}
+
+	if (req.Operation != admission.Create && req.Operation != admission.Update) ||
+		len(req.SubResource) != 0 ||
+		req.Kind.Group != api.SchemeGroupVersion.Group ||
+		(req.Kind.Kind != api.ResourceKindVaultPolicy && req.Kind.Kind != api.ResourceKindClusterVaultPolicy) {
+		status.Allowed = true
+		return status
+	}
+
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+	if !v.initialized {
+		return hookapi.StatusUninitialized()
+	}
+
+	obj, err := meta_util.UnmarshalFromJSON(req.Object.Raw, api.SchemeGroupVersion)
+	if err != nil {
+		return hookapi.StatusBadRequest(err)
+	}
+	denylist := v.PolicyDenylist
+	if isServiceAccountOf(req.UserInfo, v.operatorNamespace) {
+		// the operator needs the grants of the denylist to manage vault
+		denylist = nil
+	}
+	switch p := obj.(type) {
+	case *api.VaultPolicy:
+		err = ValidateVaultPolicy(p, denylist)
+	case *api.ClusterVaultPolicy:
+		err = ValidateClusterVaultPolicy(p, denylist)
+	}
+	if err != nil {
+		return hookapi.StatusForbidden(err)
+	}
+
+	status.Allowed = true
+	return status
+}
+
+// ValidateVaultPolicy checks that exactly one of policyDocument and policy is specified,
+// the policy is parsable by vault and it doesn't contain any grant of the denylist.
+func ValidateVaultPolicy(vp *api.VaultPolicy, denylist []string) error {
+	return validatePolicy(vp.Spec.PolicyDocument, vp.Spec.Policy, denylist, false)
+}
+
+// ValidateClusterVaultPolicy validates the ClusterVaultPolicy like ValidateVaultPolicy.
+// If aggregationSelector is specified, policyDocument and policy can be omitted.
+// The vaultPolicyName can't use the prefix of the generated policy names, otherwise
+// it could overwrite the policy of a VaultPolicy or VaultPolicyTemplate.
+func ValidateClusterVaultPolicy(cvp *api.ClusterVaultPolicy, denylist []string) error {
+	if strings.HasPrefix(cvp.Spec.VaultPolicyName, generatedPolicyNamePrefix) {
+		return errors.Errorf("spec.vaultPolicyName %s uses the prefix %s, that is reserved for the generated policy names", cvp.Spec.VaultPolicyName, generatedPolicyNamePrefix)
+	}
+	aggregated := cvp.Spec.AggregationSelector != nil
+	if aggregated {
+		if _, err := metav1.LabelSelectorAsSelector(cvp.Spec.AggregationSelector); err != nil {
+			return errors.Wrap(err, "invalid spec.aggregationSelector")
+		}
+	}
+	return validatePolicy(cvp.Spec.PolicyDocument, cvp.Spec.Policy, denylist, aggregated)
+}
+
+func validatePolicy(doc string, policy *runtime.RawExtension, denylist []string, allowEmpty bool) error {
+	hasDoc := doc != ""
+	hasPolicy := policy != nil && len(policy.Raw) > 0
+	if hasDoc && hasPolicy {
+		return errors.New("exactly one of spec.policyDocument and spec.policy must be specified")
+	}
+	if !hasDoc && !hasPolicy {
+		if allowEmpty {
+			return nil
+		}
+		return errors.New("exactly one of spec.policyDocument and spec.policy must be specified")
+	}
+
+	field := "spec.policyDocument"
+	if hasPolicy {
+		field = "spec.policy"
+		data, err := json.Marshal(policy)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal spec.policy")
+		}
+		doc = string(data)
+	}
+
+	rules, err := parsePolicy(doc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid %s", field)
+	}
+
+	for _, e := range denylist {
+		path, capability := parseDenylistEntry(e)
+		for _, r := range rules {
+			if !policyPathsOverlap(r.path, path) || r.capabilities[capabilityDeny] {
+				continue
+			}
+			if capability != "" && r.capabilities[capability] {
+				return errors.Errorf(`%s grants %s capability on path "%s", which is not allowed`, field, capability, path)
+			}
+			if capability == "" && len(r.capabilities) > 0 {
+				return errors.Errorf(`%s grants capabilities on path "%s", which is not allowed`, field, path)
+			}
+		}
+	}
+	return nil
+}
+
+// isServiceAccountOf returns whether the user is a service account of the namespace
+func isServiceAccountOf(user authentication.UserInfo, namespace string) bool {
+	if namespace == "" {
+		return false
+	}
+	group := serviceaccount.MakeNamespaceGroupName(namespace)
+	for _, g := range user.Groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// policyPathsOverlap returns whether any request path is matched by both of the policy paths.
+// A path may end with the glob "*" matching any suffix, and a segment may be "+" matching
+// any single segment.
+// ref: https://www.vaultproject.io/docs/concepts/policies#policy-syntax
+func policyPathsOverlap(a, b string) bool {
+	return segmentsOverlap(strings.Split(a, "/"), strings.Split(b, "/"))
+}
+
+func segmentsOverlap(as, bs []string) bool {
+	if len(as) > 0 && strings.HasSuffix(as[0], "*") {
+		return globOverlaps(strings.TrimSuffix(as[0], "*"), bs)
+	}
+	if len(bs) > 0 && strings.HasSuffix(bs[0], "*") {
+		return globOverlaps(strings.TrimSuffix(bs[0], "*"), as)
+	}
+	if len(as) == 0 || len(bs) == 0 {
+		return len(as) == len(bs)
+	}
+	if as[0] != "+" && bs[0] != "+" && as[0] != bs[0] {
+		return false
+	}
+	return segmentsOverlap(as[1:], bs[1:])
+}
+
+// globOverlaps returns whether the segments match any path starting with the prefix,
+// the prefix doesn't contain "/" as it is the rest of a single segment
+func globOverlaps(prefix string, segments []string) bool {
+	if len(segments) == 0 {
+		return false
+	}
+	s := segments[0]
+	switch {
+	case s == "+":
+		return true
+	case strings.HasSuffix(s, "*"):
+		s = strings.TrimSuffix(s, "*")
+		return strings.HasPrefix(s, prefix) || strings.HasPrefix(prefix, s)
+	default:
+		return strings.HasPrefix(s, prefix)
+	}
+}
+
+// parseDenylistEntry splits the denylist entry <path>[:<capability>]
+func parseDenylistEntry(e string) (string, string) {
+	if i := strings.LastIndex(e, ":"); i >= 0 && knownCapabilities[e[i+1:]] {
+		return e[:i], e[i+1:]
+	}
+	return e, ""
+}
+
+type policyRule struct {
+	path         string
+	capabilities map[string]bool
+}
+
+// parsePolicy parses the policy in HCL or JSON format following the rules of vault
+// ref: https://github.com/hashicorp/vault/blob/master/vault/policy.go
+func parsePolicy(doc string) ([]policyRule, error) {
+	root, err := hcl.Parse(doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse policy")
+	}
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		return nil, errors.New("failed to parse policy: does not contain a root object")
+	}
+	if err := checkHCLKeys(list, "name", "path"); err != nil {
+		return nil, err
+	}
+
+	var rules []policyRule
+	for _, item := range list.Filter("path").Items {
+		if len(item.Keys) == 0 {
+			return nil, errors.New("path is missing")
+		}
+		path, ok := item.Keys[0].Token.Value().(string)
+		if !ok {
+			return nil, errors.Errorf("path %s is invalid", item.Keys[0].Token.Text)
+		}
+
+		obj, ok := item.Val.(*ast.ObjectType)
+		if !ok {
+			return nil, errors.Errorf(`path "%s": should be an object`, path)
+		}
+		err := checkHCLKeys(obj.List,
+			"comment",
+			"policy",
+			"capabilities",
+			"allowed_parameters",
+			"denied_parameters",
+			"required_parameters",
+			"min_wrapping_ttl",
+			"max_wrapping_ttl",
+			"mfa_methods",
+			"control_group",
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, `path "%s"`, path)
+		}
+
+		var p struct {
+			Policy       string   `hcl:"policy"`
+			Capabilities []string `hcl:"capabilities"`
+		}
+		if err := hcl.DecodeObject(&p, item.Val); err != nil {
+			return nil, errors.Wrapf(err, `path "%s"`, path)
+		}
+
+		r := policyRule{
+			path:         path,
+			capabilities: map[string]bool{},
+		}
+		if p.Policy != "" {
+			caps, ok := policyCapabilities[p.Policy]
+			if !ok {
+				return nil, errors.Errorf(`path "%s": invalid policy "%s"`, path, p.Policy)
+			}
+			for _, c := range caps {
+				r.capabilities[c] = true
+			}
+		}
+		for _, c := range p.Capabilities {
+			if !knownCapabilities[c] {
+				return nil, errors.Errorf(`path "%s": invalid capability "%s"`, path, c)
+			}
+			r.capabilities[c] = true
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+// checkHCLKeys checks that the object list contains only the valid keys
+func checkHCLKeys(list *ast.ObjectList, valid ...string) error {
+	validKeys := make(map[string]bool, len(valid))
+	for _, k := range valid {
+		validKeys[k] = true
+	}
+
+	for _, item := range list.Items {
+		if len(item.Keys) == 0 {
+			continue
+		}
+		key, _ := item.Keys[0].Token.Value().(string)
+		if !validKeys[key] {
+			return errors.Errorf(`invalid key "%s" on line %d`, item.Keys[0].Token.Text, item.Keys[0].Pos().Line)
+		}
+	}
+	return nil
+}
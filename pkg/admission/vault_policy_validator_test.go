@@ -0,0 +1,336 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package admission
+
+import (
+	"testing"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestValidateVaultPolicy(t *testing.T) {
+	cases := []struct {
+		testName  string
+		spec      api.VaultPolicySpec
+		denylist  []string
+		expectErr bool
+	}{
+		{
+			testName: "valid hcl policy, expect no error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `
+path "secret/*" {
+  capabilities = ["create", "read", "update", "delete", "list"]
+}
+path "secret/super-secret" {
+  capabilities = ["deny"]
+}`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: false,
+		},
+		{
+			testName: "valid json policy, expect no error",
+			spec: api.VaultPolicySpec{
+				Policy: &runtime.RawExtension{
+					Raw: []byte(`{"path":{"secret/*":{"capabilities":["read","list"]}}}`),
+				},
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: false,
+		},
+		{
+			testName:  "neither policyDocument nor policy is specified, expect error",
+			spec:      api.VaultPolicySpec{},
+			expectErr: true,
+		},
+		{
+			testName: "both policyDocument and policy are specified, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/*" { capabilities = ["read"] }`,
+				Policy: &runtime.RawExtension{
+					Raw: []byte(`{"path":{"secret/*":{"capabilities":["read"]}}}`),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "malformed hcl, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/*" { capabilities = ["read"]`,
+			},
+			expectErr: true,
+		},
+		{
+			testName: "unknown capability, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/*" { capabilities = ["read", "root"] }`,
+			},
+			expectErr: true,
+		},
+		{
+			testName: "unknown key, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/*" { capability = ["read"] }`,
+			},
+			expectErr: true,
+		},
+		{
+			testName: "invalid policy value, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/*" { policy = "admin" }`,
+			},
+			expectErr: true,
+		},
+		{
+			testName: "sudo on path *, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "*" { capabilities = ["read", "sudo"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: true,
+		},
+		{
+			testName: "sudo granted by policy on path *, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "*" { policy = "sudo" }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: true,
+		},
+		{
+			testName: "read on path *, that matches sys/*, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "*" { capabilities = ["read"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: true,
+		},
+		{
+			testName: "read on path secret/+/config, expect no error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/+/config" { capabilities = ["read"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: false,
+		},
+		{
+			testName: "read on path sys-backup/*, expect no error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "sys-backup/*" { capabilities = ["read"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: false,
+		},
+		{
+			testName: "grant on path sys/+/seal, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "sys/+/seal" { capabilities = ["update"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: true,
+		},
+		{
+			testName: "grant on path sy*, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "sy*" { capabilities = ["read"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: true,
+		},
+		{
+			testName: "grant on path sys/seal, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "sys/seal" { capabilities = ["update"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: true,
+		},
+		{
+			testName: "sudo on path +/seal, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "+/seal" { capabilities = ["sudo"] }`,
+			},
+			denylist:  []string{"*:sudo"},
+			expectErr: true,
+		},
+		{
+			testName: "sudo on path secret/*, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/*" { capabilities = ["sudo"] }`,
+			},
+			denylist:  []string{"*:sudo"},
+			expectErr: true,
+		},
+		{
+			testName: "capability not supported by the catalog versions, expect error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "secret/*" { capabilities = ["patch"] }`,
+			},
+			expectErr: true,
+		},
+		{
+			testName: "grant on path sys/*, expect error",
+			spec: api.VaultPolicySpec{
+				Policy: &runtime.RawExtension{
+					Raw: []byte(`{"path":{"sys/*":{"capabilities":["read"]}}}`),
+				},
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: true,
+		},
+		{
+			testName: "deny on path sys/*, expect no error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "sys/*" { capabilities = ["deny"] }`,
+			},
+			denylist:  DefaultPolicyDenylist,
+			expectErr: false,
+		},
+		{
+			testName: "grant on path sys/* with empty denylist, expect no error",
+			spec: api.VaultPolicySpec{
+				PolicyDocument: `path "sys/*" { capabilities = ["read", "sudo"] }`,
+			},
+			denylist:  nil,
+			expectErr: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			err := ValidateVaultPolicy(&api.VaultPolicy{Spec: c.spec}, c.denylist)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateClusterVaultPolicy(t *testing.T) {
+	cases := []struct {
+		testName  string
+		spec      api.ClusterVaultPolicySpec
+		expectErr bool
+	}{
+		{
+			testName: "valid hcl policy, expect no error",
+			spec: api.ClusterVaultPolicySpec{
+				PolicyDocument: `path "secret/*" { capabilities = ["read"] }`,
+			},
+			expectErr: false,
+		},
+		{
+			testName:  "neither policyDocument nor policy is specified, expect error",
+			spec:      api.ClusterVaultPolicySpec{},
+			expectErr: true,
+		},
+		{
+			testName: "aggregationSelector without policy, expect no error",
+			spec: api.ClusterVaultPolicySpec{
+				AggregationSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"policy.kubevault.com/aggregate-to-baseline": "true"},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			testName: "invalid aggregationSelector, expect error",
+			spec: api.ClusterVaultPolicySpec{
+				AggregationSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "team", Operator: "Equal"},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "aggregationSelector with denied policy, expect error",
+			spec: api.ClusterVaultPolicySpec{
+				PolicyDocument:      `path "sys/*" { capabilities = ["read"] }`,
+				AggregationSelector: &metav1.LabelSelector{},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "custom vaultPolicyName, expect no error",
+			spec: api.ClusterVaultPolicySpec{
+				VaultPolicyName: "baseline",
+				PolicyDocument:  `path "secret/*" { capabilities = ["read"] }`,
+			},
+			expectErr: false,
+		},
+		{
+			testName: "vaultPolicyName of a VaultPolicy, expect error",
+			spec: api.ClusterVaultPolicySpec{
+				VaultPolicyName: "k8s.-.demo.read-only",
+				PolicyDocument:  `path "secret/*" { capabilities = ["read"] }`,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			err := ValidateClusterVaultPolicy(&api.ClusterVaultPolicy{Spec: c.spec}, DefaultPolicyDenylist)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestPolicyPathsOverlap(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"sys/*", "sys/*", true},
+		{"sys/*", "sys/seal", true},
+		{"sys/*", "sys/+/seal", true},
+		{"sys/*", "sy*", true},
+		{"sys/*", "s*", true},
+		{"sys/*", "*", true},
+		{"sys/*", "+/seal", true},
+		{"sys/*", "+", false},
+		{"sys/*", "sys", false},
+		{"sys/*", "sysfoo/*", false},
+		{"sys/*", "secret/*", false},
+		{"sys/*", "secret/+/config", false},
+		{"*", "secret/data/app", true},
+		{"secret/+/config", "secret/app/config", true},
+		{"secret/+/config", "secret/app/creds", false},
+		{"secret/+/config", "secret/app/*", true},
+		{"secret/app", "secret/app", true},
+		{"secret/app", "secret/app2", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.a+" "+c.b, func(t *testing.T) {
+			assert.Equal(t, c.expected, policyPathsOverlap(c.a, c.b))
+			assert.Equal(t, c.expected, policyPathsOverlap(c.b, c.a))
+		})
+	}
+}
@@ -17,18 +17,25 @@ package admission
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 	"sync"
 
+	catalog "kubevault.dev/operator/apis/catalog/v1alpha1"
 	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
 	cs "kubevault.dev/operator/client/clientset/versioned"
+	"kubevault.dev/operator/pkg/vault/util"
 
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
 	"github.com/pkg/errors"
 	admission "k8s.io/api/admission/v1beta1"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	meta_util "kmodules.xyz/client-go/meta"
@@ -38,6 +45,8 @@ import (
 const (
 	validatorGroup   = "validators.kubevault.com"
 	validatorVersion = "v1alpha1"
+
+	vaultClientPort = 8200
 )
 
 type VaultServerValidator struct {
@@ -125,7 +134,8 @@ func ValidateVaultServer(client kubernetes.Interface, extClient cs.Interface, vs
 	if vs.Spec.Version == "" {
 		return errors.New(`'spec.version' is missing`)
 	}
-	if _, err := extClient.CatalogV1alpha1().VaultServerVersions().Get(string(vs.Spec.Version), metav1.GetOptions{}); err != nil {
+	version, err := extClient.CatalogV1alpha1().VaultServerVersions().Get(string(vs.Spec.Version), metav1.GetOptions{})
+	if err != nil {
 		return err
 	}
 
@@ -133,6 +143,22 @@ func ValidateVaultServer(client kubernetes.Interface, extClient cs.Interface, vs
 		return errors.Errorf(`spec.nodes "%v" invalid. Value must be greater than zero`, vs.Spec.Nodes)
 	}
 
+	if err := validateTLS(vs); err != nil {
+		return err
+	}
+
+	if err := validateListener(client, vs); err != nil {
+		return err
+	}
+
+	if err := validateServerConfig(vs); err != nil {
+		return err
+	}
+
+	if err := validateConfigSource(client, vs); err != nil {
+		return err
+	}
+
 	numOfBackend := 0
 	if vs.Spec.Backend.Inmem != nil {
 		numOfBackend++
@@ -387,6 +413,10 @@ func ValidateVaultServer(client kubernetes.Interface, extClient cs.Interface, vs
 			}
 		}
 
+		if err := validatePGPKeys(client, vs, version); err != nil {
+			return err
+		}
+
 	}
 	return nil
 }
@@ -435,6 +465,253 @@ func preconditionFailedError() error {
 	namespace`, strList}, "\n\t"))
 }
 
+// validateTLS validates the issuer reference and renewal window of the vault server certificates
+func validateTLS(vs *api.VaultServer) error {
+	tls := vs.Spec.TLS
+	if tls == nil {
+		return nil
+	}
+	if tls.IssuerRef != nil {
+		if tls.IssuerRef.Name == "" {
+			return errors.New("spec.tls.issuerRef.name is missing")
+		}
+		if tls.IssuerRef.Kind != "Issuer" && tls.IssuerRef.Kind != "ClusterIssuer" {
+			return errors.Errorf(`spec.tls.issuerRef.kind "%s" invalid. Value must be Issuer or ClusterIssuer`, tls.IssuerRef.Kind)
+		}
+	}
+	if tls.RenewBefore != nil && tls.RenewBefore.Duration <= 0 {
+		return errors.Errorf(`spec.tls.renewBefore "%v" invalid. Value must be greater than zero`, tls.RenewBefore.Duration)
+	}
+	return nil
+}
+
+// validateListener validates the typed tcp listener configuration
+func validateListener(client kubernetes.Interface, vs *api.VaultServer) error {
+	l := vs.Spec.Listener
+	if l == nil {
+		return nil
+	}
+	switch l.TLSMinVersion {
+	case "", "tls10", "tls11", "tls12", "tls13":
+	default:
+		return errors.Errorf(`spec.listener.tlsMinVersion "%s" invalid. Value must be one of tls10, tls11, tls12 or tls13`, l.TLSMinVersion)
+	}
+	if l.TLSClientCASecret != "" {
+		err := validateSecret(client, l.TLSClientCASecret, vs.Namespace, []string{
+			util.TLSClientCAKey,
+		})
+		if err != nil {
+			return errors.Wrap(err, "for spec.listener.tlsClientCASecret")
+		}
+	}
+	if p := l.ProxyProtocol; p != nil {
+		switch p.Behavior {
+		case api.ProxyProtocolBehaviorUseAlways:
+		case api.ProxyProtocolBehaviorAllowAuthorized, api.ProxyProtocolBehaviorDenyUnauthorized:
+			if len(p.AuthorizedAddrs) == 0 {
+				return errors.Errorf("spec.listener.proxyProtocol.authorizedAddrs is required for behavior %s", p.Behavior)
+			}
+		default:
+			return errors.Errorf(`spec.listener.proxyProtocol.behavior "%s" invalid. Value must be one of use_always, allow_authorized or deny_unauthorized`, p.Behavior)
+		}
+	}
+	if x := l.XForwardedFor; x != nil {
+		if len(x.AuthorizedAddrs) == 0 {
+			return errors.New("spec.listener.xForwardedFor.authorizedAddrs is missing")
+		}
+		if x.HopSkips < 0 {
+			return errors.Errorf(`spec.listener.xForwardedFor.hopSkips "%d" invalid. Value must not be negative`, x.HopSkips)
+		}
+	}
+	return nil
+}
+
+// validateServerConfig validates the typed server configuration
+func validateServerConfig(vs *api.VaultServer) error {
+	s := vs.Spec.ServerConfig
+	if s == nil {
+		return nil
+	}
+	switch s.LogLevel {
+	case "", "trace", "debug", "info", "warn", "err":
+	default:
+		return errors.Errorf(`spec.serverConfig.logLevel "%s" invalid. Value must be one of trace, debug, info, warn or err`, s.LogLevel)
+	}
+	if s.DefaultLeaseTTL != nil && s.DefaultLeaseTTL.Duration <= 0 {
+		return errors.Errorf(`spec.serverConfig.defaultLeaseTTL "%v" invalid. Value must be greater than zero`, s.DefaultLeaseTTL.Duration)
+	}
+	if s.MaxLeaseTTL != nil && s.MaxLeaseTTL.Duration <= 0 {
+		return errors.Errorf(`spec.serverConfig.maxLeaseTTL "%v" invalid. Value must be greater than zero`, s.MaxLeaseTTL.Duration)
+	}
+	if s.DefaultLeaseTTL != nil && s.MaxLeaseTTL != nil && s.DefaultLeaseTTL.Duration > s.MaxLeaseTTL.Duration {
+		return errors.New("spec.serverConfig.defaultLeaseTTL must not be greater than spec.serverConfig.maxLeaseTTL")
+	}
+	if s.CacheSize < 0 {
+		return errors.Errorf(`spec.serverConfig.cacheSize "%d" invalid. Value must not be negative`, s.CacheSize)
+	}
+	return nil
+}
+
+// validateConfigSource checks that the user provided configuration
+// doesn't conflict with the typed listener and server configuration
+func validateConfigSource(client kubernetes.Interface, vs *api.VaultServer) error {
+	if vs.Spec.ConfigSource == nil || (vs.Spec.Listener == nil && vs.Spec.ServerConfig == nil) {
+		return nil
+	}
+	data, err := getUserConfig(client, vs.Namespace, vs.Spec.ConfigSource)
+	if err != nil {
+		return errors.Wrap(err, "for spec.configSource")
+	}
+	if data == "" {
+		return nil
+	}
+	f, err := hcl.Parse(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse spec.configSource")
+	}
+	list, ok := f.Node.(*ast.ObjectList)
+	if !ok {
+		return errors.New("failed to parse spec.configSource: does not contain a root object")
+	}
+
+	if vs.Spec.Listener != nil {
+		for _, item := range list.Filter("listener", "tcp").Items {
+			obj, ok := item.Val.(*ast.ObjectType)
+			if !ok {
+				continue
+			}
+			// vault listens on 127.0.0.1:8200, if address is not specified
+			addr := "127.0.0.1:8200"
+			if items := obj.List.Filter("address").Items; len(items) > 0 {
+				if lit, ok := items[0].Val.(*ast.LiteralType); ok {
+					addr = fmt.Sprint(lit.Token.Value())
+				}
+			}
+			if strings.HasSuffix(addr, fmt.Sprintf(":%d", vaultClientPort)) {
+				return errors.Errorf("spec.configSource defines a tcp listener on %s which conflicts with spec.listener", addr)
+			}
+		}
+	}
+
+	if s := vs.Spec.ServerConfig; s != nil {
+		params := map[string]bool{
+			"ui":                s.UI,
+			"log_level":         s.LogLevel != "",
+			"default_lease_ttl": s.DefaultLeaseTTL != nil,
+			"max_lease_ttl":     s.MaxLeaseTTL != nil,
+			"disable_mlock":     s.DisableMlock,
+			"cache_size":        s.CacheSize > 0,
+		}
+		for _, item := range list.Items {
+			if len(item.Keys) == 0 {
+				continue
+			}
+			key := item.Keys[0].Token.Value()
+			if k, ok := key.(string); ok && params[k] {
+				return errors.Errorf("spec.configSource sets %s which conflicts with spec.serverConfig", k)
+			}
+		}
+	}
+	return nil
+}
+
+// getUserConfig returns the vault.hcl file of the user provided configuration.
+// It returns empty string for volume sources other than ConfigMap and Secret.
+func getUserConfig(kc kubernetes.Interface, ns string, src *core.VolumeSource) (string, error) {
+	file := filepath.Base(util.VaultConfigFile)
+	keyOf := func(items []core.KeyToPath) string {
+		if len(items) == 0 {
+			return file
+		}
+		for _, it := range items {
+			if it.Path == file {
+				return it.Key
+			}
+		}
+		return ""
+	}
+
+	switch {
+	case src.ConfigMap != nil:
+		cm, err := kc.CoreV1().ConfigMaps(ns).Get(src.ConfigMap.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return cm.Data[keyOf(src.ConfigMap.Items)], nil
+	case src.Secret != nil:
+		sr, err := kc.CoreV1().Secrets(ns).Get(src.Secret.SecretName, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		return string(sr.Data[keyOf(src.Secret.Items)]), nil
+	}
+	return "", nil
+}
+
+// validatePGPKeys validates the pgp keys used to encrypt the unseal keys and root token
+func validatePGPKeys(client kubernetes.Interface, vs *api.VaultServer, version *catalog.VaultServerVersion) error {
+	unslr := vs.Spec.Unsealer
+	if len(unslr.PGPKeys) == 0 {
+		if unslr.RootTokenPGPKey != nil {
+			return errors.New("spec.unsealer.rootTokenPGPKey requires spec.unsealer.pgpKeys")
+		}
+		if unslr.UnsealKeysSecret != "" {
+			return errors.New("spec.unsealer.unsealKeysSecret requires spec.unsealer.pgpKeys")
+		}
+		return nil
+	}
+
+	if !version.Spec.Unsealer.PGPKeys {
+		return errors.Errorf("unsealer image %s of version %s does not support spec.unsealer.pgpKeys", version.Spec.Unsealer.Image, version.Name)
+	}
+	// encrypted unseal keys are published only from the kubernetes secret key store
+	if unslr.Mode.KubernetesSecret == nil {
+		return errors.New("spec.unsealer.pgpKeys is only supported with spec.unsealer.mode.kubernetesSecret")
+	}
+	if len(unslr.PGPKeys) != unslr.SecretShares {
+		return errors.Errorf("spec.unsealer.pgpKeys must contain %d keys, one for each secret share", unslr.SecretShares)
+	}
+	custodians := map[string]bool{}
+	for i, k := range unslr.PGPKeys {
+		if k.Custodian == "" {
+			return errors.Errorf("spec.unsealer.pgpKeys[%d].custodian is missing", i)
+		}
+		if errs := validation.IsDNS1123Label(k.Custodian); len(errs) > 0 {
+			return errors.Errorf("spec.unsealer.pgpKeys[%d].custodian is invalid: %s", i, strings.Join(errs, ", "))
+		}
+		if custodians[k.Custodian] {
+			return errors.Errorf("spec.unsealer.pgpKeys[%d].custodian %s is duplicated", i, k.Custodian)
+		}
+		custodians[k.Custodian] = true
+
+		if err := validateConfigMapKey(client, k.ConfigMapKeyRef, vs.Namespace); err != nil {
+			return errors.Wrapf(err, "for spec.unsealer.pgpKeys[%d].configMapKeyRef", i)
+		}
+	}
+
+	if unslr.RootTokenPGPKey != nil {
+		if err := validateConfigMapKey(client, unslr.RootTokenPGPKey.ConfigMapKeyRef, vs.Namespace); err != nil {
+			return errors.Wrap(err, "for spec.unsealer.rootTokenPGPKey.configMapKeyRef")
+		}
+	}
+	return nil
+}
+
+// validateConfigMapKey will check:
+//	- whether configMap exists
+//	- whether value for the selected key exists
+func validateConfigMapKey(kc kubernetes.Interface, sel core.ConfigMapKeySelector, ns string) error {
+	cm, err := kc.CoreV1().ConfigMaps(ns).Get(sel.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	val, ok := cm.Data[sel.Key]
+	if !ok || len(val) == 0 {
+		return errors.Errorf("configMap data doesn't contain any value for key '%s'", sel.Key)
+	}
+	return nil
+}
+
 // validateSecret will check:
 //	- whether secret exists
 //	- whether value for requiredKeys exists
@@ -17,6 +17,7 @@ package admission
 
 import (
 	"testing"
+	"time"
 
 	catalog "kubevault.dev/operator/apis/catalog/v1alpha1"
 	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
@@ -43,6 +44,17 @@ var (
 			Namespace: namespace,
 		},
 	}
+	vsVersionWithPGPKeys = catalog.VaultServerVersion{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "1.11.1-pgp",
+			Namespace: namespace,
+		},
+		Spec: catalog.VaultServerVersionSpec{
+			Unsealer: catalog.VaultServerVersionUnsealer{
+				PGPKeys: true,
+			},
+		},
+	}
 	vs = api.VaultServer{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       api.ResourceKindVaultServer,
@@ -393,6 +405,127 @@ func TestValidateVaultServer(t *testing.T) {
 			extraSecret: nil,
 			expectErr:   true,
 		},
+		{
+			testName: "invalid spec.tls.issuerRef.kind, expect error",
+			vs: func() *api.VaultServer {
+				v := vaultServerWiitUnsealer(nil)
+				v.Spec.TLS = &api.TLSPolicy{
+					IssuerRef: &core.TypedLocalObjectReference{
+						Name: "ca-issuer",
+						Kind: "Certificate",
+					},
+				}
+				return &v
+			}(),
+			extraSecret: nil,
+			expectErr:   true,
+		},
+		{
+			testName: "invalid spec.listener.tlsMinVersion, expect error",
+			vs: func() *api.VaultServer {
+				v := vaultServerWiitUnsealer(nil)
+				v.Spec.Listener = &api.TCPListenerSpec{TLSMinVersion: "tls09"}
+				return &v
+			}(),
+			extraSecret: nil,
+			expectErr:   true,
+		},
+		{
+			testName: "spec.serverConfig.defaultLeaseTTL > spec.serverConfig.maxLeaseTTL, expect error",
+			vs: func() *api.VaultServer {
+				v := vaultServerWiitUnsealer(nil)
+				v.Spec.ServerConfig = &api.ServerConfigSpec{
+					DefaultLeaseTTL: &metav1.Duration{Duration: 2 * time.Hour},
+					MaxLeaseTTL:     &metav1.Duration{Duration: time.Hour},
+				}
+				return &v
+			}(),
+			extraSecret: nil,
+			expectErr:   true,
+		},
+		{
+			testName: "spec.configSource conflicts with spec.serverConfig, expect error",
+			vs: func() *api.VaultServer {
+				v := vaultServerWiitUnsealer(nil)
+				v.Spec.ServerConfig = &api.ServerConfigSpec{UI: true}
+				v.Spec.ConfigSource = &core.VolumeSource{Secret: &core.SecretVolumeSource{SecretName: "user-config"}}
+				return &v
+			}(),
+			extraSecret: []core.Secret{userConfigSecret("ui = true\n")},
+			expectErr:   true,
+		},
+		{
+			testName: "spec.configSource conflicts with spec.listener, expect error",
+			vs: func() *api.VaultServer {
+				v := vaultServerWiitUnsealer(nil)
+				v.Spec.Listener = &api.TCPListenerSpec{TLSMinVersion: "tls12"}
+				v.Spec.ConfigSource = &core.VolumeSource{Secret: &core.SecretVolumeSource{SecretName: "user-config"}}
+				return &v
+			}(),
+			extraSecret: []core.Secret{userConfigSecret(`listener "tcp" { address = "0.0.0.0:8200" }`)},
+			expectErr:   true,
+		},
+		{
+			testName: "spec.configSource doesn't conflict with typed configuration, expect no error",
+			vs: func() *api.VaultServer {
+				v := vaultServerWiitUnsealer(nil)
+				v.Spec.Listener = &api.TCPListenerSpec{TLSMinVersion: "tls12"}
+				v.Spec.ServerConfig = &api.ServerConfigSpec{UI: true, LogLevel: "debug"}
+				v.Spec.ConfigSource = &core.VolumeSource{Secret: &core.SecretVolumeSource{SecretName: "user-config"}}
+				return &v
+			}(),
+			extraSecret: []core.Secret{userConfigSecret("plugin_directory = \"/etc/vault/plugins\"\n" +
+				`listener "tcp" { address = "0.0.0.0:8300" }`)},
+			expectErr: false,
+		},
+		{
+			testName: "spec.unsealer.pgpKeys not supported by unsealer image, expect error",
+			vs: func() *api.VaultServer {
+				u := unsealerWithKubernetes()
+				u.SecretShares = 1
+				u.PGPKeys = []api.PGPKeySource{{Custodian: "alice"}}
+				v := vaultServerWiitUnsealer(&u)
+				return &v
+			}(),
+			extraSecret: nil,
+			expectErr:   true,
+		},
+		{
+			testName: "spec.unsealer.pgpKeys with google kms gcs mode, expect error",
+			vs: func() *api.VaultServer {
+				u, _ := unsealerWithGoogleKmsGcs()
+				u.SecretShares = 1
+				u.PGPKeys = []api.PGPKeySource{{Custodian: "alice"}}
+				v := vaultServerWiitUnsealer(&u)
+				v.Spec.Version = "1.11.1-pgp"
+				return &v
+			}(),
+			extraSecret: func() []core.Secret { _, s := unsealerWithGoogleKmsGcs(); return s }(),
+			expectErr:   true,
+		},
+		{
+			testName: "number of spec.unsealer.pgpKeys != spec.unsealer.secretShares, expect error",
+			vs: func() *api.VaultServer {
+				u := unsealerWithKubernetes()
+				u.PGPKeys = []api.PGPKeySource{{Custodian: "alice"}}
+				v := vaultServerWiitUnsealer(&u)
+				v.Spec.Version = "1.11.1-pgp"
+				return &v
+			}(),
+			extraSecret: nil,
+			expectErr:   true,
+		},
+		{
+			testName: "spec.unsealer.rootTokenPGPKey without spec.unsealer.pgpKeys, expect error",
+			vs: func() *api.VaultServer {
+				u := unsealerWithKubernetes()
+				u.RootTokenPGPKey = &api.PGPKeySource{}
+				v := vaultServerWiitUnsealer(&u)
+				return &v
+			}(),
+			extraSecret: nil,
+			expectErr:   true,
+		},
 		{
 			testName:    "using kubernetes secret unsealer, expect no error",
 			vs:          func() *api.VaultServer { u := unsealerWithKubernetes(); v := vaultServerWiitUnsealer(&u); return &v }(),
@@ -435,7 +568,7 @@ func TestValidateVaultServer(t *testing.T) {
 				assert.Nil(t, err, "create secret error should be nil")
 			}
 
-			extC := extfake.NewSimpleClientset(&vsVersion)
+			extC := extfake.NewSimpleClientset(&vsVersion, &vsVersionWithPGPKeys)
 
 			err := ValidateVaultServer(kc, extC, c.vs)
 			if c.expectErr {
@@ -809,3 +942,15 @@ func getSecret(name string, keys []string) core.Secret {
 	}
 	return sr
 }
+
+func userConfigSecret(config string) core.Secret {
+	return core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "user-config",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			"vault.hcl": []byte(config),
+		},
+	}
+}
@@ -17,15 +17,19 @@ package server
 
 import (
 	"flag"
+	"strings"
 	"time"
 
 	cs "kubevault.dev/operator/client/clientset/versioned"
+	"kubevault.dev/operator/pkg/admission"
 	"kubevault.dev/operator/pkg/controller"
+	"kubevault.dev/operator/pkg/credentialprovider"
 	"kubevault.dev/operator/pkg/docker"
 
 	prom "github.com/coreos/prometheus-operator/pkg/client/versioned/typed/monitoring/v1"
 	"github.com/spf13/pflag"
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"kmodules.xyz/client-go/tools/clusterid"
 	appcat_cs "kmodules.xyz/custom-resources/client/clientset/versioned/typed/appcatalog/v1alpha1"
@@ -40,6 +44,8 @@ type ExtraOptions struct {
 	ResyncPeriod            time.Duration
 	EnableValidatingWebhook bool
 	EnableMutatingWebhook   bool
+	PolicyDenylist          string
+	AWSCredentialsAudience  string
 }
 
 func NewExtraOptions() *ExtraOptions {
@@ -50,6 +56,9 @@ func NewExtraOptions() *ExtraOptions {
 		QPS:            100,
 		Burst:          100,
 		ResyncPeriod:   10 * time.Minute,
+		PolicyDenylist: strings.Join(admission.DefaultPolicyDenylist, ","),
+
+		AWSCredentialsAudience: credentialprovider.DefaultAudience,
 	}
 }
 
@@ -64,6 +73,8 @@ func (s *ExtraOptions) AddGoFlags(fs *flag.FlagSet) {
 
 	fs.BoolVar(&s.EnableMutatingWebhook, "enable-mutating-webhook", s.EnableMutatingWebhook, "If true, enables mutating webhooks for KubeDB CRDs.")
 	fs.BoolVar(&s.EnableValidatingWebhook, "enable-validating-webhook", s.EnableValidatingWebhook, "If true, enables validating webhooks for KubeDB CRDs.")
+	fs.StringVar(&s.PolicyDenylist, "policy-denylist", s.PolicyDenylist, "Comma separated list of grants formatted as <path>[:<capability>], which are rejected by the VaultPolicy validating webhook. The policy paths matching any path of a grant are rejected, except for the service accounts of the operator namespace")
+	fs.StringVar(&s.AWSCredentialsAudience, "aws-credentials-audience", s.AWSCredentialsAudience, "Audience of the projected service account tokens, which the pods are authenticated by at the aws credentials endpoint")
 }
 
 func (s *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
@@ -83,6 +94,13 @@ func (s *ExtraOptions) ApplyTo(cfg *controller.Config) error {
 	cfg.ClientConfig.Burst = s.Burst
 	cfg.EnableMutatingWebhook = s.EnableMutatingWebhook
 	cfg.EnableValidatingWebhook = s.EnableValidatingWebhook
+	cfg.AWSCredentialsAudience = s.AWSCredentialsAudience
+	cfg.PolicyDenylist = nil
+	for _, e := range strings.Split(s.PolicyDenylist, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			cfg.PolicyDenylist = append(cfg.PolicyDenylist, e)
+		}
+	}
 
 	if cfg.KubeClient, err = kubernetes.NewForConfig(cfg.ClientConfig); err != nil {
 		return err
@@ -99,5 +117,8 @@ func (s *ExtraOptions) ApplyTo(cfg *controller.Config) error {
 	if cfg.AppCatalogClient, err = appcat_cs.NewForConfig(cfg.ClientConfig); err != nil {
 		return err
 	}
+	if cfg.DynamicClient, err = dynamic.NewForConfig(cfg.ClientConfig); err != nil {
+		return err
+	}
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	"net"
 
 	"kubevault.dev/operator/pkg/controller"
+	"kubevault.dev/operator/pkg/credentialprovider"
 	"kubevault.dev/operator/pkg/server"
 
 	"github.com/spf13/pflag"
@@ -55,6 +56,8 @@ func NewVaultServerOptions(out, errOut io.Writer) *VaultServerOptions {
 	}
 	o.RecommendedOptions.Etcd = nil
 	o.RecommendedOptions.Admission = nil
+	// the pods are authenticated by the aws credentials endpoint itself
+	o.RecommendedOptions.Authorization.WithAlwaysAllowPaths(credentialprovider.AWSCredentialsPath + "*")
 
 	return o
 }
@@ -0,0 +1,262 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault/role/approle"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	VaultAppRolePhaseSuccess    api.VaultAppRolePhase = "Success"
+	VaultAppRoleConditionFailed string                = "Failed"
+	VaultAppRoleFinalizer       string                = "vaultapprole.engine.kubevault.com"
+)
+
+func (c *VaultController) initVaultAppRoleWatcher() {
+	c.appRoleInformer = c.extInformerFactory.Engine().V1alpha1().VaultAppRoles().Informer()
+	c.appRoleQueue = queue.New(api.ResourceKindVaultAppRole, c.MaxNumRequeues, c.NumThreads, c.runVaultAppRoleInjector)
+	c.appRoleInformer.AddEventHandler(queue.NewReconcilableHandler(c.appRoleQueue.GetQueue()))
+	c.appRoleLister = c.extInformerFactory.Engine().V1alpha1().VaultAppRoles().Lister()
+}
+
+func (c *VaultController) runVaultAppRoleInjector(key string) error {
+	obj, exist, err := c.appRoleInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exist {
+		glog.Warningf("VaultAppRole %s does not exist anymore", key)
+
+	} else {
+		appRole := obj.(*api.VaultAppRole).DeepCopy()
+
+		glog.Infof("Sync/Add/Update for VaultAppRole %s/%s", appRole.Namespace, appRole.Name)
+
+		if appRole.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(appRole.ObjectMeta, VaultAppRoleFinalizer) {
+				go c.runVaultAppRoleFinalizer(appRole, finalizerTimeout, finalizerInterval)
+			}
+		} else {
+			if !core_util.HasFinalizer(appRole.ObjectMeta, VaultAppRoleFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchVaultAppRole(c.extClient.EngineV1alpha1(), appRole, func(role *api.VaultAppRole) *api.VaultAppRole {
+					role.ObjectMeta = core_util.AddFinalizer(role.ObjectMeta, VaultAppRoleFinalizer)
+					return role
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set VaultAppRole finalizer for %s/%s", appRole.Namespace, appRole.Name)
+				}
+			}
+
+			arClient, err := approle.NewVaultAppRole(c.kubeClient, c.appCatalogClient, appRole)
+			if err != nil {
+				return err
+			}
+
+			err = c.reconcileVaultAppRole(arClient, appRole)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultAppRole %s/%s:", appRole.Namespace, appRole.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Will do:
+//	For vault:
+// 	  - configure an AppRole
+//    - read role_id of the AppRole
+//    - sync role
+func (c *VaultController) reconcileVaultAppRole(arClient approle.AppRoleInterface, appRole *api.VaultAppRole) error {
+	status := appRole.Status
+
+	// create role
+	err := arClient.CreateRole()
+	if err != nil {
+		status.Conditions = []api.VaultAppRoleCondition{
+			{
+				Type:    VaultAppRoleConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  "FailedToCreateRole",
+				Message: err.Error(),
+			},
+		}
+
+		err2 := c.updatedVaultAppRoleStatus(&status, appRole)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to update status")
+		}
+		return errors.Wrap(err, "failed to create role")
+	}
+
+	// read role_id
+	roleID, err := arClient.ReadRoleID()
+	if err != nil {
+		status.Conditions = []api.VaultAppRoleCondition{
+			{
+				Type:    VaultAppRoleConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  "FailedToReadRoleID",
+				Message: err.Error(),
+			},
+		}
+
+		err2 := c.updatedVaultAppRoleStatus(&status, appRole)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to update status")
+		}
+		return errors.Wrap(err, "failed to read role_id")
+	}
+
+	status.RoleID = roleID
+	status.Conditions = []api.VaultAppRoleCondition{}
+	status.Phase = VaultAppRolePhaseSuccess
+	status.ObservedGeneration = appRole.Generation
+
+	err = c.updatedVaultAppRoleStatus(&status, appRole)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update VaultAppRole status")
+	}
+	return nil
+}
+
+func (c *VaultController) updatedVaultAppRoleStatus(status *api.VaultAppRoleStatus, appRole *api.VaultAppRole) error {
+	_, err := patchutil.UpdateVaultAppRoleStatus(c.extClient.EngineV1alpha1(), appRole, func(s *api.VaultAppRoleStatus) *api.VaultAppRoleStatus {
+		return status
+	})
+	return err
+}
+
+func (c *VaultController) runVaultAppRoleFinalizer(appRole *api.VaultAppRole, timeout time.Duration, interval time.Duration) {
+	if appRole == nil {
+		glog.Infoln("VaultAppRole is nil")
+		return
+	}
+
+	id := getVaultAppRoleId(appRole)
+	if c.finalizerInfo.IsAlreadyProcessing(id) {
+		// already processing
+		return
+	}
+
+	glog.Infof("Processing finalizer for VaultAppRole %s/%s", appRole.Namespace, appRole.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this VaultAppRole
+	c.finalizerInfo.Add(id)
+
+	stopCh := time.After(timeout)
+	finalizationDone := false
+	timeOutOccured := false
+	attempt := 0
+
+	for {
+		glog.Infof("VaultAppRole %s/%s finalizer: attempt %d\n", appRole.Namespace, appRole.Name, attempt)
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		if !finalizationDone {
+			d, err := approle.NewVaultAppRole(c.kubeClient, c.appCatalogClient, appRole)
+			if err != nil {
+				glog.Errorf("VaultAppRole %s/%s finalizer: %v", appRole.Namespace, appRole.Name, err)
+			} else {
+				err = c.finalizeVaultAppRole(d, appRole)
+				if err != nil {
+					glog.Errorf("VaultAppRole %s/%s finalizer: %v", appRole.Namespace, appRole.Name, err)
+				} else {
+					finalizationDone = true
+				}
+			}
+		}
+
+		if finalizationDone {
+			err := c.removeVaultAppRoleFinalizer(appRole)
+			if err != nil {
+				glog.Errorf("VaultAppRole %s/%s finalizer: removing finalizer %v", appRole.Namespace, appRole.Name, err)
+			} else {
+				break
+			}
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+		attempt++
+	}
+
+	err := c.removeVaultAppRoleFinalizer(appRole)
+	if err != nil {
+		glog.Errorf("VaultAppRole %s/%s finalizer: removing finalizer %v", appRole.Namespace, appRole.Name, err)
+	} else {
+		glog.Infof("Removed finalizer for VaultAppRole %s/%s", appRole.Namespace, appRole.Name)
+	}
+
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(id)
+}
+
+// Do:
+//	- delete role in vault
+func (c *VaultController) finalizeVaultAppRole(arClient approle.AppRoleInterface, appRole *api.VaultAppRole) error {
+	err := arClient.DeleteRole(appRole.RoleName())
+	if err != nil {
+		return errors.Wrap(err, "failed to delete approle")
+	}
+	return nil
+}
+
+func (c *VaultController) removeVaultAppRoleFinalizer(appRole *api.VaultAppRole) error {
+	m, err := c.extClient.EngineV1alpha1().VaultAppRoles(appRole.Namespace).Get(appRole.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// remove finalizer
+	_, _, err = patchutil.PatchVaultAppRole(c.extClient.EngineV1alpha1(), m, func(role *api.VaultAppRole) *api.VaultAppRole {
+		role.ObjectMeta = core_util.RemoveFinalizer(role.ObjectMeta, VaultAppRoleFinalizer)
+		return role
+	})
+	return err
+}
+
+func getVaultAppRoleId(appRole *api.VaultAppRole) string {
+	return fmt.Sprintf("%s/%s/%s", api.ResourceVaultAppRole, appRole.Namespace, appRole.Name)
+}
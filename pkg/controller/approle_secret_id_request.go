@@ -0,0 +1,486 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault/role/approle"
+	"kubevault.dev/operator/pkg/vault/util"
+
+	"github.com/appscode/go/crypto/rand"
+	"github.com/golang/glog"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+	rbac_util "kmodules.xyz/client-go/rbac/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	AppRoleSecretIDRequestFailed    api.RequestConditionType = "Failed"
+	AppRoleSecretIDRequestFinalizer string                   = "approlesecretidrequest.engine.kubevault.com"
+
+	AppRoleRoleIDKey        = "role_id"
+	AppRoleSecretIDKey      = "secret_id"
+	AppRoleWrappingTokenKey = "wrapping_token"
+)
+
+func (c *VaultController) initAppRoleSecretIDRequestWatcher() {
+	c.appRoleSecretIDInformer = c.extInformerFactory.Engine().V1alpha1().AppRoleSecretIDRequests().Informer()
+	c.appRoleSecretIDQueue = queue.New(api.ResourceKindAppRoleSecretIDRequest, c.MaxNumRequeues, c.NumThreads, c.runAppRoleSecretIDRequestInjector)
+	c.appRoleSecretIDInformer.AddEventHandler(queue.NewEventHandler(c.appRoleSecretIDQueue.GetQueue(), func(oldObj, newObj interface{}) bool {
+		old := oldObj.(*api.AppRoleSecretIDRequest)
+		nu := newObj.(*api.AppRoleSecretIDRequest)
+
+		oldCondType := ""
+		nuCondType := ""
+		for _, c := range old.Status.Conditions {
+			if c.Type == api.AccessApproved || c.Type == api.AccessDenied {
+				oldCondType = string(c.Type)
+			}
+		}
+		for _, c := range nu.Status.Conditions {
+			if c.Type == api.AccessApproved || c.Type == api.AccessDenied {
+				nuCondType = string(c.Type)
+			}
+		}
+		if oldCondType != nuCondType {
+			return true
+		}
+		return nu.GetDeletionTimestamp() != nil
+	}))
+	c.appRoleSecretIDLister = c.extInformerFactory.Engine().V1alpha1().AppRoleSecretIDRequests().Lister()
+}
+
+func (c *VaultController) runAppRoleSecretIDRequestInjector(key string) error {
+	obj, exist, err := c.appRoleSecretIDInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exist {
+		glog.Warningf("AppRoleSecretIDRequest %s does not exist anymore", key)
+
+	} else {
+		sidReq := obj.(*api.AppRoleSecretIDRequest).DeepCopy()
+
+		glog.Infof("Sync/Add/Update for AppRoleSecretIDRequest %s/%s", sidReq.Namespace, sidReq.Name)
+
+		if sidReq.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(sidReq.ObjectMeta, AppRoleSecretIDRequestFinalizer) {
+				go c.runAppRoleSecretIDRequestFinalizer(sidReq, finalizerTimeout, finalizerInterval)
+			}
+		} else {
+			if !core_util.HasFinalizer(sidReq.ObjectMeta, AppRoleSecretIDRequestFinalizer) {
+				// Add finalizer
+				_, _, err = patchutil.PatchAppRoleSecretIDRequest(c.extClient.EngineV1alpha1(), sidReq, func(in *api.AppRoleSecretIDRequest) *api.AppRoleSecretIDRequest {
+					in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, AppRoleSecretIDRequestFinalizer)
+					return in
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set AppRoleSecretIDRequest finalizer for %s/%s", sidReq.Namespace, sidReq.Name)
+				}
+			}
+
+			var condType api.RequestConditionType
+			for _, c := range sidReq.Status.Conditions {
+				if c.Type == api.AccessApproved || c.Type == api.AccessDenied {
+					condType = c.Type
+				}
+			}
+
+			if condType == api.AccessApproved {
+				arClient, err := c.newAppRoleClientForRequest(sidReq)
+				if err != nil {
+					return err
+				}
+
+				err = c.reconcileAppRoleSecretIDRequest(arClient, sidReq)
+				if err != nil {
+					return errors.Wrapf(err, "For AppRoleSecretIDRequest %s/%s", sidReq.Namespace, sidReq.Name)
+				}
+			} else if condType == api.AccessDenied {
+				glog.Infof("For AppRoleSecretIDRequest %s/%s: request is denied", sidReq.Namespace, sidReq.Name)
+			} else {
+				glog.Infof("For AppRoleSecretIDRequest %s/%s: request is not approved yet", sidReq.Namespace, sidReq.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *VaultController) newAppRoleClientForRequest(sidReq *api.AppRoleSecretIDRequest) (approle.AppRoleInterface, error) {
+	ref := sidReq.Spec.RoleRef
+	r, err := c.extClient.EngineV1alpha1().VaultAppRoles(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "VaultAppRole %s/%s", ref.Namespace, ref.Name)
+	}
+	return approle.NewVaultAppRole(c.kubeClient, c.appCatalogClient, r)
+}
+
+// Will do:
+//	For vault:
+//	  - read role_id and generate secret_id
+//	  - create secret containing role_id and secret_id (or wrapping token)
+//	  - create rbac role and role binding
+//    - sync role binding
+func (c *VaultController) reconcileAppRoleSecretIDRequest(arClient approle.AppRoleInterface, sidReq *api.AppRoleSecretIDRequest) error {
+	var (
+		name   = sidReq.Name
+		ns     = sidReq.Namespace
+		status = sidReq.Status
+	)
+
+	var secretName string
+	if sidReq.Status.Secret != nil {
+		secretName = sidReq.Status.Secret.Name
+	}
+
+	// check whether secret_id is already issued or not
+	// if not, then generate secret_id
+	if sidReq.Status.SecretIDAccessor == "" {
+		data, accessor, err := c.issueAppRoleSecretID(arClient, sidReq.Spec)
+		if err != nil {
+			status.Conditions = UpsertAppRoleSecretIDCondition(status.Conditions, api.AppRoleSecretIDRequestCondition{
+				Type:           AppRoleSecretIDRequestFailed,
+				Reason:         "FailedToGetSecretID",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateAppRoleSecretIDRequestStatus(&status, sidReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+
+		secretName = rand.WithUniqSuffix(name)
+		err = c.createAppRoleSecretIDSecret(secretName, sidReq, data)
+		if err != nil {
+			err2 := arClient.DestroySecretIDAccessor(accessor)
+			if err2 != nil {
+				return errors.Wrapf(err, "failed to destroy secret_id with %v", err2)
+			}
+			status.Conditions = UpsertAppRoleSecretIDCondition(status.Conditions, api.AppRoleSecretIDRequestCondition{
+				Type:           AppRoleSecretIDRequestFailed,
+				Reason:         "FailedToCreateSecret",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 = c.updateAppRoleSecretIDRequestStatus(&status, sidReq)
+			if err2 != nil {
+				return errors.Wrapf(err, "failed to update status with %v", err2)
+			}
+			return errors.WithStack(err)
+		}
+
+		status.SecretIDAccessor = accessor
+		status.Secret = &core.LocalObjectReference{
+			Name: secretName,
+		}
+	}
+
+	roleName := getSecretAccessRoleName(api.ResourceKindAppRoleSecretIDRequest, ns, name)
+
+	err := c.createAppRoleSecretIDRBAC(roleName, secretName, sidReq)
+	if err != nil {
+		status.Conditions = UpsertAppRoleSecretIDCondition(status.Conditions, api.AppRoleSecretIDRequestCondition{
+			Type:           AppRoleSecretIDRequestFailed,
+			Reason:         "FailedToCreateRoleBinding",
+			Message:        err.Error(),
+			LastUpdateTime: metav1.Now(),
+		})
+
+		err2 := c.updateAppRoleSecretIDRequestStatus(&status, sidReq)
+		if err2 != nil {
+			return errors.Wrapf(err2, "failed to update status")
+		}
+		return errors.WithStack(err)
+	}
+
+	status.Conditions = DeleteAppRoleSecretIDCondition(status.Conditions, AppRoleSecretIDRequestFailed)
+	err = c.updateAppRoleSecretIDRequestStatus(&status, sidReq)
+	if err != nil {
+		return errors.Wrap(err, "failed to update status")
+	}
+	return nil
+}
+
+// issueAppRoleSecretID reads the role_id and generates a new secret_id.
+// It returns the secret data and the accessor of the secret_id.
+func (c *VaultController) issueAppRoleSecretID(arClient approle.AppRoleInterface, spec api.AppRoleSecretIDRequestSpec) (map[string][]byte, string, error) {
+	roleID, err := arClient.ReadRoleID()
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := arClient.GenerateSecretID(spec)
+	if err != nil {
+		return nil, "", err
+	}
+	return appRoleSecretIDData(roleID, secret)
+}
+
+// appRoleSecretIDData returns the secret data and the accessor of the secret_id.
+// For a response-wrapped secret_id, the wrapping token is stored instead of
+// the secret_id and the accessor is taken from the wrap info.
+func appRoleSecretIDData(roleID string, secret *vaultapi.Secret) (map[string][]byte, string, error) {
+	data := map[string][]byte{
+		AppRoleRoleIDKey: []byte(roleID),
+	}
+	if secret.WrapInfo != nil {
+		if secret.WrapInfo.Token == "" {
+			return nil, "", errors.New("wrapping token is empty")
+		}
+		data[AppRoleWrappingTokenKey] = []byte(secret.WrapInfo.Token)
+		return data, secret.WrapInfo.WrappedAccessor, nil
+	}
+
+	secretID, ok := secret.Data["secret_id"].(string)
+	if !ok || secretID == "" {
+		return nil, "", errors.New("secret_id is not found")
+	}
+	accessor, ok := secret.Data["secret_id_accessor"].(string)
+	if !ok || accessor == "" {
+		return nil, "", errors.New("secret_id_accessor is not found")
+	}
+	data[AppRoleSecretIDKey] = []byte(secretID)
+	return data, accessor, nil
+}
+
+func (c *VaultController) createAppRoleSecretIDSecret(name string, sidReq *api.AppRoleSecretIDRequest, data map[string][]byte) error {
+	obj := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: sidReq.Namespace,
+	}
+	_, _, err := core_util.CreateOrPatchSecret(c.kubeClient, obj, func(in *core.Secret) *core.Secret {
+		in.Data = data
+		util.EnsureOwnerRefToObject(in, getAppRoleSecretIDRequestOwnerRef(sidReq))
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create/update secret %s/%s", sidReq.Namespace, name)
+	}
+	return nil
+}
+
+// createAppRoleSecretIDRBAC creates rbac role and role binding that
+// grant the subjects of the request to read the secret
+func (c *VaultController) createAppRoleSecretIDRBAC(roleName, secretName string, sidReq *api.AppRoleSecretIDRequest) error {
+	obj := metav1.ObjectMeta{
+		Name:      roleName,
+		Namespace: sidReq.Namespace,
+	}
+	ownerRef := getAppRoleSecretIDRequestOwnerRef(sidReq)
+
+	_, _, err := rbac_util.CreateOrPatchRole(c.kubeClient, obj, func(in *rbac.Role) *rbac.Role {
+		in.Rules = []rbac.PolicyRule{
+			{
+				APIGroups: []string{
+					"", // represents core api
+				},
+				Resources: []string{
+					"secrets",
+				},
+				ResourceNames: []string{
+					secretName,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+		}
+		util.EnsureOwnerRefToObject(in, ownerRef)
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create rbac role %s/%s", sidReq.Namespace, roleName)
+	}
+
+	_, _, err = rbac_util.CreateOrPatchRoleBinding(c.kubeClient, obj, func(in *rbac.RoleBinding) *rbac.RoleBinding {
+		in.RoleRef = rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "Role",
+			Name:     roleName,
+		}
+		in.Subjects = sidReq.Spec.Subjects
+		util.EnsureOwnerRefToObject(in, ownerRef)
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create/update rbac role binding %s/%s", sidReq.Namespace, roleName)
+	}
+	return nil
+}
+
+func (c *VaultController) updateAppRoleSecretIDRequestStatus(status *api.AppRoleSecretIDRequestStatus, sidReq *api.AppRoleSecretIDRequest) error {
+	_, err := patchutil.UpdateAppRoleSecretIDRequestStatus(c.extClient.EngineV1alpha1(), sidReq, func(s *api.AppRoleSecretIDRequestStatus) *api.AppRoleSecretIDRequestStatus {
+		return status
+	})
+	return err
+}
+
+func (c *VaultController) runAppRoleSecretIDRequestFinalizer(sidReq *api.AppRoleSecretIDRequest, timeout time.Duration, interval time.Duration) {
+	if sidReq == nil {
+		glog.Infoln("AppRoleSecretIDRequest is nil")
+		return
+	}
+
+	id := getAppRoleSecretIDRequestId(sidReq)
+	if c.finalizerInfo.IsAlreadyProcessing(id) {
+		// already processing
+		return
+	}
+
+	glog.Infof("Processing finalizer for AppRoleSecretIDRequest %s/%s", sidReq.Namespace, sidReq.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this AppRoleSecretIDRequest
+	c.finalizerInfo.Add(id)
+
+	stopCh := time.After(timeout)
+	finalizationDone := false
+	timeOutOccured := false
+	attempt := 0
+
+	for {
+		glog.Infof("AppRoleSecretIDRequest %s/%s finalizer: attempt %d\n", sidReq.Namespace, sidReq.Name, attempt)
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		if !finalizationDone {
+			err := c.finalizeAppRoleSecretIDRequest(sidReq)
+			if err != nil {
+				glog.Errorf("AppRoleSecretIDRequest %s/%s finalizer: %v", sidReq.Namespace, sidReq.Name, err)
+			} else {
+				finalizationDone = true
+			}
+		}
+
+		if finalizationDone {
+			err := c.removeAppRoleSecretIDRequestFinalizer(sidReq)
+			if err != nil {
+				glog.Errorf("AppRoleSecretIDRequest %s/%s finalizer: %v", sidReq.Namespace, sidReq.Name, err)
+			} else {
+				break
+			}
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+		attempt++
+	}
+
+	err := c.removeAppRoleSecretIDRequestFinalizer(sidReq)
+	if err != nil {
+		glog.Errorf("AppRoleSecretIDRequest %s/%s finalizer: %v", sidReq.Namespace, sidReq.Name, err)
+	} else {
+		glog.Infof("Removed finalizer for AppRoleSecretIDRequest %s/%s", sidReq.Namespace, sidReq.Name)
+	}
+
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(id)
+}
+
+// Do:
+//	- destroy the issued secret_id in vault
+func (c *VaultController) finalizeAppRoleSecretIDRequest(sidReq *api.AppRoleSecretIDRequest) error {
+	if sidReq.Status.SecretIDAccessor == "" {
+		return nil
+	}
+	arClient, err := c.newAppRoleClientForRequest(sidReq)
+	if err != nil {
+		return err
+	}
+	return arClient.DestroySecretIDAccessor(sidReq.Status.SecretIDAccessor)
+}
+
+func (c *VaultController) removeAppRoleSecretIDRequestFinalizer(sidReq *api.AppRoleSecretIDRequest) error {
+	m, err := c.extClient.EngineV1alpha1().AppRoleSecretIDRequests(sidReq.Namespace).Get(sidReq.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, _, err = patchutil.PatchAppRoleSecretIDRequest(c.extClient.EngineV1alpha1(), m, func(in *api.AppRoleSecretIDRequest) *api.AppRoleSecretIDRequest {
+		in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, AppRoleSecretIDRequestFinalizer)
+		return in
+	})
+	return err
+}
+
+func getAppRoleSecretIDRequestOwnerRef(sidReq *api.AppRoleSecretIDRequest) metav1.OwnerReference {
+	trueVar := true
+	return metav1.OwnerReference{
+		APIVersion: api.SchemeGroupVersion.String(),
+		Kind:       api.ResourceKindAppRoleSecretIDRequest,
+		Name:       sidReq.Name,
+		UID:        sidReq.UID,
+		Controller: &trueVar,
+	}
+}
+
+func getAppRoleSecretIDRequestId(sidReq *api.AppRoleSecretIDRequest) string {
+	return fmt.Sprintf("%s/%s/%s", api.ResourceAppRoleSecretIDRequest, sidReq.Namespace, sidReq.Name)
+}
+
+func UpsertAppRoleSecretIDCondition(condList []api.AppRoleSecretIDRequestCondition, cond api.AppRoleSecretIDRequestCondition) []api.AppRoleSecretIDRequestCondition {
+	res := []api.AppRoleSecretIDRequestCondition{}
+	inserted := false
+	for _, c := range condList {
+		if c.Type == cond.Type {
+			res = append(res, cond)
+			inserted = true
+		} else {
+			res = append(res, c)
+		}
+	}
+	if !inserted {
+		res = append(res, cond)
+	}
+	return res
+}
+
+func DeleteAppRoleSecretIDCondition(condList []api.AppRoleSecretIDRequestCondition, condType api.RequestConditionType) []api.AppRoleSecretIDRequestCondition {
+	res := []api.AppRoleSecretIDRequestCondition{}
+	for _, c := range condList {
+		if c.Type != condType {
+			res = append(res, c)
+		}
+	}
+	return res
+}
@@ -0,0 +1,86 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppRoleSecretIDData(t *testing.T) {
+	roleID := "e5a7b66e-5d08-da9c-7075-71984634b882"
+
+	cases := []struct {
+		testName         string
+		secret           *vaultapi.Secret
+		expectedData     map[string][]byte
+		expectedAccessor string
+		expectErr        bool
+	}{
+		{
+			testName: "secret_id, expect no error",
+			secret: &vaultapi.Secret{
+				Data: map[string]interface{}{
+					"secret_id":          "841771dc-11c9-bbc7-bcac-6a3945a69cd9",
+					"secret_id_accessor": "84896a0c-1347-aa90-a4f6-aca8b7558780",
+				},
+			},
+			expectedData: map[string][]byte{
+				AppRoleRoleIDKey:   []byte(roleID),
+				AppRoleSecretIDKey: []byte("841771dc-11c9-bbc7-bcac-6a3945a69cd9"),
+			},
+			expectedAccessor: "84896a0c-1347-aa90-a4f6-aca8b7558780",
+		},
+		{
+			testName: "response-wrapped secret_id, expect no error",
+			secret: &vaultapi.Secret{
+				WrapInfo: &vaultapi.SecretWrapInfo{
+					Token:           "s.wrapped",
+					WrappedAccessor: "84896a0c-1347-aa90-a4f6-aca8b7558780",
+				},
+			},
+			expectedData: map[string][]byte{
+				AppRoleRoleIDKey:        []byte(roleID),
+				AppRoleWrappingTokenKey: []byte("s.wrapped"),
+			},
+			expectedAccessor: "84896a0c-1347-aa90-a4f6-aca8b7558780",
+		},
+		{
+			testName: "secret_id without accessor, expect error",
+			secret: &vaultapi.Secret{
+				Data: map[string]interface{}{
+					"secret_id": "841771dc-11c9-bbc7-bcac-6a3945a69cd9",
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			data, accessor, err := appRoleSecretIDData(roleID, c.secret)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else if assert.Nil(t, err) {
+				assert.Equal(t, c.expectedData, data)
+				assert.Equal(t, c.expectedAccessor, accessor)
+			}
+		})
+	}
+}
@@ -51,6 +51,10 @@ path "sys/auth" {
 path "sys/auth/*" {
   capabilities = ["sudo", "create", "read", "update", "delete"]
 }
+
+path "auth/+/config" {
+  capabilities = ["create", "read", "update"]
+}
 `
 
 const (
@@ -83,6 +87,7 @@ func (c *VaultController) runAuthMethodsReconcile(vs *api.VaultServer) {
 // tasks:
 //	- create VaultPolicy and VaultPolicyBinding, it will not create those until vault is ready
 //  - enable or disable auth methods in vault
+//  - configure jwt/oidc auth methods and re-sync the public keys of the service account issuer
 func (c *VaultController) reconcileAuthMethods(vs *api.VaultServer, ctx context.Context) {
 	if vs == nil {
 		glog.Errorf("VaultServer is nil")
@@ -135,6 +140,7 @@ func (c *VaultController) reconcileAuthMethods(vs *api.VaultServer, ctx context.
 		glog.Errorf("auth method controller: for VaultServer %s/%s: %s", vs.Namespace, vs.Name, err)
 		return
 	}
+	authStatus = configureJWTAuthMethods(vc, c.kubeClient, vs.Spec.AuthMethods, authStatus)
 
 	authDisableStatus := disableAuthMethods(vc, vs.Spec.AuthMethods, vs.Status.AuthMethodStatus)
 	authStatus = append(authStatus, authDisableStatus...)
@@ -148,6 +154,9 @@ func (c *VaultController) reconcileAuthMethods(vs *api.VaultServer, ctx context.
 	}
 
 	glog.Infof("auth method controller: for VaultServer %s/%s: auth method enable or disable operation applied", vs.Namespace, vs.Name)
+
+	// the keys of the service account issuer are rotated, so keep them in sync
+	c.resyncServiceAccountIssuerKeys(ctx, vs)
 }
 
 func vaultPolicyForAuthMethod(vs *api.VaultServer) *policyapi.VaultPolicy {
@@ -247,10 +256,11 @@ func enableAuthMethods(vc *vaultapi.Client, auths []api.AuthMethod) ([]api.AuthM
 				})
 			} else {
 				resp = append(resp, api.AuthMethodStatus{
-					Type:   au.Type,
-					Path:   au.Path,
-					Status: api.AuthMethodEnableSucceeded,
-					Reason: "",
+					Type:     au.Type,
+					Path:     au.Path,
+					Status:   api.AuthMethodEnableSucceeded,
+					Reason:   "",
+					Accessor: got.Accessor,
 				})
 			}
 		} else {
@@ -292,9 +302,36 @@ func enableAuthMethods(vc *vaultapi.Client, auths []api.AuthMethod) ([]api.AuthM
 			}
 		}
 	}
+	if err := setAuthMethodAccessors(vc, resp); err != nil {
+		glog.Errorf("failed to read the accessors of auth methods: %v", err)
+	}
 	return resp, nil
 }
 
+// setAuthMethodAccessors sets the mount accessor of the newly enabled auth methods
+func setAuthMethodAccessors(vc *vaultapi.Client, auths []api.AuthMethodStatus) error {
+	var missing bool
+	for _, au := range auths {
+		if au.Status == api.AuthMethodEnableSucceeded && au.Accessor == "" {
+			missing = true
+		}
+	}
+	if !missing {
+		return nil
+	}
+
+	authList, err := vc.Sys().ListAuth()
+	if err != nil {
+		return err
+	}
+	for i, au := range auths {
+		if got, ok := authList[filepath.Clean(au.Path)+"/"]; ok && au.Status == api.AuthMethodEnableSucceeded {
+			auths[i].Accessor = got.Accessor
+		}
+	}
+	return nil
+}
+
 // Disable auth methods that are not in the 'expected' auth methods but in the 'has' auth methods
 // returns the auth methods that are failed to disable
 func disableAuthMethods(vc *vaultapi.Client, expected []api.AuthMethod, has []api.AuthMethodStatus) []api.AuthMethodStatus {
@@ -306,7 +343,8 @@ func disableAuthMethods(vc *vaultapi.Client, expected []api.AuthMethod, has []ap
 	var failedToDisable []api.AuthMethodStatus
 	for _, au := range has {
 		p := filepath.Clean(au.Path)
-		if ok := authMap[p]; !ok && au.Status == api.AuthMethodEnableSucceeded {
+		enabled := au.Status == api.AuthMethodEnableSucceeded || au.Status == api.AuthMethodConfigureFailed
+		if ok := authMap[p]; !ok && enabled {
 			err := vc.Sys().DisableAuth(p)
 			if err != nil {
 				failedToDisable = append(failedToDisable, api.AuthMethodStatus{
@@ -0,0 +1,357 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+
+	"github.com/golang/glog"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	authTypeJWT  = "jwt"
+	authTypeOIDC = "oidc"
+
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	defaultJWKSPath   = "/openid/v1/jwks"
+
+	// jwksResyncInterval is the interval to re-sync the public keys of the
+	// cluster's service account issuer, so that the rotated keys are picked up
+	jwksResyncInterval = 10 * time.Minute
+)
+
+// serviceAccountIssuer contains the issuer and the public keys
+// of the cluster's service account issuer
+type serviceAccountIssuer struct {
+	issuer  string
+	pubKeys []string
+}
+
+type oidcDiscoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	// RSA public key parameters
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC public key parameters
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// configureJWTAuthMethods writes the config of the successfully enabled jwt and oidc
+// auth methods. It returns the updated status, failed ones are marked as ConfigureFailed.
+func configureJWTAuthMethods(vc *vaultapi.Client, kc kubernetes.Interface, auths []api.AuthMethod, status []api.AuthMethodStatus) []api.AuthMethodStatus {
+	var saIssuer *serviceAccountIssuer
+	for _, au := range auths {
+		if au.JWTConfig == nil || (au.Type != authTypeJWT && au.Type != authTypeOIDC) {
+			continue
+		}
+
+		idx := -1
+		for i := range status {
+			if filepath.Clean(status[i].Path) == filepath.Clean(au.Path) && status[i].Status == api.AuthMethodEnableSucceeded {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+
+		err := func() error {
+			if useServiceAccountIssuer(au.JWTConfig) && saIssuer == nil {
+				var err error
+				saIssuer, err = discoverServiceAccountIssuer(kc)
+				if err != nil {
+					return err
+				}
+			}
+			return writeJWTAuthConfig(vc, au.Path, jwtAuthConfigPayload(au.JWTConfig, saIssuer))
+		}()
+		if err != nil {
+			status[idx].Status = api.AuthMethodConfigureFailed
+			status[idx].Reason = err.Error()
+		}
+	}
+	return status
+}
+
+// serviceAccountIssuerAuthMethods returns the jwt and oidc auth methods
+// that are configured with the public keys of the cluster's service account issuer
+func serviceAccountIssuerAuthMethods(auths []api.AuthMethod) []api.AuthMethod {
+	var methods []api.AuthMethod
+	for _, au := range auths {
+		if au.JWTConfig != nil && (au.Type == authTypeJWT || au.Type == authTypeOIDC) && useServiceAccountIssuer(au.JWTConfig) {
+			methods = append(methods, au)
+		}
+	}
+	return methods
+}
+
+// resyncServiceAccountIssuerKeys periodically discovers the public keys of the cluster's
+// service account issuer and rewrites the config of the auth methods using them, when
+// the keys are changed. It runs until the context is cancelled.
+func (c *VaultController) resyncServiceAccountIssuerKeys(ctx context.Context, vs *api.VaultServer) {
+	methods := serviceAccountIssuerAuthMethods(vs.Spec.AuthMethods)
+	if len(methods) == 0 {
+		return
+	}
+
+	var pubKeys []string
+	ticker := time.NewTicker(jwksResyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		saIssuer, err := discoverServiceAccountIssuer(c.kubeClient)
+		if err != nil {
+			glog.Errorf("auth method controller: for VaultServer %s/%s: %s", vs.Namespace, vs.Name, err)
+			continue
+		}
+		if reflect.DeepEqual(pubKeys, saIssuer.pubKeys) {
+			continue
+		}
+
+		// the token of the vault client may expire, so create a new one every time
+		vc, err := newVaultClientForAuthMethodController(c.kubeClient, c.appCatalogClient, vs)
+		if err != nil {
+			glog.Errorf("auth method controller: for VaultServer %s/%s: %s", vs.Namespace, vs.Name, err)
+			continue
+		}
+		synced := true
+		for _, au := range methods {
+			err = writeJWTAuthConfig(vc, au.Path, jwtAuthConfigPayload(au.JWTConfig, saIssuer))
+			if err != nil {
+				synced = false
+				glog.Errorf("auth method controller: for VaultServer %s/%s: %s", vs.Namespace, vs.Name, err)
+			}
+		}
+		if synced {
+			pubKeys = saIssuer.pubKeys
+		}
+	}
+}
+
+// useServiceAccountIssuer returns true, if no source of the public keys is specified
+func useServiceAccountIssuer(cfg *api.JWTAuthConfig) bool {
+	return cfg.OIDCDiscoveryURL == "" && cfg.JWKSURL == "" && len(cfg.JWTValidationPubKeys) == 0
+}
+
+// Links:
+// - https://www.vaultproject.io/api/auth/jwt/index.html#configure
+func jwtAuthConfigPayload(cfg *api.JWTAuthConfig, saIssuer *serviceAccountIssuer) map[string]interface{} {
+	payload := map[string]interface{}{}
+	switch {
+	case cfg.OIDCDiscoveryURL != "":
+		payload["oidc_discovery_url"] = cfg.OIDCDiscoveryURL
+		if cfg.OIDCDiscoveryCAPem != "" {
+			payload["oidc_discovery_ca_pem"] = cfg.OIDCDiscoveryCAPem
+		}
+	case cfg.JWKSURL != "":
+		payload["jwks_url"] = cfg.JWKSURL
+		if cfg.JWKSCAPem != "" {
+			payload["jwks_ca_pem"] = cfg.JWKSCAPem
+		}
+	case len(cfg.JWTValidationPubKeys) > 0:
+		payload["jwt_validation_pubkeys"] = cfg.JWTValidationPubKeys
+	case saIssuer != nil:
+		payload["jwt_validation_pubkeys"] = saIssuer.pubKeys
+		payload["bound_issuer"] = saIssuer.issuer
+	}
+
+	if cfg.BoundIssuer != "" {
+		payload["bound_issuer"] = cfg.BoundIssuer
+	}
+	if cfg.DefaultRole != "" {
+		payload["default_role"] = cfg.DefaultRole
+	}
+	return payload
+}
+
+func writeJWTAuthConfig(vc *vaultapi.Client, path string, payload map[string]interface{}) error {
+	req := vc.NewRequest("POST", fmt.Sprintf("/v1/auth/%s/config", filepath.Clean(path)))
+	if err := req.SetJSONBody(payload); err != nil {
+		return errors.Wrap(err, "failed to load payload in jwt auth config request")
+	}
+
+	resp, err := vc.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to configure auth method in path %s", path)
+	}
+	return nil
+}
+
+// discoverServiceAccountIssuer discovers the issuer and the public keys of the
+// cluster's service account issuer from the Kubernetes API server. The public keys
+// are fetched by the operator, so that vault doesn't need to reach the API server.
+// More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/#service-account-issuer-discovery
+func discoverServiceAccountIssuer(kc kubernetes.Interface) (*serviceAccountIssuer, error) {
+	data, err := kc.Discovery().RESTClient().Get().AbsPath(oidcDiscoveryPath).DoRaw()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get openid configuration of service account issuer")
+	}
+	var doc oidcDiscoveryDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, errors.Wrap(err, "failed to parse openid configuration of service account issuer")
+	}
+	if doc.Issuer == "" {
+		return nil, errors.New("issuer is empty in openid configuration of service account issuer")
+	}
+
+	// jwks_uri may not be reachable from operator, so use its path to query the API server
+	jwksPath := defaultJWKSPath
+	if doc.JWKSURI != "" {
+		u, err := url.Parse(doc.JWKSURI)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse jwks_uri %s", doc.JWKSURI)
+		}
+		if u.Path != "" {
+			jwksPath = u.Path
+		}
+	}
+
+	data, err = kc.Discovery().RESTClient().Get().AbsPath(jwksPath).DoRaw()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get jwks of service account issuer")
+	}
+	pubKeys, err := jwksToPEM(data)
+	if err != nil {
+		return nil, err
+	}
+	return &serviceAccountIssuer{
+		issuer:  doc.Issuer,
+		pubKeys: pubKeys,
+	}, nil
+}
+
+// jwksToPEM converts the signing keys of a JSON Web Key Set to PEM-encoded public keys
+func jwksToPEM(data []byte) ([]string, error) {
+	var jwks jsonWebKeySet
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, errors.Wrap(err, "failed to parse jwks")
+	}
+
+	var pubKeys []string
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		pub, err := k.publicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "for key %s", k.Kid)
+		}
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal key %s", k.Kid)
+		}
+		pubKeys = append(pubKeys, string(pem.EncodeToMemory(&pem.Block{
+			Type:  "PUBLIC KEY",
+			Bytes: der,
+		})))
+	}
+	if len(pubKeys) == 0 {
+		return nil, errors.New("jwks doesn't contain any signing key")
+	}
+	return pubKeys, nil
+}
+
+func (k jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid modulus")
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid exponent")
+		}
+		if !e.IsInt64() || e.Int64() > int64(^uint32(0)>>1) {
+			return nil, errors.New("exponent is too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid x coordinate")
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid y coordinate")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("value is empty")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,201 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"testing"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKSToPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if !assert.Nil(t, err) {
+		return
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !assert.Nil(t, err) {
+		return
+	}
+	enc := base64.RawURLEncoding.EncodeToString
+
+	cases := []struct {
+		testName  string
+		keys      []jsonWebKey
+		expected  []interface{}
+		expectErr bool
+	}{
+		{
+			testName: "rsa and ec signing keys, expect no error",
+			keys: []jsonWebKey{
+				{
+					Kty: "RSA",
+					Use: "sig",
+					Kid: "rsa",
+					N:   enc(rsaKey.N.Bytes()),
+					E:   enc(big.NewInt(int64(rsaKey.E)).Bytes()),
+				},
+				{
+					Kty: "EC",
+					Kid: "ec",
+					Crv: "P-256",
+					X:   enc(ecKey.X.Bytes()),
+					Y:   enc(ecKey.Y.Bytes()),
+				},
+				{
+					Kty: "RSA",
+					Use: "enc",
+					Kid: "encryption key is skipped",
+				},
+			},
+			expected: []interface{}{&rsaKey.PublicKey, &ecKey.PublicKey},
+		},
+		{
+			testName: "unsupported key type, expect error",
+			keys: []jsonWebKey{
+				{
+					Kty: "oct",
+					Kid: "hmac",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			testName:  "no signing key, expect error",
+			keys:      []jsonWebKey{},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			data, err := json.Marshal(jsonWebKeySet{Keys: c.keys})
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			pubKeys, err := jwksToPEM(data)
+			if c.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			if !assert.Nil(t, err) || !assert.Len(t, pubKeys, len(c.expected)) {
+				return
+			}
+			for i, k := range pubKeys {
+				block, _ := pem.Decode([]byte(k))
+				if !assert.NotNil(t, block) {
+					return
+				}
+				pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+				if assert.Nil(t, err) {
+					assert.Equal(t, c.expected[i], pub)
+				}
+			}
+		})
+	}
+}
+
+func TestJWTAuthConfigPayload(t *testing.T) {
+	saIssuer := &serviceAccountIssuer{
+		issuer:  "https://kubernetes.default.svc",
+		pubKeys: []string{"pem"},
+	}
+
+	cases := []struct {
+		testName string
+		config   *api.JWTAuthConfig
+		saIssuer *serviceAccountIssuer
+		expected map[string]interface{}
+	}{
+		{
+			testName: "service account issuer",
+			config: &api.JWTAuthConfig{
+				DefaultRole: "demo",
+			},
+			saIssuer: saIssuer,
+			expected: map[string]interface{}{
+				"jwt_validation_pubkeys": []string{"pem"},
+				"bound_issuer":           "https://kubernetes.default.svc",
+				"default_role":           "demo",
+			},
+		},
+		{
+			testName: "service account issuer with bound issuer",
+			config: &api.JWTAuthConfig{
+				BoundIssuer: "https://oidc.example.com",
+			},
+			saIssuer: saIssuer,
+			expected: map[string]interface{}{
+				"jwt_validation_pubkeys": []string{"pem"},
+				"bound_issuer":           "https://oidc.example.com",
+			},
+		},
+		{
+			testName: "oidc discovery url",
+			config: &api.JWTAuthConfig{
+				OIDCDiscoveryURL:   "https://oidc.example.com",
+				OIDCDiscoveryCAPem: "ca",
+			},
+			expected: map[string]interface{}{
+				"oidc_discovery_url":    "https://oidc.example.com",
+				"oidc_discovery_ca_pem": "ca",
+			},
+		},
+		{
+			testName: "jwks url",
+			config: &api.JWTAuthConfig{
+				JWKSURL: "https://oidc.example.com/keys",
+			},
+			expected: map[string]interface{}{
+				"jwks_url": "https://oidc.example.com/keys",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			assert.Equal(t, c.expected, jwtAuthConfigPayload(c.config, c.saIssuer))
+		})
+	}
+}
+
+func TestServiceAccountIssuerAuthMethods(t *testing.T) {
+	auths := []api.AuthMethod{
+		{Type: authTypeJWT, Path: "jwt", JWTConfig: &api.JWTAuthConfig{}},
+		{Type: authTypeOIDC, Path: "oidc", JWTConfig: &api.JWTAuthConfig{OIDCDiscoveryURL: "https://oidc.example.com"}},
+		{Type: authTypeJWT, Path: "gitlab", JWTConfig: &api.JWTAuthConfig{JWKSURL: "https://gitlab.example.com/keys"}},
+		{Type: authTypeJWT, Path: "plain"},
+		{Type: "kubernetes", Path: "kubernetes", JWTConfig: &api.JWTAuthConfig{}},
+	}
+
+	methods := serviceAccountIssuerAuthMethods(auths)
+	if assert.Len(t, methods, 1) {
+		assert.Equal(t, "jwt", methods[0].Path)
+	}
+}
@@ -22,8 +22,8 @@ import (
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
 	"kubevault.dev/operator/pkg/vault/credential"
+	awscred "kubevault.dev/operator/pkg/vault/credential/aws"
 
-	"github.com/appscode/go/crypto/rand"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	core "k8s.io/api/core/v1"
@@ -105,12 +105,12 @@ func (c *VaultController) runAWSAccessKeyRequestInjector(key string) error {
 
 			if condType == api.AccessApproved {
 				awsCredManager, err := credential.NewCredentialManagerForAWS(c.kubeClient, c.appCatalogClient, c.extClient, awsAccessReq)
-				if err != nil {
-					return err
+				if err == nil {
+					err = c.reconcileAWSAccessKeyRequest(awsCredManager, awsAccessReq)
 				}
-
-				err = c.reconcileAWSAccessKeyRequest(awsCredManager, awsAccessReq)
 				if err != nil {
+					// retry to refresh the sts credential before it expires
+					requeueCredentialRefresh(c.awsAccessQueue, awsAccessReq.ObjectMeta, awsAccessReq.Status.ExpiresAt, true)
 					return errors.Wrapf(err, "For AWSAccessKeyRequest %s/%s", awsAccessReq.Namespace, awsAccessReq.Name)
 				}
 			} else if condType == api.AccessDenied {
@@ -127,11 +127,12 @@ func (c *VaultController) runAWSAccessKeyRequestInjector(key string) error {
 //	For vault:
 //	  - get aws credential
 //	  - create secret containing credential
+//	  - reissue the sts credential before it expires
 //	  - create rbac role and role binding
 //    - sync role binding
+//    - roll out the restart targets
 func (c *VaultController) reconcileAWSAccessKeyRequest(awsCM credential.CredentialManager, awsAccessReq *api.AWSAccessKeyRequest) error {
 	var (
-		name   = awsAccessReq.Name
 		ns     = awsAccessReq.Namespace
 		status = awsAccessReq.Status
 	)
@@ -143,9 +144,26 @@ func (c *VaultController) reconcileAWSAccessKeyRequest(awsCM credential.Credenti
 
 	// check whether lease id exists in .status.lease or not
 	// if does not exist in .status.lease, then get credential
-	if awsAccessReq.Status.Lease == nil {
+	if awsAccessReq.Status.Lease == nil && awsAccessReq.Status.Wrapping == nil {
+		var err error
+		secretName, err = c.getCredentialSecretName(awsAccessReq.Spec.SecretRef, awsAccessReq.ObjectMeta)
+		if err != nil {
+			status.Conditions = UpsertAWSAccessKeyCondition(status.Conditions, api.AWSAccessKeyRequestCondition{
+				Type:           AWSAccessKeyRequestFailed,
+				Reason:         "FailedToAdoptSecret",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateAWSAccessKeyRequestStatus(&status, awsAccessReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+
 		// get aws credential secret
-		credSecret, err := awsCM.GetCredential()
+		credSecret, err := getCredential(awsCM, awsAccessReq.Spec.Delivery, awsAccessReq.Spec.WrapTTL)
 		if err != nil {
 			status.Conditions = UpsertAWSAccessKeyCondition(status.Conditions, api.AWSAccessKeyRequestCondition{
 				Type:           AWSAccessKeyRequestFailed,
@@ -161,12 +179,11 @@ func (c *VaultController) reconcileAWSAccessKeyRequest(awsCM credential.Credenti
 			return errors.WithStack(err)
 		}
 
-		secretName = rand.WithUniqSuffix(name)
 		err = awsCM.CreateSecret(secretName, ns, credSecret)
 		if err != nil {
-			err2 := awsCM.RevokeLease(credSecret.LeaseID)
+			err2 := revokeCredential(awsCM, credSecret)
 			if err2 != nil {
-				return errors.Wrapf(err2, "failed to revoke lease")
+				return errors.Wrapf(err2, "failed to revoke credential")
 			}
 
 			status.Conditions = UpsertAWSAccessKeyCondition(status.Conditions, api.AWSAccessKeyRequestCondition{
@@ -183,7 +200,7 @@ func (c *VaultController) reconcileAWSAccessKeyRequest(awsCM credential.Credenti
 			return errors.WithStack(err)
 		}
 
-		// add lease info in status
+		// add lease info in status, the lease of a wrapped credential is empty, if it is not found
 		status.Lease = &api.Lease{
 			ID: credSecret.LeaseID,
 			Duration: metav1.Duration{
@@ -191,6 +208,12 @@ func (c *VaultController) reconcileAWSAccessKeyRequest(awsCM credential.Credenti
 			},
 			Renewable: credSecret.Renewable,
 		}
+		if credSecret.WrapInfo != nil {
+			// add wrapping token info in status
+			status.Wrapping = newWrappingStatus(credSecret)
+		} else {
+			status.ExpiresAt = awscred.STSExpiration(credSecret)
+		}
 
 		// assign secret name
 		status.Secret = &core.LocalObjectReference{
@@ -198,6 +221,36 @@ func (c *VaultController) reconcileAWSAccessKeyRequest(awsCM credential.Credenti
 		}
 	}
 
+	// reissue the sts credential in the secret before it expires
+	if status.Wrapping == nil && needsCredentialRefresh(status.ExpiresAt, time.Now()) {
+		credSecret, err := awsCM.GetCredential()
+		if err == nil {
+			err = awsCM.CreateSecret(secretName, ns, credSecret)
+		}
+		if err != nil {
+			status.Conditions = UpsertAWSAccessKeyCondition(status.Conditions, api.AWSAccessKeyRequestCondition{
+				Type:           AWSAccessKeyRequestFailed,
+				Reason:         "FailedToRefreshCredential",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateAWSAccessKeyRequestStatus(&status, awsAccessReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+		status.Lease = &api.Lease{
+			ID: credSecret.LeaseID,
+			Duration: metav1.Duration{
+				Duration: time.Second * time.Duration(credSecret.LeaseDuration),
+			},
+			Renewable: credSecret.Renewable,
+		}
+		status.ExpiresAt = awscred.STSExpiration(credSecret)
+	}
+
 	roleName := getSecretAccessRoleName(api.ResourceKindAWSAccessKeyRequest, ns, awsAccessReq.Name)
 
 	err := awsCM.CreateRole(roleName, ns, secretName)
@@ -232,11 +285,61 @@ func (c *VaultController) reconcileAWSAccessKeyRequest(awsCM credential.Credenti
 		return errors.WithStack(err)
 	}
 
+	// check whether the wrapping token is used as intended
+	if status.Wrapping != nil {
+		reason, msg, err := c.syncWrappingStatus(awsCM, ns, secretName, awsAccessReq.Spec.Subjects, status.Wrapping, status.Lease)
+		if err != nil {
+			status.Conditions = UpsertAWSAccessKeyCondition(status.Conditions, api.AWSAccessKeyRequestCondition{
+				Type:           AWSAccessKeyRequestFailed,
+				Reason:         "FailedToLookupWrappingToken",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateAWSAccessKeyRequestStatus(&status, awsAccessReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+		if reason != "" {
+			status.Conditions = UpsertAWSAccessKeyCondition(status.Conditions, api.AWSAccessKeyRequestCondition{
+				Type:           api.WrappingTokenAlert,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+		}
+	}
+
+	// roll out the workloads onto the credential, the hash of
+	// the credential changes only when it is issued or rotated
+	err = c.rolloutRestartTargets(ns, secretName, awsAccessReq.Spec.RestartTargets, false)
+	if err != nil {
+		status.Conditions = UpsertAWSAccessKeyCondition(status.Conditions, api.AWSAccessKeyRequestCondition{
+			Type:           AWSAccessKeyRequestFailed,
+			Reason:         "FailedToRolloutRestartTargets",
+			Message:        err.Error(),
+			LastUpdateTime: metav1.Now(),
+		})
+
+		err2 := c.updateAWSAccessKeyRequestStatus(&status, awsAccessReq)
+		if err2 != nil {
+			return errors.Wrapf(err2, "failed to update status")
+		}
+		return errors.WithStack(err)
+	}
+
 	status.Conditions = DeleteAWSAccessKeyCondition(status.Conditions, api.RequestConditionType(AWSAccessKeyRequestFailed))
 	err = c.updateAWSAccessKeyRequestStatus(&status, awsAccessReq)
 	if err != nil {
 		return errors.Wrap(err, "failed to update status")
 	}
+
+	// look up the wrapping token again, until it is unwrapped or expired
+	requeueWrappingLookup(c.awsAccessQueue, awsAccessReq.ObjectMeta, status.Wrapping)
+	// reissue the sts credential before it expires
+	requeueCredentialRefresh(c.awsAccessQueue, awsAccessReq.ObjectMeta, status.ExpiresAt, false)
 	return nil
 }
 
@@ -286,7 +389,7 @@ func (c *VaultController) runAWSAccessKeyRequestFinalizer(awsAKReq *api.AWSAcces
 			if err != nil {
 				glog.Errorf("AWSAccessKeyRequest %s/%s finalizer: %v", awsAKReq.Namespace, awsAKReq.Name, err)
 			} else {
-				err = c.finalizeAWSAccessKeyRequest(awsCM, awsAKReq.Status.Lease)
+				err = c.finalizeAWSAccessKeyRequest(awsCM, awsAKReq.Status.Lease, awsAKReq.Status.Wrapping)
 				if err != nil {
 					glog.Errorf("AWSAccessKeyRequest %s/%s finalizer: %v", awsAKReq.Namespace, awsAKReq.Name, err)
 				} else {
@@ -323,7 +426,11 @@ func (c *VaultController) runAWSAccessKeyRequestFinalizer(awsAKReq *api.AWSAcces
 	c.finalizerInfo.Delete(id)
 }
 
-func (c *VaultController) finalizeAWSAccessKeyRequest(awsCM credential.CredentialManager, lease *api.Lease) error {
+func (c *VaultController) finalizeAWSAccessKeyRequest(awsCM credential.CredentialManager, lease *api.Lease, wrapping *api.WrappingStatus) error {
+	err := finalizeWrappedCredential(awsCM, wrapping)
+	if err != nil {
+		return err
+	}
 	if lease == nil {
 		return nil
 	}
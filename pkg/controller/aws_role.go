@@ -152,6 +152,7 @@ func (c *VaultController) runAWSRoleFinalizer(awsRole *api.AWSRole, timeout time
 
 	stopCh := time.After(timeout)
 	finalizationDone := false
+	blocked := false
 	timeOutOccured := false
 	attempt := 0
 
@@ -174,6 +175,7 @@ func (c *VaultController) runAWSRoleFinalizer(awsRole *api.AWSRole, timeout time
 				glog.Errorf("AWSRole %s/%s finalizer: %v", awsRole.Namespace, awsRole.Name, err)
 			} else {
 				err = c.finalizeAWSRole(d, awsRole)
+				blocked = errors.Cause(err) == errDeletionBlocked
 				if err != nil {
 					glog.Errorf("AWSRole %s/%s finalizer: %v", awsRole.Namespace, awsRole.Name, err)
 				} else {
@@ -199,6 +201,14 @@ func (c *VaultController) runAWSRoleFinalizer(awsRole *api.AWSRole, timeout time
 		attempt++
 	}
 
+	if blocked {
+		// keep the finalizer, as the deletion is blocked by live leases
+		glog.Infof("AWSRole %s/%s finalizer: deletion is blocked by live leases, retrying after %v", awsRole.Namespace, awsRole.Name, blockedDeletionInterval)
+		c.finalizerInfo.Delete(id)
+		requeueBlockedDeletion(c.awsRoleQueue, awsRole.ObjectMeta)
+		return
+	}
+
 	err := c.removeAWSRoleFinalizer(awsRole)
 	if err != nil {
 		glog.Errorf("AWSRole %s/%s finalizer: removing finalizer %v", awsRole.Namespace, awsRole.Name, err)
@@ -211,9 +221,15 @@ func (c *VaultController) runAWSRoleFinalizer(awsRole *api.AWSRole, timeout time
 }
 
 // Do:
+//	- apply the revocation policy to the leases issued against the role
 //	- delete role in vault
 func (c *VaultController) finalizeAWSRole(awsRClient aws.AWSRoleInterface, awsRole *api.AWSRole) error {
-	err := awsRClient.DeleteRole(awsRole.RoleName())
+	err := c.revokeRoleLeases(awsRClient, awsRole.Spec.RevocationPolicy, api.ResourceKindAWSRole, awsRole.ObjectMeta, awsRole.RoleName())
+	if err != nil {
+		return err
+	}
+
+	err = awsRClient.DeleteRole(awsRole.RoleName())
 	if err != nil {
 		return errors.Wrap(err, "failed to delete aws role")
 	}
@@ -23,7 +23,6 @@ import (
 	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
 	"kubevault.dev/operator/pkg/vault/credential"
 
-	"github.com/appscode/go/crypto/rand"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	core "k8s.io/api/core/v1"
@@ -129,9 +128,9 @@ func (c *VaultController) runAzureAccessKeyRequestInjector(key string) error {
 //	  - create secret containing credential
 //	  - create rbac role and role binding
 //    - sync role binding
+//    - roll out the restart targets
 func (c *VaultController) reconcileAzureAccessKeyRequest(azureCM credential.CredentialManager, azureAccessKeyReq *api.AzureAccessKeyRequest) error {
 	var (
-		name   = azureAccessKeyReq.Name
 		ns     = azureAccessKeyReq.Namespace
 		status = azureAccessKeyReq.Status
 	)
@@ -143,9 +142,26 @@ func (c *VaultController) reconcileAzureAccessKeyRequest(azureCM credential.Cred
 
 	// check whether lease id exists in .status.lease or not
 	// if does not exist in .status.lease, then get credential
-	if azureAccessKeyReq.Status.Lease == nil {
+	if azureAccessKeyReq.Status.Lease == nil && azureAccessKeyReq.Status.Wrapping == nil {
+		var err error
+		secretName, err = c.getCredentialSecretName(azureAccessKeyReq.Spec.SecretRef, azureAccessKeyReq.ObjectMeta)
+		if err != nil {
+			status.Conditions = UpsertAzureAccessKeyCondition(status.Conditions, api.AzureAccessKeyRequestCondition{
+				Type:           AzureAccessKeyRequestFailed,
+				Reason:         "FailedToAdoptSecret",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateAzureAccessKeyRequestStatus(&status, azureAccessKeyReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+
 		// get azure credential secret
-		credSecret, err := azureCM.GetCredential()
+		credSecret, err := getCredential(azureCM, azureAccessKeyReq.Spec.Delivery, azureAccessKeyReq.Spec.WrapTTL)
 		if err != nil {
 			status.Conditions = UpsertAzureAccessKeyCondition(status.Conditions, api.AzureAccessKeyRequestCondition{
 				Type:           AzureAccessKeyRequestFailed,
@@ -161,14 +177,11 @@ func (c *VaultController) reconcileAzureAccessKeyRequest(azureCM credential.Cred
 			return errors.WithStack(err)
 		}
 
-		secretName = rand.WithUniqSuffix(name)
 		err = azureCM.CreateSecret(secretName, ns, credSecret)
 		if err != nil {
-			if len(credSecret.LeaseID) != 0 {
-				err2 := azureCM.RevokeLease(credSecret.LeaseID)
-				if err2 != nil {
-					return errors.Wrapf(err, "failed to revoke lease with %v", err2)
-				}
+			err2 := revokeCredential(azureCM, credSecret)
+			if err2 != nil {
+				return errors.Wrapf(err, "failed to revoke credential with %v", err2)
 			}
 			status.Conditions = UpsertAzureAccessKeyCondition(status.Conditions, api.AzureAccessKeyRequestCondition{
 				Type:           AzureAccessKeyRequestFailed,
@@ -177,7 +190,7 @@ func (c *VaultController) reconcileAzureAccessKeyRequest(azureCM credential.Cred
 				LastUpdateTime: metav1.Now(),
 			})
 
-			err2 := c.updateAzureAccessKeyRequestStatus(&status, azureAccessKeyReq)
+			err2 = c.updateAzureAccessKeyRequestStatus(&status, azureAccessKeyReq)
 			if err2 != nil {
 				return errors.Wrapf(err, "failed to update status with %v", err2)
 			}
@@ -185,7 +198,7 @@ func (c *VaultController) reconcileAzureAccessKeyRequest(azureCM credential.Cred
 			return errors.WithStack(err)
 		}
 
-		// add lease info in status
+		// add lease info in status, the lease of a wrapped credential is empty, if it is not found
 		status.Lease = &api.Lease{
 			ID: credSecret.LeaseID,
 			Duration: metav1.Duration{
@@ -193,6 +206,10 @@ func (c *VaultController) reconcileAzureAccessKeyRequest(azureCM credential.Cred
 			},
 			Renewable: credSecret.Renewable,
 		}
+		if credSecret.WrapInfo != nil {
+			// add wrapping token info in status
+			status.Wrapping = newWrappingStatus(credSecret)
+		}
 
 		// assign secret name
 		status.Secret = &core.LocalObjectReference{
@@ -234,11 +251,59 @@ func (c *VaultController) reconcileAzureAccessKeyRequest(azureCM credential.Cred
 		return errors.WithStack(err)
 	}
 
+	// check whether the wrapping token is used as intended
+	if status.Wrapping != nil {
+		reason, msg, err := c.syncWrappingStatus(azureCM, ns, secretName, azureAccessKeyReq.Spec.Subjects, status.Wrapping, status.Lease)
+		if err != nil {
+			status.Conditions = UpsertAzureAccessKeyCondition(status.Conditions, api.AzureAccessKeyRequestCondition{
+				Type:           AzureAccessKeyRequestFailed,
+				Reason:         "FailedToLookupWrappingToken",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateAzureAccessKeyRequestStatus(&status, azureAccessKeyReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+		if reason != "" {
+			status.Conditions = UpsertAzureAccessKeyCondition(status.Conditions, api.AzureAccessKeyRequestCondition{
+				Type:           api.WrappingTokenAlert,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+		}
+	}
+
+	// roll out the workloads onto the credential, the hash of
+	// the credential changes only when it is issued or rotated
+	err = c.rolloutRestartTargets(ns, secretName, azureAccessKeyReq.Spec.RestartTargets, false)
+	if err != nil {
+		status.Conditions = UpsertAzureAccessKeyCondition(status.Conditions, api.AzureAccessKeyRequestCondition{
+			Type:           AzureAccessKeyRequestFailed,
+			Reason:         "FailedToRolloutRestartTargets",
+			Message:        err.Error(),
+			LastUpdateTime: metav1.Now(),
+		})
+
+		err2 := c.updateAzureAccessKeyRequestStatus(&status, azureAccessKeyReq)
+		if err2 != nil {
+			return errors.Wrapf(err2, "failed to update status")
+		}
+		return errors.WithStack(err)
+	}
+
 	status.Conditions = DeleteAzureAccessKeyCondition(status.Conditions, api.RequestConditionType(AzureAccessKeyRequestFailed))
 	err = c.updateAzureAccessKeyRequestStatus(&status, azureAccessKeyReq)
 	if err != nil {
 		return errors.Wrap(err, "failed to update status")
 	}
+
+	// look up the wrapping token again, until it is unwrapped or expired
+	requeueWrappingLookup(c.azureAccessQueue, azureAccessKeyReq.ObjectMeta, status.Wrapping)
 	return nil
 }
 
@@ -288,7 +353,7 @@ func (c *VaultController) runAzureAccessKeyRequestFinalizer(azureAKReq *api.Azur
 			if err != nil {
 				glog.Errorf("AzureAccessKeyRequest %s/%s finalizer: %v", azureAKReq.Namespace, azureAKReq.Name, err)
 			} else {
-				err = c.finalizeAzureAccessKeyRequest(azureCM, azureAKReq.Status.Lease)
+				err = c.finalizeAzureAccessKeyRequest(azureCM, azureAKReq.Status.Lease, azureAKReq.Status.Wrapping)
 				if err != nil {
 					glog.Errorf("AzureAccessKeyRequest %s/%s finalizer: %v", azureAKReq.Namespace, azureAKReq.Name, err)
 				} else {
@@ -325,7 +390,11 @@ func (c *VaultController) runAzureAccessKeyRequestFinalizer(azureAKReq *api.Azur
 	c.finalizerInfo.Delete(id)
 }
 
-func (c *VaultController) finalizeAzureAccessKeyRequest(azureCM credential.CredentialManager, lease *api.Lease) error {
+func (c *VaultController) finalizeAzureAccessKeyRequest(azureCM credential.CredentialManager, lease *api.Lease, wrapping *api.WrappingStatus) error {
+	err := finalizeWrappedCredential(azureCM, wrapping)
+	if err != nil {
+		return err
+	}
 	if lease == nil {
 		return nil
 	}
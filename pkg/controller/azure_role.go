@@ -97,6 +97,20 @@ func (c *VaultController) runAzureRoleInjector(key string) error {
 func (c *VaultController) reconcileAzureRole(azureRClient azure.AzureRoleInterface, azureRole *api.AzureRole) error {
 	status := azureRole.Status
 
+	if err := azureRole.IsValid(); err != nil {
+		status.Conditions = []api.AzureRoleCondition{
+			{
+				Type:    AzureRoleConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  "InvalidRole",
+				Message: err.Error(),
+			},
+		}
+		status.ObservedGeneration = azureRole.Generation
+		// invalid spec is not retried
+		return c.updatedAzureRoleStatus(&status, azureRole)
+	}
+
 	// create role
 	err := azureRClient.CreateRole()
 	if err != nil {
@@ -153,6 +167,7 @@ func (c *VaultController) runAzureRoleFinalizer(azureRole *api.AzureRole, timeou
 
 	stopCh := time.After(timeout)
 	finalizationDone := false
+	blocked := false
 	timeOutOccured := false
 	attempt := 0
 
@@ -175,6 +190,7 @@ func (c *VaultController) runAzureRoleFinalizer(azureRole *api.AzureRole, timeou
 				glog.Errorf("AzureRole %s/%s finalizer: %v", azureRole.Namespace, azureRole.Name, err)
 			} else {
 				err = c.finalizeAzureRole(d, azureRole)
+				blocked = errors.Cause(err) == errDeletionBlocked
 				if err != nil {
 					glog.Errorf("AzureRole %s/%s finalizer: %v", azureRole.Namespace, azureRole.Name, err)
 				} else {
@@ -200,6 +216,14 @@ func (c *VaultController) runAzureRoleFinalizer(azureRole *api.AzureRole, timeou
 		attempt++
 	}
 
+	if blocked {
+		// keep the finalizer, as the deletion is blocked by live leases
+		glog.Infof("AzureRole %s/%s finalizer: deletion is blocked by live leases, retrying after %v", azureRole.Namespace, azureRole.Name, blockedDeletionInterval)
+		c.finalizerInfo.Delete(id)
+		requeueBlockedDeletion(c.azureRoleQueue, azureRole.ObjectMeta)
+		return
+	}
+
 	err := c.removeAzureRoleFinalizer(azureRole)
 	if err != nil {
 		glog.Errorf("AzureRole %s/%s finalizer: removing finalizer %v", azureRole.Namespace, azureRole.Name, err)
@@ -212,9 +236,15 @@ func (c *VaultController) runAzureRoleFinalizer(azureRole *api.AzureRole, timeou
 }
 
 // Do:
+//	- apply the revocation policy to the leases issued against the role
 //	- delete role in vault
 func (c *VaultController) finalizeAzureRole(azureRClient azure.AzureRoleInterface, azureRole *api.AzureRole) error {
-	err := azureRClient.DeleteRole(azureRole.RoleName())
+	err := c.revokeRoleLeases(azureRClient, azureRole.Spec.RevocationPolicy, api.ResourceKindAzureRole, azureRole.ObjectMeta, azureRole.RoleName())
+	if err != nil {
+		return err
+	}
+
+	err = azureRClient.DeleteRole(azureRole.RoleName())
 	if err != nil {
 		return errors.Wrap(err, "failed to delete azure role")
 	}
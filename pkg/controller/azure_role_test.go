@@ -65,6 +65,14 @@ func (f *fakeAzureRole) DeleteRole(name string) error {
 	return nil
 }
 
+func (f *fakeAzureRole) HasLeases(name string) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeAzureRole) RevokeLeases(name string) error {
+	return nil
+}
+
 func TestAzureRole_reconcileAzureRole(t *testing.T) {
 
 	aRole := &api.AzureRole{
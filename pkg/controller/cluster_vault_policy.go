@@ -0,0 +1,339 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	policyapi "kubevault.dev/operator/apis/policy/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/policy/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault/policy"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	core_util "kmodules.xyz/client-go/core/v1"
+	meta_util "kmodules.xyz/client-go/meta"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+// emptyAggregatedPolicy is set in vault when no rule is aggregated, as vault doesn't accept empty policy
+const emptyAggregatedPolicy = "# no ClusterVaultPolicy is aggregated"
+
+func (c *VaultController) initClusterVaultPolicyWatcher() {
+	c.cvPlcyInformer = c.extInformerFactory.Policy().V1alpha1().ClusterVaultPolicies().Informer()
+	c.cvPlcyQueue = queue.New(policyapi.ResourceKindClusterVaultPolicy, c.MaxNumRequeues, c.NumThreads, c.runClusterVaultPolicyInjector)
+	c.cvPlcyInformer.AddEventHandler(queue.NewReconcilableHandler(c.cvPlcyQueue.GetQueue()))
+	// recompute the aggregated policies when a member policy changes
+	c.cvPlcyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if p, ok := obj.(*policyapi.ClusterVaultPolicy); ok {
+				c.enqueueAggregatingClusterVaultPolicies(p.Labels)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldP, ok1 := oldObj.(*policyapi.ClusterVaultPolicy)
+			newP, ok2 := newObj.(*policyapi.ClusterVaultPolicy)
+			if !ok1 || !ok2 {
+				return
+			}
+			if oldP.Generation != newP.Generation || !meta_util.Equal(oldP.Labels, newP.Labels) {
+				c.enqueueAggregatingClusterVaultPolicies(oldP.Labels)
+				c.enqueueAggregatingClusterVaultPolicies(newP.Labels)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if p, ok := obj.(*policyapi.ClusterVaultPolicy); ok {
+				c.enqueueAggregatingClusterVaultPolicies(p.Labels)
+			}
+		},
+	})
+	c.cvPlcyLister = c.extInformerFactory.Policy().V1alpha1().ClusterVaultPolicies().Lister()
+}
+
+// enqueueAggregatingClusterVaultPolicies enqueues the ClusterVaultPolicies whose
+// aggregationSelector matches the labels of a member policy
+func (c *VaultController) enqueueAggregatingClusterVaultPolicies(lbl map[string]string) {
+	policies, err := c.cvPlcyLister.List(labels.Everything())
+	if err != nil {
+		glog.Errorf("failed to list ClusterVaultPolicies: %v", err)
+		return
+	}
+	for _, p := range policies {
+		if p.Spec.AggregationSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(p.Spec.AggregationSelector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(lbl)) {
+			queue.Enqueue(c.cvPlcyQueue.GetQueue(), p)
+		}
+	}
+}
+
+// runClusterVaultPolicyInjector gets the cluster vault policy object indexed by the key from cache
+// and initializes, reconciles or garbage collects the vault policy as needed.
+func (c *VaultController) runClusterVaultPolicyInjector(key string) error {
+	obj, exists, err := c.cvPlcyInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		glog.Warningf("ClusterVaultPolicy %s does not exist anymore\n", key)
+	} else {
+		cvPolicy := obj.(*policyapi.ClusterVaultPolicy).DeepCopy()
+		glog.Infof("Sync/Add/Update for ClusterVaultPolicy %s\n", cvPolicy.Name)
+
+		if cvPolicy.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(cvPolicy.ObjectMeta, VaultPolicyFinalizer) {
+				// Finalize ClusterVaultPolicy
+				go c.runClusterPolicyFinalizer(cvPolicy, timeoutForFinalizer, timeIntervalForFinalizer)
+			} else {
+				glog.Infof("Finalizer not found for ClusterVaultPolicy %s", cvPolicy.Name)
+			}
+		} else {
+			if !core_util.HasFinalizer(cvPolicy.ObjectMeta, VaultPolicyFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchClusterVaultPolicy(c.extClient.PolicyV1alpha1(), cvPolicy, func(in *policyapi.ClusterVaultPolicy) *policyapi.ClusterVaultPolicy {
+					in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+					return in
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set ClusterVaultPolicy finalizer for %s", cvPolicy.Name)
+				}
+			}
+
+			pClient, err := policy.NewPolicyClient(c.kubeClient, c.appCatalogClient, &cvPolicy.Spec.VaultRef)
+			if err != nil {
+				return errors.Wrapf(err, "for ClusterVaultPolicy %s", cvPolicy.Name)
+			}
+
+			err = c.reconcileClusterPolicy(cvPolicy, pClient)
+			if err != nil {
+				return errors.Wrapf(err, "for ClusterVaultPolicy %s", cvPolicy.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileClusterPolicy aggregates the rules of the cluster policy and
+// creates or updates the policy in vault
+func (c *VaultController) reconcileClusterPolicy(cvPolicy *policyapi.ClusterVaultPolicy, pClient policy.Policy) error {
+	status := cvPolicy.Status
+
+	var members []*policyapi.ClusterVaultPolicy
+	if cvPolicy.Spec.AggregationSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(cvPolicy.Spec.AggregationSelector)
+		if err != nil {
+			return c.failClusterPolicy(cvPolicy, status, "FailedToAggregatePolicy", errors.Wrap(err, "invalid aggregationSelector"))
+		}
+		members, err = c.cvPlcyLister.List(selector)
+		if err != nil {
+			return c.failClusterPolicy(cvPolicy, status, "FailedToAggregatePolicy", err)
+		}
+	}
+
+	doc, aggregated, err := aggregateClusterPolicy(cvPolicy, members)
+	if err != nil {
+		return c.failClusterPolicy(cvPolicy, status, "FailedToAggregatePolicy", err)
+	}
+	status.AggregatedPolicies = aggregated
+
+	// create or update policy
+	// its safe to call multiple times
+	if err := pClient.EnsurePolicy(cvPolicy.PolicyName(), doc); err != nil {
+		return c.failClusterPolicy(cvPolicy, status, "FailedToPutPolicy", err)
+	}
+
+	// update status
+	status.ObservedGeneration = cvPolicy.Generation
+	status.Conditions = []policyapi.PolicyCondition{}
+	status.Phase = policyapi.PolicySuccess
+	err = c.updateClusterPolicyStatus(&status, cvPolicy)
+	if err != nil {
+		return errors.Wrap(err, "failed to update ClusterVaultPolicy status")
+	}
+	return nil
+}
+
+// aggregateClusterPolicy returns the union of the rules of the cluster policy and the member policies
+// of the same vault server, along with the names of the aggregated member policies.
+func aggregateClusterPolicy(cvPolicy *policyapi.ClusterVaultPolicy, members []*policyapi.ClusterVaultPolicy) (string, []string, error) {
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	doc, err := clusterPolicyRules(cvPolicy)
+	if err != nil {
+		return "", nil, err
+	}
+	docs := []string{doc}
+	var aggregated []string
+	for _, m := range members {
+		if m.Name == cvPolicy.Name || m.DeletionTimestamp != nil {
+			continue
+		}
+		// the rules of a policy for another vault server are not aggregated
+		if m.Spec.VaultRef.Namespace != cvPolicy.Spec.VaultRef.Namespace || m.Spec.VaultRef.Name != cvPolicy.Spec.VaultRef.Name {
+			continue
+		}
+		doc, err := clusterPolicyRules(m)
+		if err != nil {
+			return "", nil, err
+		}
+		docs = append(docs, doc)
+		aggregated = append(aggregated, m.Name)
+	}
+
+	doc, err = policy.AggregatePolicies(docs...)
+	if err != nil {
+		return "", nil, err
+	}
+	if doc == "" {
+		doc = emptyAggregatedPolicy
+	}
+	return doc, aggregated, nil
+}
+
+// clusterPolicyRules returns the policy of the ClusterVaultPolicy, without the aggregated rules
+func clusterPolicyRules(cvPolicy *policyapi.ClusterVaultPolicy) (string, error) {
+	if cvPolicy.Spec.PolicyDocument == "" && cvPolicy.Spec.Policy != nil {
+		data, err := json.Marshal(cvPolicy.Spec.Policy)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to serialize ClusterVaultPolicy %s", cvPolicy.Name)
+		}
+		return string(data), nil
+	}
+	return cvPolicy.Spec.PolicyDocument, nil
+}
+
+// failClusterPolicy sets the failure condition in the status of ClusterVaultPolicy
+// and returns the original error
+func (c *VaultController) failClusterPolicy(cvPolicy *policyapi.ClusterVaultPolicy, status policyapi.ClusterVaultPolicyStatus, reason string, err error) error {
+	status.Phase = policyapi.PolicyFailed
+	status.Conditions = []policyapi.PolicyCondition{
+		{
+			Type:    policyapi.PolicyConditionFailure,
+			Status:  core.ConditionTrue,
+			Reason:  reason,
+			Message: err.Error(),
+		},
+	}
+
+	err2 := c.updateClusterPolicyStatus(&status, cvPolicy)
+	if err2 != nil {
+		return errors.Wrap(err2, "failed to update ClusterVaultPolicy status")
+	}
+	return err
+}
+
+// updateClusterPolicyStatus updates cluster policy status
+func (c *VaultController) updateClusterPolicyStatus(status *policyapi.ClusterVaultPolicyStatus, cvPolicy *policyapi.ClusterVaultPolicy) error {
+	_, err := patchutil.UpdateClusterVaultPolicyStatus(c.extClient.PolicyV1alpha1(), cvPolicy, func(s *policyapi.ClusterVaultPolicyStatus) *policyapi.ClusterVaultPolicyStatus {
+		return status
+	})
+	return err
+}
+
+// runClusterPolicyFinalizer wil periodically run the finalizeClusterPolicy until finalizeClusterPolicy func produces no error or timeout occurs.
+// After that it will remove the finalizer string from the objectMeta of ClusterVaultPolicy
+func (c *VaultController) runClusterPolicyFinalizer(cvPolicy *policyapi.ClusterVaultPolicy, timeout time.Duration, interval time.Duration) {
+	if cvPolicy == nil {
+		glog.Infoln("ClusterVaultPolicy in nil")
+		return
+	}
+
+	key := cvPolicy.GetKey()
+	if c.finalizerInfo.IsAlreadyProcessing(key) {
+		// already processing it
+		return
+	}
+
+	glog.Infof("Processing finalizer for ClusterVaultPolicy %s", cvPolicy.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this ClusterVaultPolicy
+	c.finalizerInfo.Add(key)
+	stopCh := time.After(timeout)
+	timeOutOccured := false
+	for {
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		// finalize policy
+		if err := c.finalizeClusterPolicy(cvPolicy); err == nil {
+			glog.Infof("For ClusterVaultPolicy %s: successfully removed policy from vault", cvPolicy.Name)
+			break
+		} else {
+			glog.Infof("For ClusterVaultPolicy %s: %v", cvPolicy.Name, err)
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+	}
+
+	// Remove finalizer
+	_, err := patchutil.TryPatchClusterVaultPolicy(c.extClient.PolicyV1alpha1(), cvPolicy, func(in *policyapi.ClusterVaultPolicy) *policyapi.ClusterVaultPolicy {
+		in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+		return in
+	})
+	if err != nil {
+		glog.Errorf("For ClusterVaultPolicy %s: %v", cvPolicy.Name, err)
+	} else {
+		glog.Infof("For ClusterVaultPolicy %s: removed finalizer '%s'", cvPolicy.Name, VaultPolicyFinalizer)
+	}
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(key)
+	glog.Infof("Removed finalizer for ClusterVaultPolicy %s", cvPolicy.Name)
+}
+
+// finalizeClusterPolicy will delete the policy in vault
+func (c *VaultController) finalizeClusterPolicy(cvPolicy *policyapi.ClusterVaultPolicy) error {
+	out, err := c.extClient.PolicyV1alpha1().ClusterVaultPolicies().Get(cvPolicy.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	pClient, err := policy.NewPolicyClient(c.kubeClient, c.appCatalogClient, &out.Spec.VaultRef)
+	if err != nil {
+		return err
+	}
+	return pClient.DeletePolicy(cvPolicy.PolicyName())
+}
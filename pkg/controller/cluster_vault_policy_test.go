@@ -0,0 +1,118 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	policyapi "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+func clusterVaultPolicy(name, doc string) *policyapi.ClusterVaultPolicy {
+	return &policyapi.ClusterVaultPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: policyapi.ClusterVaultPolicySpec{
+			PolicyDocument: doc,
+		},
+	}
+}
+
+func TestAggregateClusterPolicy(t *testing.T) {
+	jsonPolicy := clusterVaultPolicy("json", "")
+	jsonPolicy.Spec.Policy = &runtime.RawExtension{
+		Raw: []byte(`{"path":{"kv/*":{"capabilities":["list"]}}}`),
+	}
+
+	otherVaultPolicy := clusterVaultPolicy("other", `path "auth/*" { capabilities = ["sudo"] }`)
+	otherVaultPolicy.Spec.VaultRef = appcat.AppReference{
+		Namespace: "demo",
+		Name:      "other-vault",
+	}
+
+	cases := []struct {
+		testName           string
+		policy             *policyapi.ClusterVaultPolicy
+		members            []*policyapi.ClusterVaultPolicy
+		expectedDoc        string
+		expectedAggregated []string
+		expectErr          bool
+	}{
+		{
+			testName:    "no aggregation, expect no error",
+			policy:      clusterVaultPolicy("baseline", `path "secret/*" { capabilities = ["read"] }`),
+			expectedDoc: `path "secret/*" { capabilities = ["read"] }`,
+		},
+		{
+			testName: "aggregate members sorted by name, expect no error",
+			policy:   clusterVaultPolicy("baseline", `path "secret/*" { capabilities = ["read"] }`),
+			members: []*policyapi.ClusterVaultPolicy{
+				jsonPolicy,
+				clusterVaultPolicy("baseline", `path "secret/*" { capabilities = ["read"] }`),
+				clusterVaultPolicy("auth", `path "auth/token/lookup-self" { capabilities = ["read"] }`),
+			},
+			expectedDoc: `path "secret/*" { capabilities = ["read"] }
+
+path "auth/token/lookup-self" { capabilities = ["read"] }
+
+path "kv/*" {
+  capabilities = ["list"]
+}`,
+			expectedAggregated: []string{"auth", "json"},
+		},
+		{
+			testName: "member of another vault server, expect no error",
+			policy:   clusterVaultPolicy("baseline", `path "secret/*" { capabilities = ["read"] }`),
+			members: []*policyapi.ClusterVaultPolicy{
+				otherVaultPolicy,
+			},
+			expectedDoc: `path "secret/*" { capabilities = ["read"] }`,
+		},
+		{
+			testName:    "no rule aggregated, expect no error",
+			policy:      clusterVaultPolicy("baseline", ""),
+			expectedDoc: emptyAggregatedPolicy,
+		},
+		{
+			testName: "malformed member policy, expect error",
+			policy:   clusterVaultPolicy("baseline", ""),
+			members: []*policyapi.ClusterVaultPolicy{
+				clusterVaultPolicy("auth", `path "auth/*" {`),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			doc, aggregated, err := aggregateClusterPolicy(c.policy, c.members)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				if assert.Nil(t, err) {
+					assert.Equal(t, c.expectedDoc, doc)
+					assert.Equal(t, c.expectedAggregated, aggregated)
+				}
+			}
+		})
+	}
+}
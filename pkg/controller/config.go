@@ -26,6 +26,7 @@ import (
 	pcm "github.com/coreos/prometheus-operator/pkg/client/versioned/typed/monitoring/v1"
 	core "k8s.io/api/core/v1"
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -47,6 +48,8 @@ type config struct {
 	ResyncPeriod            time.Duration
 	EnableValidatingWebhook bool
 	EnableMutatingWebhook   bool
+	PolicyDenylist          []string
+	AWSCredentialsAudience  string
 }
 
 type Config struct {
@@ -59,6 +62,7 @@ type Config struct {
 	AppCatalogClient appcat_cs.AppcatalogV1alpha1Interface
 	PromClient       pcm.MonitoringV1Interface
 	DbClient         db_cs.Interface
+	DynamicClient    dynamic.Interface
 }
 
 func NewConfig(clientConfig *rest.Config) *Config {
@@ -83,6 +87,7 @@ func (c *Config) New() (*VaultController, error) {
 		crdClient:        c.CRDClient,
 		promClient:       c.PromClient,
 		appCatalogClient: c.AppCatalogClient,
+		dynamicClient:    c.DynamicClient,
 		kubeInformerFactory: informers.NewSharedInformerFactoryWithOptions(
 			c.KubeClient,
 			c.ResyncPeriod,
@@ -111,6 +116,13 @@ func (c *Config) New() (*VaultController, error) {
 	ctrl.initVaultPolicyWatcher()
 	// For VaultPolicyBinding
 	ctrl.initVaultPolicyBindingWatcher()
+	// For VaultPolicyTemplate
+	ctrl.initVaultPolicyTemplateWatcher()
+	// For ClusterVaultPolicy
+	ctrl.initClusterVaultPolicyWatcher()
+	// For VaultIdentityEntity and VaultIdentityGroup
+	ctrl.initVaultIdentityEntityWatcher()
+	ctrl.initVaultIdentityGroupWatcher()
 
 	// For DB manager
 	ctrl.initPostgresRoleWatcher()
@@ -130,6 +142,20 @@ func (c *Config) New() (*VaultController, error) {
 	ctrl.initAzureRoleWatcher()
 	ctrl.initAzureAccessKeyWatcher()
 
+	// For KubernetesAuthCluster
+	ctrl.initKubernetesAuthClusterWatcher()
+
+	// For VaultAppRole
+	ctrl.initVaultAppRoleWatcher()
+	ctrl.initAppRoleSecretIDRequestWatcher()
+
+	// For VaultTokenRole
+	ctrl.initVaultTokenRoleWatcher()
+	ctrl.initVaultTokenRequestWatcher()
+
+	// For LeaseRevocation
+	ctrl.initLeaseRevocationWatcher()
+
 	// For secretEngine
 	ctrl.initSecretEngineWatcher()
 
@@ -33,8 +33,10 @@ import (
 	crd_api "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	core_listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -57,6 +59,7 @@ type VaultController struct {
 	extClient        cs.Interface
 	appCatalogClient appcat_cs.AppcatalogV1alpha1Interface
 	crdClient        crd_cs.ApiextensionsV1beta1Interface
+	dynamicClient    dynamic.Interface
 	recorder         record.EventRecorder
 	// Prometheus client
 	promClient pcm.MonitoringV1Interface
@@ -79,6 +82,27 @@ type VaultController struct {
 	vplcyBindingInformer cache.SharedIndexInformer
 	vplcyBindingLister   policy_listers.VaultPolicyBindingLister
 
+	// for VaultPolicyTemplate
+	vplcyTemplateQueue    *queue.Worker
+	vplcyTemplateInformer cache.SharedIndexInformer
+	vplcyTemplateLister   policy_listers.VaultPolicyTemplateLister
+	nsLister              core_listers.NamespaceLister
+
+	// for ClusterVaultPolicy
+	cvPlcyQueue    *queue.Worker
+	cvPlcyInformer cache.SharedIndexInformer
+	cvPlcyLister   policy_listers.ClusterVaultPolicyLister
+
+	// for VaultIdentityEntity
+	identityEntityQueue    *queue.Worker
+	identityEntityInformer cache.SharedIndexInformer
+	identityEntityLister   policy_listers.VaultIdentityEntityLister
+
+	// for VaultIdentityGroup
+	identityGroupQueue    *queue.Worker
+	identityGroupInformer cache.SharedIndexInformer
+	identityGroupLister   policy_listers.VaultIdentityGroupLister
+
 	// PostgresRole
 	pgRoleQueue    *queue.Worker
 	pgRoleInformer cache.SharedIndexInformer
@@ -129,6 +153,36 @@ type VaultController struct {
 	azureAccessInformer cache.SharedIndexInformer
 	azureAccessLister   engine_listers.AzureAccessKeyRequestLister
 
+	// KubernetesAuthCluster
+	kacQueue    *queue.Worker
+	kacInformer cache.SharedIndexInformer
+	kacLister   vault_listers.KubernetesAuthClusterLister
+
+	// VaultAppRole
+	appRoleQueue    *queue.Worker
+	appRoleInformer cache.SharedIndexInformer
+	appRoleLister   engine_listers.VaultAppRoleLister
+
+	// AppRoleSecretIDRequest
+	appRoleSecretIDQueue    *queue.Worker
+	appRoleSecretIDInformer cache.SharedIndexInformer
+	appRoleSecretIDLister   engine_listers.AppRoleSecretIDRequestLister
+
+	// VaultTokenRole
+	tokenRoleQueue    *queue.Worker
+	tokenRoleInformer cache.SharedIndexInformer
+	tokenRoleLister   engine_listers.VaultTokenRoleLister
+
+	// VaultTokenRequest
+	tokenRequestQueue    *queue.Worker
+	tokenRequestInformer cache.SharedIndexInformer
+	tokenRequestLister   engine_listers.VaultTokenRequestLister
+
+	// LeaseRevocation
+	leaseRevocationQueue    *queue.Worker
+	leaseRevocationInformer cache.SharedIndexInformer
+	leaseRevocationLister   engine_listers.LeaseRevocationLister
+
 	// SecretEngine
 	secretEngineQueue    *queue.Worker
 	secretEngineInformer cache.SharedIndexInformer
@@ -145,9 +199,14 @@ type VaultController struct {
 func (c *VaultController) ensureCustomResourceDefinitions() error {
 	crds := []*crd_api.CustomResourceDefinition{
 		vaultapi.VaultServer{}.CustomResourceDefinition(),
+		vaultapi.KubernetesAuthCluster{}.CustomResourceDefinition(),
 		catalogapi.VaultServerVersion{}.CustomResourceDefinition(),
 		policyapi.VaultPolicy{}.CustomResourceDefinition(),
 		policyapi.VaultPolicyBinding{}.CustomResourceDefinition(),
+		policyapi.VaultPolicyTemplate{}.CustomResourceDefinition(),
+		policyapi.ClusterVaultPolicy{}.CustomResourceDefinition(),
+		policyapi.VaultIdentityEntity{}.CustomResourceDefinition(),
+		policyapi.VaultIdentityGroup{}.CustomResourceDefinition(),
 		appcat.AppBinding{}.CustomResourceDefinition(),
 		engineapi.AWSAccessKeyRequest{}.CustomResourceDefinition(),
 		engineapi.AWSRole{}.CustomResourceDefinition(),
@@ -160,6 +219,11 @@ func (c *VaultController) ensureCustomResourceDefinitions() error {
 		engineapi.MySQLRole{}.CustomResourceDefinition(),
 		engineapi.PostgresRole{}.CustomResourceDefinition(),
 		engineapi.SecretEngine{}.CustomResourceDefinition(),
+		engineapi.VaultAppRole{}.CustomResourceDefinition(),
+		engineapi.AppRoleSecretIDRequest{}.CustomResourceDefinition(),
+		engineapi.VaultTokenRole{}.CustomResourceDefinition(),
+		engineapi.VaultTokenRequest{}.CustomResourceDefinition(),
+		engineapi.LeaseRevocation{}.CustomResourceDefinition(),
 	}
 	return crdutils.RegisterCRDs(c.crdClient, crds)
 }
@@ -184,7 +248,14 @@ func (c *VaultController) RunInformers(stopCh <-chan struct{}) {
 
 	glog.Info("Starting Vault controller")
 
+	c.kubeInformerFactory.Start(stopCh)
 	c.extInformerFactory.Start(stopCh)
+	for _, v := range c.kubeInformerFactory.WaitForCacheSync(stopCh) {
+		if !v {
+			runtime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
+			return
+		}
+	}
 	for _, v := range c.extInformerFactory.WaitForCacheSync(stopCh) {
 		if !v {
 			runtime.HandleError(fmt.Errorf("timed out waiting for caches to sync"))
@@ -201,6 +272,16 @@ func (c *VaultController) RunInformers(stopCh <-chan struct{}) {
 	//For VaultPolicyBinding
 	go c.vplcyBindingQueue.Run(stopCh)
 
+	//For VaultPolicyTemplate
+	go c.vplcyTemplateQueue.Run(stopCh)
+
+	//For ClusterVaultPolicy
+	go c.cvPlcyQueue.Run(stopCh)
+
+	//For VaultIdentityEntity and VaultIdentityGroup
+	go c.identityEntityQueue.Run(stopCh)
+	go c.identityGroupQueue.Run(stopCh)
+
 	// For DB role
 	go c.pgRoleQueue.Run(stopCh)
 	go c.myRoleQueue.Run(stopCh)
@@ -227,6 +308,24 @@ func (c *VaultController) RunInformers(stopCh <-chan struct{}) {
 	// For Azure access key request
 	go c.azureAccessQueue.Run(stopCh)
 
+	// For KubernetesAuthCluster
+	go c.kacQueue.Run(stopCh)
+
+	// For VaultAppRole
+	go c.appRoleQueue.Run(stopCh)
+
+	// For AppRole secret_id request
+	go c.appRoleSecretIDQueue.Run(stopCh)
+
+	// For VaultTokenRole
+	go c.tokenRoleQueue.Run(stopCh)
+
+	// For token request
+	go c.tokenRequestQueue.Run(stopCh)
+
+	// For LeaseRevocation
+	go c.leaseRevocationQueue.Run(stopCh)
+
 	// For Secret Engine
 	go c.secretEngineQueue.Run(stopCh)
 
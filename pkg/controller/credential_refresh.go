@@ -0,0 +1,60 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+// The credentials that have no renewable lease, i.e. gcp access tokens and
+// aws sts credentials, are reissued when they expire within this window
+const credentialRefreshWindow = 5 * time.Minute
+
+// credentialRefreshRetryInterval is the minimum delay to retry the failed refresh of a credential
+const credentialRefreshRetryInterval = time.Minute
+
+// needsCredentialRefresh returns whether the credential expires within the refresh window
+func needsCredentialRefresh(expiresAt *metav1.Time, now time.Time) bool {
+	return expiresAt != nil && !now.Before(expiresAt.Add(-credentialRefreshWindow))
+}
+
+// credentialRefreshDelay returns the delay to refresh the credential before it expires.
+// After a failure, the refresh is retried no sooner than credentialRefreshRetryInterval,
+// as the refresh window may have already started.
+func credentialRefreshDelay(expiresAt time.Time, now time.Time, failed bool) time.Duration {
+	d := expiresAt.Add(-credentialRefreshWindow).Sub(now)
+	if failed && d < credentialRefreshRetryInterval {
+		return credentialRefreshRetryInterval
+	}
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// requeueCredentialRefresh requeues the request to refresh the credential before it expires.
+// It is requeued after a failed reconciliation too, because the queue drops the key after
+// a limited number of retries.
+func requeueCredentialRefresh(q *queue.Worker, meta metav1.ObjectMeta, expiresAt *metav1.Time, failed bool) {
+	if q == nil || expiresAt == nil {
+		return
+	}
+	q.GetQueue().AddAfter(fmt.Sprintf("%s/%s", meta.Namespace, meta.Name), credentialRefreshDelay(expiresAt.Time, time.Now(), failed))
+}
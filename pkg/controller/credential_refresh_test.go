@@ -0,0 +1,99 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNeedsCredentialRefresh(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		testName  string
+		expiresAt *metav1.Time
+		expected  bool
+	}{
+		{
+			testName:  "no expiration",
+			expiresAt: nil,
+			expected:  false,
+		},
+		{
+			testName:  "credential is valid",
+			expiresAt: &metav1.Time{Time: now.Add(time.Hour)},
+			expected:  false,
+		},
+		{
+			testName:  "credential expires within the refresh window",
+			expiresAt: &metav1.Time{Time: now.Add(time.Minute)},
+			expected:  true,
+		},
+		{
+			testName:  "credential is expired",
+			expiresAt: &metav1.Time{Time: now.Add(-time.Minute)},
+			expected:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			assert.Equal(t, c.expected, needsCredentialRefresh(c.expiresAt, now))
+		})
+	}
+}
+
+func TestCredentialRefreshDelay(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		testName  string
+		expiresAt time.Time
+		failed    bool
+		expected  time.Duration
+	}{
+		{
+			testName:  "refresh before it expires",
+			expiresAt: now.Add(time.Hour),
+			expected:  time.Hour - credentialRefreshWindow,
+		},
+		{
+			testName:  "refresh window has started",
+			expiresAt: now.Add(time.Minute),
+			expected:  0,
+		},
+		{
+			testName:  "failed, refresh before it expires",
+			expiresAt: now.Add(time.Hour),
+			failed:    true,
+			expected:  time.Hour - credentialRefreshWindow,
+		},
+		{
+			testName:  "failed, refresh window has started",
+			expiresAt: now.Add(time.Minute),
+			failed:    true,
+			expected:  credentialRefreshRetryInterval,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			assert.Equal(t, c.expected, credentialRefreshDelay(c.expiresAt, now, c.failed))
+		})
+	}
+}
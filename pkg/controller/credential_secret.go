@@ -0,0 +1,231 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	"github.com/appscode/go/crypto/rand"
+	"github.com/pkg/errors"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	apps_util "kmodules.xyz/client-go/apps/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+const (
+	RestartTargetDeployment  = "Deployment"
+	RestartTargetStatefulSet = "StatefulSet"
+	RestartTargetDaemonSet   = "DaemonSet"
+)
+
+// getCredentialSecretName returns the name of the secret where the credential of an access request is stored.
+// If the secret is chosen by the user and already exists, it must be adoptable by the access request.
+func (c *VaultController) getCredentialSecretName(ref *api.CredentialSecretRef, meta metav1.ObjectMeta) (string, error) {
+	if ref == nil || ref.Name == "" {
+		return rand.WithUniqSuffix(meta.Name), nil
+	}
+
+	secret, err := c.kubeClient.CoreV1().Secrets(meta.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return ref.Name, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := canAdoptSecret(secret, ref, meta.UID); err != nil {
+		return "", err
+	}
+	return ref.Name, nil
+}
+
+// canAdoptSecret checks whether the existing secret can be used by the access request.
+// A secret already owned by the access request is always usable. A secret controlled by
+// another object is never adopted, and an uncontrolled one only if adopt is set.
+// Secrets of a type other than Opaque, i.e. service account tokens, are only adopted
+// if they are annotated as adoptable, as the credential would overwrite their data.
+func canAdoptSecret(secret *core.Secret, ref *api.CredentialSecretRef, uid types.UID) error {
+	for _, o := range secret.OwnerReferences {
+		if o.UID == uid {
+			return nil
+		}
+	}
+	if owner := metav1.GetControllerOf(secret); owner != nil {
+		return errors.Errorf("secret %s/%s is controlled by %s %s", secret.Namespace, secret.Name, owner.Kind, owner.Name)
+	}
+	if !ref.Adopt {
+		return errors.Errorf("secret %s/%s already exists, set secretRef.adopt to adopt it", secret.Namespace, secret.Name)
+	}
+	if secret.Type != "" && secret.Type != core.SecretTypeOpaque && secret.Annotations[api.SecretAdoptableAnnotation] != "true" {
+		return errors.Errorf("secret %s/%s is of type %s, annotate it with %s: \"true\" to adopt it", secret.Namespace, secret.Name, secret.Type, api.SecretAdoptableAnnotation)
+	}
+	return nil
+}
+
+// credentialHash returns the hash of the credential stored in the secret data
+func credentialHash(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write(data[k])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// credentialHashAnnotationKey returns the key of the annotation holding the hash of the credential
+// stored in the secret. The name part of an annotation key can't be longer than 63 characters,
+// so the hash of the secret name is used for the longer names.
+func credentialHashAnnotationKey(secretName string) string {
+	if len(secretName) <= 63 {
+		return api.CredentialHashAnnotationPrefix + secretName
+	}
+	h := sha256.Sum256([]byte(secretName))
+	return api.CredentialHashAnnotationPrefix + hex.EncodeToString(h[:])[:63]
+}
+
+// rolloutRestartTargets annotates the pod templates of the restart targets with the hash
+// of the credential stored in the secret, so that the pods are rolled out onto the new credential.
+// If onlyOnce is set, i.e. for a credential refreshed in place, the pod templates already
+// annotated for the secret are not updated, so that the workloads are not rolled out on every refresh.
+func (c *VaultController) rolloutRestartTargets(ns, secretName string, targets []api.RestartTarget, onlyOnce bool) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	secret, err := c.kubeClient.CoreV1().Secrets(ns).Get(secretName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get secret %s/%s", ns, secretName)
+	}
+	key := credentialHashAnnotationKey(secretName)
+	hash := credentialHash(secret.Data)
+	annotate := func(annotations map[string]string) map[string]string {
+		if _, ok := annotations[key]; ok && onlyOnce {
+			return annotations
+		}
+		return core_util.UpsertMap(annotations, map[string]string{key: hash})
+	}
+
+	for _, t := range targets {
+		if err := c.rolloutRestartTarget(ns, t, annotate); err != nil {
+			return errors.Wrapf(err, "failed to roll out %s %s", t.Kind, restartTargetString(t))
+		}
+	}
+	return nil
+}
+
+func (c *VaultController) rolloutRestartTarget(ns string, t api.RestartTarget, annotate func(map[string]string) map[string]string) error {
+	opts, err := restartTargetListOptions(t)
+	if err != nil {
+		return err
+	}
+
+	switch t.Kind {
+	case RestartTargetDeployment:
+		list, err := c.kubeClient.AppsV1().Deployments(ns).List(opts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Items {
+			_, _, err = apps_util.PatchDeployment(c.kubeClient, &list.Items[i], func(in *apps.Deployment) *apps.Deployment {
+				in.Spec.Template.Annotations = annotate(in.Spec.Template.Annotations)
+				return in
+			})
+			if err != nil {
+				return err
+			}
+		}
+	case RestartTargetStatefulSet:
+		list, err := c.kubeClient.AppsV1().StatefulSets(ns).List(opts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Items {
+			_, _, err = apps_util.PatchStatefulSet(c.kubeClient, &list.Items[i], func(in *apps.StatefulSet) *apps.StatefulSet {
+				in.Spec.Template.Annotations = annotate(in.Spec.Template.Annotations)
+				return in
+			})
+			if err != nil {
+				return err
+			}
+		}
+	case RestartTargetDaemonSet:
+		list, err := c.kubeClient.AppsV1().DaemonSets(ns).List(opts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Items {
+			_, _, err = apps_util.PatchDaemonSet(c.kubeClient, &list.Items[i], func(in *apps.DaemonSet) *apps.DaemonSet {
+				in.Spec.Template.Annotations = annotate(in.Spec.Template.Annotations)
+				return in
+			})
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		return errors.Errorf("unknown kind %s, expected one of %s, %s or %s", t.Kind, RestartTargetDeployment, RestartTargetStatefulSet, RestartTargetDaemonSet)
+	}
+	return nil
+}
+
+// restartTargetListOptions returns the list options that select the workloads of the restart target.
+// Either the name or the selector of the workloads must be specified.
+func restartTargetListOptions(t api.RestartTarget) (metav1.ListOptions, error) {
+	if t.Name != "" && t.Selector != nil {
+		return metav1.ListOptions{}, errors.New("only one of name and selector can be specified")
+	}
+	if t.Name != "" {
+		return metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("metadata.name", t.Name).String(),
+		}, nil
+	}
+	if t.Selector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(t.Selector)
+		if err != nil {
+			return metav1.ListOptions{}, err
+		}
+		return metav1.ListOptions{
+			LabelSelector: sel.String(),
+		}, nil
+	}
+	return metav1.ListOptions{}, errors.New("either name or selector must be specified")
+}
+
+func restartTargetString(t api.RestartTarget) string {
+	if t.Name != "" {
+		return t.Name
+	}
+	if t.Selector != nil {
+		return metav1.FormatLabelSelector(t.Selector)
+	}
+	return ""
+}
@@ -0,0 +1,199 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCanAdoptSecret(t *testing.T) {
+	trueVar := true
+	cases := []struct {
+		testName    string
+		owners      []metav1.OwnerReference
+		secretType  core.SecretType
+		annotations map[string]string
+		adopt       bool
+		expectErr   bool
+	}{
+		{
+			testName:  "secret is owned by the access request",
+			owners:    []metav1.OwnerReference{{Kind: api.ResourceKindDatabaseAccessRequest, Name: "db", UID: "req", Controller: &trueVar}},
+			expectErr: false,
+		},
+		{
+			testName:  "secret is controlled by another object",
+			owners:    []metav1.OwnerReference{{Kind: "Deployment", Name: "app", UID: "app", Controller: &trueVar}},
+			adopt:     true,
+			expectErr: true,
+		},
+		{
+			testName:  "uncontrolled secret, adopt is set",
+			owners:    []metav1.OwnerReference{{Kind: "ConfigMap", Name: "cfg", UID: "cfg"}},
+			adopt:     true,
+			expectErr: false,
+		},
+		{
+			testName:  "uncontrolled secret, adopt is not set",
+			expectErr: true,
+		},
+		{
+			testName:   "opaque secret, adopt is set",
+			secretType: core.SecretTypeOpaque,
+			adopt:      true,
+			expectErr:  false,
+		},
+		{
+			testName:   "service account token, adopt is set",
+			secretType: core.SecretTypeServiceAccountToken,
+			adopt:      true,
+			expectErr:  true,
+		},
+		{
+			testName:    "tls secret annotated as adoptable, adopt is set",
+			secretType:  core.SecretTypeTLS,
+			annotations: map[string]string{api.SecretAdoptableAnnotation: "true"},
+			adopt:       true,
+			expectErr:   false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			secret := &core.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "db-cred",
+					Namespace:       "demo",
+					OwnerReferences: c.owners,
+					Annotations:     c.annotations,
+				},
+				Type: c.secretType,
+			}
+			err := canAdoptSecret(secret, &api.CredentialSecretRef{Name: "db-cred", Adopt: c.adopt}, "req")
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestCredentialHash(t *testing.T) {
+	h := credentialHash(map[string][]byte{"username": []byte("nahid"), "password": []byte("1234")})
+	assert.Equal(t, h, credentialHash(map[string][]byte{"password": []byte("1234"), "username": []byte("nahid")}), "hash does not depend on the order of keys")
+	assert.NotEqual(t, h, credentialHash(map[string][]byte{"username": []byte("nahid"), "password": []byte("4321")}), "hash changes with the credential")
+	assert.NotEqual(t, credentialHash(map[string][]byte{"ab": []byte("c")}), credentialHash(map[string][]byte{"a": []byte("bc")}))
+}
+
+func TestRolloutRestartTargets(t *testing.T) {
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-cred",
+			Namespace: "demo",
+		},
+		Data: map[string][]byte{
+			"username": []byte("nahid"),
+			"password": []byte("1234"),
+		},
+	}
+	deploy := func(name string, labels map[string]string) *apps.Deployment {
+		return &apps.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "demo",
+				Labels:    labels,
+			},
+		}
+	}
+	sts := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "db-client",
+			Namespace: "demo",
+		},
+	}
+	key := credentialHashAnnotationKey("db-cred")
+	hash := credentialHash(secret.Data)
+
+	ctrl := &VaultController{
+		kubeClient: kfake.NewSimpleClientset(secret, deploy("app", map[string]string{"app": "web"}), deploy("other", nil), sts),
+	}
+	err := ctrl.rolloutRestartTargets("demo", "db-cred", []api.RestartTarget{
+		{
+			Kind: RestartTargetDeployment,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "web"},
+			},
+		},
+		{
+			Kind: RestartTargetStatefulSet,
+			Name: "db-client",
+		},
+	}, false)
+	if assert.Nil(t, err) {
+		d, err := ctrl.kubeClient.AppsV1().Deployments("demo").Get("app", metav1.GetOptions{})
+		if assert.Nil(t, err) {
+			assert.Equal(t, hash, d.Spec.Template.Annotations[key])
+		}
+		d, err = ctrl.kubeClient.AppsV1().Deployments("demo").Get("other", metav1.GetOptions{})
+		if assert.Nil(t, err) {
+			assert.NotContains(t, d.Spec.Template.Annotations, key)
+		}
+		s, err := ctrl.kubeClient.AppsV1().StatefulSets("demo").Get("db-client", metav1.GetOptions{})
+		if assert.Nil(t, err) {
+			assert.Equal(t, hash, s.Spec.Template.Annotations[key])
+		}
+	}
+
+	secret.Data["password"] = []byte("refreshed")
+	_, err = ctrl.kubeClient.CoreV1().Secrets("demo").Update(secret)
+	if assert.Nil(t, err) {
+		err = ctrl.rolloutRestartTargets("demo", "db-cred", []api.RestartTarget{{Kind: RestartTargetStatefulSet, Name: "db-client"}}, true)
+		if assert.Nil(t, err) {
+			s, err := ctrl.kubeClient.AppsV1().StatefulSets("demo").Get("db-client", metav1.GetOptions{})
+			if assert.Nil(t, err) {
+				assert.Equal(t, hash, s.Spec.Template.Annotations[key], "already rolled out")
+			}
+		}
+	}
+
+	err = ctrl.rolloutRestartTargets("demo", "db-cred", []api.RestartTarget{{Kind: "ReplicaSet", Name: "app"}}, false)
+	assert.NotNil(t, err, "unknown kind")
+
+	err = ctrl.rolloutRestartTargets("demo", "db-cred", []api.RestartTarget{{Kind: RestartTargetDeployment}}, false)
+	assert.NotNil(t, err, "neither name nor selector")
+}
+
+func TestCredentialHashAnnotationKey(t *testing.T) {
+	assert.Equal(t, api.CredentialHashAnnotationPrefix+"db-cred", credentialHashAnnotationKey("db-cred"))
+
+	long := credentialHashAnnotationKey(strings.Repeat("a", 64))
+	name := strings.TrimPrefix(long, api.CredentialHashAnnotationPrefix)
+	assert.Len(t, name, 63)
+	assert.Empty(t, validation.IsQualifiedName(long))
+	assert.NotEqual(t, long, credentialHashAnnotationKey(strings.Repeat("b", 64)))
+}
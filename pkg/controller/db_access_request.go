@@ -24,7 +24,6 @@ import (
 	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
 	"kubevault.dev/operator/pkg/vault/credential"
 
-	"github.com/appscode/go/crypto/rand"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	core "k8s.io/api/core/v1"
@@ -127,9 +126,9 @@ func (c *VaultController) runDatabaseAccessRequestInjector(key string) error {
 //	  - create secret containing credential
 //	  - create rbac role and role binding
 //    - sync role binding
+//    - roll out the restart targets
 func (c *VaultController) reconcileDatabaseAccessRequest(dbCM credential.CredentialManager, dbAccessReq *api.DatabaseAccessRequest) error {
 	var (
-		name   = dbAccessReq.Name
 		ns     = dbAccessReq.Namespace
 		status = dbAccessReq.Status
 	)
@@ -141,9 +140,26 @@ func (c *VaultController) reconcileDatabaseAccessRequest(dbCM credential.Credent
 
 	// check whether lease id exists in .status.lease or not
 	// if does not exist in .status.lease, then get credential
-	if dbAccessReq.Status.Lease == nil {
+	if dbAccessReq.Status.Lease == nil && dbAccessReq.Status.Wrapping == nil {
+		var err error
+		secretName, err = c.getCredentialSecretName(dbAccessReq.Spec.SecretRef, dbAccessReq.ObjectMeta)
+		if err != nil {
+			status.Conditions = UpsertDatabaseAccessCondition(status.Conditions, api.DatabaseAccessRequestCondition{
+				Type:           RequestFailed,
+				Reason:         "FailedToAdoptSecret",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateDatabaseAccessRequestStatus(&status, dbAccessReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+
 		// get database credential secret
-		credSecret, err := dbCM.GetCredential()
+		credSecret, err := getCredential(dbCM, dbAccessReq.Spec.Delivery, dbAccessReq.Spec.WrapTTL)
 		if err != nil {
 			status.Conditions = UpsertDatabaseAccessCondition(status.Conditions, api.DatabaseAccessRequestCondition{
 				Type:           RequestFailed,
@@ -159,12 +175,11 @@ func (c *VaultController) reconcileDatabaseAccessRequest(dbCM credential.Credent
 			return errors.WithStack(err)
 		}
 
-		secretName = rand.WithUniqSuffix(name)
 		err = dbCM.CreateSecret(secretName, ns, credSecret)
 		if err != nil {
-			err2 := dbCM.RevokeLease(credSecret.LeaseID)
+			err2 := revokeCredential(dbCM, credSecret)
 			if err2 != nil {
-				return errors.Wrapf(err2, "failed to revoke lease")
+				return errors.Wrapf(err2, "failed to revoke credential")
 			}
 
 			status.Conditions = UpsertDatabaseAccessCondition(status.Conditions, api.DatabaseAccessRequestCondition{
@@ -181,7 +196,7 @@ func (c *VaultController) reconcileDatabaseAccessRequest(dbCM credential.Credent
 			return errors.WithStack(err)
 		}
 
-		// add lease info in status
+		// add lease info in status, the lease of a wrapped credential is empty, if it is not found
 		status.Lease = &api.Lease{
 			ID: credSecret.LeaseID,
 			Duration: metav1.Duration{
@@ -189,6 +204,10 @@ func (c *VaultController) reconcileDatabaseAccessRequest(dbCM credential.Credent
 			},
 			Renewable: credSecret.Renewable,
 		}
+		if credSecret.WrapInfo != nil {
+			// add wrapping token info in status
+			status.Wrapping = newWrappingStatus(credSecret)
+		}
 
 		// assign secret name
 		status.Secret = &core.LocalObjectReference{
@@ -230,11 +249,59 @@ func (c *VaultController) reconcileDatabaseAccessRequest(dbCM credential.Credent
 		return errors.WithStack(err)
 	}
 
+	// check whether the wrapping token is used as intended
+	if status.Wrapping != nil {
+		reason, msg, err := c.syncWrappingStatus(dbCM, ns, secretName, dbAccessReq.Spec.Subjects, status.Wrapping, status.Lease)
+		if err != nil {
+			status.Conditions = UpsertDatabaseAccessCondition(status.Conditions, api.DatabaseAccessRequestCondition{
+				Type:           RequestFailed,
+				Reason:         "FailedToLookupWrappingToken",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateDatabaseAccessRequestStatus(&status, dbAccessReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+		if reason != "" {
+			status.Conditions = UpsertDatabaseAccessCondition(status.Conditions, api.DatabaseAccessRequestCondition{
+				Type:           api.WrappingTokenAlert,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+		}
+	}
+
+	// roll out the workloads onto the credential, the hash of
+	// the credential changes only when it is issued or rotated
+	err = c.rolloutRestartTargets(ns, secretName, dbAccessReq.Spec.RestartTargets, false)
+	if err != nil {
+		status.Conditions = UpsertDatabaseAccessCondition(status.Conditions, api.DatabaseAccessRequestCondition{
+			Type:           RequestFailed,
+			Reason:         "FailedToRolloutRestartTargets",
+			Message:        err.Error(),
+			LastUpdateTime: metav1.Now(),
+		})
+
+		err2 := c.updateDatabaseAccessRequestStatus(&status, dbAccessReq)
+		if err2 != nil {
+			return errors.Wrapf(err2, "failed to update status")
+		}
+		return errors.WithStack(err)
+	}
+
 	status.Conditions = DeleteDatabaseAccessCondition(status.Conditions, api.RequestConditionType(RequestFailed))
 	err = c.updateDatabaseAccessRequestStatus(&status, dbAccessReq)
 	if err != nil {
 		return errors.Wrap(err, "failed to update status")
 	}
+
+	// look up the wrapping token again, until it is unwrapped or expired
+	requeueWrappingLookup(c.dbAccessQueue, dbAccessReq.ObjectMeta, status.Wrapping)
 	return nil
 }
 
@@ -284,7 +351,7 @@ func (c *VaultController) runDatabaseAccessRequestFinalizer(dbAReq *api.Database
 			if err != nil {
 				glog.Errorf("DatabaseAccessRequest %s/%s finalizer: %v", dbAReq.Namespace, dbAReq.Name, err)
 			} else {
-				err = c.finalizeDatabaseAccessRequest(d, dbAReq.Status.Lease)
+				err = c.finalizeDatabaseAccessRequest(d, dbAReq.Status.Lease, dbAReq.Status.Wrapping)
 				if err != nil {
 					glog.Errorf("DatabaseAccessRequest %s/%s finalizer: %v", dbAReq.Namespace, dbAReq.Name, err)
 				} else {
@@ -321,7 +388,11 @@ func (c *VaultController) runDatabaseAccessRequestFinalizer(dbAReq *api.Database
 	c.finalizerInfo.Delete(id)
 }
 
-func (c *VaultController) finalizeDatabaseAccessRequest(dbCM credential.CredentialManager, lease *api.Lease) error {
+func (c *VaultController) finalizeDatabaseAccessRequest(dbCM credential.CredentialManager, lease *api.Lease, wrapping *api.WrappingStatus) error {
+	err := finalizeWrappedCredential(dbCM, wrapping)
+	if err != nil {
+		return err
+	}
 	if lease == nil {
 		return nil
 	}
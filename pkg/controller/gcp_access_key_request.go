@@ -23,7 +23,6 @@ import (
 	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
 	"kubevault.dev/operator/pkg/vault/credential"
 
-	"github.com/appscode/go/crypto/rand"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	core "k8s.io/api/core/v1"
@@ -105,12 +104,12 @@ func (c *VaultController) runGCPAccessKeyRequestInjector(key string) error {
 
 			if condType == api.AccessApproved {
 				gcpCredManager, err := credential.NewCredentialManagerForGCP(c.kubeClient, c.appCatalogClient, c.extClient, gcpAccessReq)
-				if err != nil {
-					return err
+				if err == nil {
+					err = c.reconcileGCPAccessKeyRequest(gcpCredManager, gcpAccessReq)
 				}
-
-				err = c.reconcileGCPAccessKeyRequest(gcpCredManager, gcpAccessReq)
 				if err != nil {
+					// retry to refresh the access token before it expires
+					requeueCredentialRefresh(c.gcpAccessQueue, gcpAccessReq.ObjectMeta, gcpAccessReq.Status.TokenExpiresAt, true)
 					return errors.Wrapf(err, "For GCPAccessKeyRequest %s/%s", gcpAccessReq.Namespace, gcpAccessReq.Name)
 				}
 			} else if condType == api.AccessDenied {
@@ -127,11 +126,12 @@ func (c *VaultController) runGCPAccessKeyRequestInjector(key string) error {
 //	For vault:
 //	  - get gcp credential
 //	  - create secret containing credential
+//	  - refresh the access token before it expires
 //	  - create rbac role and role binding
 //    - sync role binding
+//    - roll out the restart targets
 func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.CredentialManager, gcpAccessKeyReq *api.GCPAccessKeyRequest) error {
 	var (
-		name   = gcpAccessKeyReq.Name
 		ns     = gcpAccessKeyReq.Namespace
 		status = gcpAccessKeyReq.Status
 	)
@@ -143,9 +143,26 @@ func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.Credenti
 
 	// check whether lease id exists in .status.lease or not
 	// if does not exist in .status.lease, then get credential
-	if gcpAccessKeyReq.Status.Lease == nil {
+	if gcpAccessKeyReq.Status.Lease == nil && gcpAccessKeyReq.Status.Wrapping == nil {
+		var err error
+		secretName, err = c.getCredentialSecretName(gcpAccessKeyReq.Spec.SecretRef, gcpAccessKeyReq.ObjectMeta)
+		if err != nil {
+			status.Conditions = UpsertGCPAccessKeyCondition(status.Conditions, api.GCPAccessKeyRequestCondition{
+				Type:           GCPAccessKeyRequestFailed,
+				Reason:         "FailedToAdoptSecret",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateGCPAccessKeyRequestStatus(&status, gcpAccessKeyReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+
 		// get gcp credential secret
-		credSecret, err := gcpCM.GetCredential()
+		credSecret, err := getCredential(gcpCM, gcpAccessKeyReq.Spec.Delivery, gcpAccessKeyReq.Spec.WrapTTL)
 		if err != nil {
 			status.Conditions = UpsertGCPAccessKeyCondition(status.Conditions, api.GCPAccessKeyRequestCondition{
 				Type:           GCPAccessKeyRequestFailed,
@@ -161,14 +178,11 @@ func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.Credenti
 			return errors.WithStack(err)
 		}
 
-		secretName = rand.WithUniqSuffix(name)
 		err = gcpCM.CreateSecret(secretName, ns, credSecret)
 		if err != nil {
-			if len(credSecret.LeaseID) != 0 {
-				err2 := gcpCM.RevokeLease(credSecret.LeaseID)
-				if err2 != nil {
-					return errors.Wrapf(err, "failed to revoke lease with %v", err2)
-				}
+			err2 := revokeCredential(gcpCM, credSecret)
+			if err2 != nil {
+				return errors.Wrapf(err, "failed to revoke credential with %v", err2)
 			}
 			status.Conditions = UpsertGCPAccessKeyCondition(status.Conditions, api.GCPAccessKeyRequestCondition{
 				Type:           GCPAccessKeyRequestFailed,
@@ -177,7 +191,7 @@ func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.Credenti
 				LastUpdateTime: metav1.Now(),
 			})
 
-			err2 := c.updateGCPAccessKeyRequestStatus(&status, gcpAccessKeyReq)
+			err2 = c.updateGCPAccessKeyRequestStatus(&status, gcpAccessKeyReq)
 			if err2 != nil {
 				return errors.Wrapf(err, "failed to update status with %v", err2)
 			}
@@ -185,7 +199,7 @@ func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.Credenti
 			return errors.WithStack(err)
 		}
 
-		// add lease info in status
+		// add lease info in status, the lease of a wrapped credential is empty, if it is not found
 		status.Lease = &api.Lease{
 			ID: credSecret.LeaseID,
 			Duration: metav1.Duration{
@@ -193,6 +207,12 @@ func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.Credenti
 			},
 			Renewable: credSecret.Renewable,
 		}
+		if credSecret.WrapInfo != nil {
+			// add wrapping token info in status
+			status.Wrapping = newWrappingStatus(credSecret)
+		} else {
+			status.TokenExpiresAt = accessTokenExpiration(credSecret)
+		}
 
 		// assign secret name
 		status.Secret = &core.LocalObjectReference{
@@ -200,6 +220,29 @@ func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.Credenti
 		}
 	}
 
+	// refresh the access token in the secret before it expires
+	if status.Wrapping == nil && needsCredentialRefresh(status.TokenExpiresAt, time.Now()) {
+		credSecret, err := gcpCM.GetCredential()
+		if err == nil {
+			err = gcpCM.CreateSecret(secretName, ns, credSecret)
+		}
+		if err != nil {
+			status.Conditions = UpsertGCPAccessKeyCondition(status.Conditions, api.GCPAccessKeyRequestCondition{
+				Type:           GCPAccessKeyRequestFailed,
+				Reason:         "FailedToRefreshAccessToken",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateGCPAccessKeyRequestStatus(&status, gcpAccessKeyReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+		status.TokenExpiresAt = accessTokenExpiration(credSecret)
+	}
+
 	roleName := getSecretAccessRoleName(api.ResourceKindGCPAccessKeyRequest, ns, gcpAccessKeyReq.Name)
 
 	err := gcpCM.CreateRole(roleName, ns, secretName)
@@ -234,11 +277,63 @@ func (c *VaultController) reconcileGCPAccessKeyRequest(gcpCM credential.Credenti
 		return errors.WithStack(err)
 	}
 
+	// check whether the wrapping token is used as intended
+	if status.Wrapping != nil {
+		reason, msg, err := c.syncWrappingStatus(gcpCM, ns, secretName, gcpAccessKeyReq.Spec.Subjects, status.Wrapping, status.Lease)
+		if err != nil {
+			status.Conditions = UpsertGCPAccessKeyCondition(status.Conditions, api.GCPAccessKeyRequestCondition{
+				Type:           GCPAccessKeyRequestFailed,
+				Reason:         "FailedToLookupWrappingToken",
+				Message:        err.Error(),
+				LastUpdateTime: metav1.Now(),
+			})
+
+			err2 := c.updateGCPAccessKeyRequestStatus(&status, gcpAccessKeyReq)
+			if err2 != nil {
+				return errors.Wrapf(err2, "failed to update status")
+			}
+			return errors.WithStack(err)
+		}
+		if reason != "" {
+			status.Conditions = UpsertGCPAccessKeyCondition(status.Conditions, api.GCPAccessKeyRequestCondition{
+				Type:           api.WrappingTokenAlert,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+		}
+	}
+
+	// roll out the workloads onto the credential, the hash of
+	// the credential changes only when it is issued or rotated.
+	// An access token is refreshed in place, so the workloads
+	// are rolled out only once onto it.
+	err = c.rolloutRestartTargets(ns, secretName, gcpAccessKeyReq.Spec.RestartTargets, status.TokenExpiresAt != nil)
+	if err != nil {
+		status.Conditions = UpsertGCPAccessKeyCondition(status.Conditions, api.GCPAccessKeyRequestCondition{
+			Type:           GCPAccessKeyRequestFailed,
+			Reason:         "FailedToRolloutRestartTargets",
+			Message:        err.Error(),
+			LastUpdateTime: metav1.Now(),
+		})
+
+		err2 := c.updateGCPAccessKeyRequestStatus(&status, gcpAccessKeyReq)
+		if err2 != nil {
+			return errors.Wrapf(err2, "failed to update status")
+		}
+		return errors.WithStack(err)
+	}
+
 	status.Conditions = DeleteGCPAccessKeyCondition(status.Conditions, api.RequestConditionType(GCPAccessKeyRequestFailed))
 	err = c.updateGCPAccessKeyRequestStatus(&status, gcpAccessKeyReq)
 	if err != nil {
 		return errors.Wrap(err, "failed to update status")
 	}
+
+	// look up the wrapping token again, until it is unwrapped or expired
+	requeueWrappingLookup(c.gcpAccessQueue, gcpAccessKeyReq.ObjectMeta, status.Wrapping)
+	// refresh the access token before it expires
+	requeueCredentialRefresh(c.gcpAccessQueue, gcpAccessKeyReq.ObjectMeta, status.TokenExpiresAt, false)
 	return nil
 }
 
@@ -288,7 +383,7 @@ func (c *VaultController) runGCPAccessKeyRequestFinalizer(gcpAKReq *api.GCPAcces
 			if err != nil {
 				glog.Errorf("GCPAccessKeyRequest %s/%s finalizer: %v", gcpAKReq.Namespace, gcpAKReq.Name, err)
 			} else {
-				err = c.finalizeGCPAccessKeyRequest(gcpCM, gcpAKReq.Status.Lease)
+				err = c.finalizeGCPAccessKeyRequest(gcpCM, gcpAKReq.Status.Lease, gcpAKReq.Status.Wrapping)
 				if err != nil {
 					glog.Errorf("GCPAccessKeyRequest %s/%s finalizer: %v", gcpAKReq.Namespace, gcpAKReq.Name, err)
 				} else {
@@ -325,7 +420,11 @@ func (c *VaultController) runGCPAccessKeyRequestFinalizer(gcpAKReq *api.GCPAcces
 	c.finalizerInfo.Delete(id)
 }
 
-func (c *VaultController) finalizeGCPAccessKeyRequest(gcpCM credential.CredentialManager, lease *api.Lease) error {
+func (c *VaultController) finalizeGCPAccessKeyRequest(gcpCM credential.CredentialManager, lease *api.Lease, wrapping *api.WrappingStatus) error {
+	err := finalizeWrappedCredential(gcpCM, wrapping)
+	if err != nil {
+		return err
+	}
 	if lease == nil {
 		return nil
 	}
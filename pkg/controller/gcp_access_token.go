@@ -0,0 +1,53 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"encoding/json"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// key of the gcp access token credential, that contains its expiration time
+	accessTokenExpiresAtKey = "expires_at_seconds"
+)
+
+// accessTokenExpiration returns the time when the gcp access token expires.
+// It returns nil, if the credential is not an access token.
+func accessTokenExpiration(credSecret *vaultapi.Secret) *metav1.Time {
+	if credSecret == nil || credSecret.WrapInfo != nil {
+		return nil
+	}
+
+	var sec int64
+	switch v := credSecret.Data[accessTokenExpiresAtKey].(type) {
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return nil
+		}
+		sec = n
+	case float64:
+		sec = int64(v)
+	default:
+		return nil
+	}
+	t := metav1.NewTime(time.Unix(sec, 0))
+	return &t
+}
@@ -0,0 +1,77 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccessTokenExpiration(t *testing.T) {
+	cases := []struct {
+		testName   string
+		credSecret *vaultapi.Secret
+		expected   *metav1.Time
+	}{
+		{
+			testName: "access token",
+			credSecret: &vaultapi.Secret{
+				Data: map[string]interface{}{
+					"token":              "ya29.c.ElodBmNPwHUNY5gcBpnXcE4ywG4w1k...",
+					"expires_at_seconds": json.Number("1537400046"),
+					"token_ttl":          json.Number("3599"),
+				},
+			},
+			expected: &metav1.Time{Time: time.Unix(1537400046, 0)},
+		},
+		{
+			testName: "service account key",
+			credSecret: &vaultapi.Secret{
+				Data: map[string]interface{}{
+					"private_key_data": "cHJpdmF0ZS1rZXk=",
+					"key_algorithm":    "KEY_ALG_RSA_2048",
+					"key_type":         "TYPE_GOOGLE_CREDENTIALS_FILE",
+				},
+			},
+			expected: nil,
+		},
+		{
+			testName: "wrapped credential",
+			credSecret: &vaultapi.Secret{
+				WrapInfo: &vaultapi.SecretWrapInfo{
+					Token: "s.wrapped",
+				},
+			},
+			expected: nil,
+		},
+		{
+			testName:   "no credential",
+			credSecret: nil,
+			expected:   nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			assert.Equal(t, c.expected, accessTokenExpiration(c.credSecret))
+		})
+	}
+}
@@ -97,6 +97,20 @@ func (c *VaultController) runGCPRoleInjector(key string) error {
 func (c *VaultController) reconcileGCPRole(gcpRClient gcp.GCPRoleInterface, gcpRole *api.GCPRole) error {
 	status := gcpRole.Status
 
+	if err := gcpRole.IsValid(); err != nil {
+		status.Conditions = []api.GCPRoleCondition{
+			{
+				Type:    GCPRoleConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  "InvalidRole",
+				Message: err.Error(),
+			},
+		}
+		status.ObservedGeneration = gcpRole.Generation
+		// invalid spec is not retried
+		return c.updatedGCPRoleStatus(&status, gcpRole)
+	}
+
 	// create role
 	err := gcpRClient.CreateRole()
 	if err != nil {
@@ -152,6 +166,7 @@ func (c *VaultController) runGCPRoleFinalizer(gcpRole *api.GCPRole, timeout time
 
 	stopCh := time.After(timeout)
 	finalizationDone := false
+	blocked := false
 	timeOutOccured := false
 	attempt := 0
 
@@ -174,6 +189,7 @@ func (c *VaultController) runGCPRoleFinalizer(gcpRole *api.GCPRole, timeout time
 				glog.Errorf("GCPRole %s/%s finalizer: %v", gcpRole.Namespace, gcpRole.Name, err)
 			} else {
 				err = c.finalizeGCPRole(d, gcpRole)
+				blocked = errors.Cause(err) == errDeletionBlocked
 				if err != nil {
 					glog.Errorf("GCPRole %s/%s finalizer: %v", gcpRole.Namespace, gcpRole.Name, err)
 				} else {
@@ -199,6 +215,14 @@ func (c *VaultController) runGCPRoleFinalizer(gcpRole *api.GCPRole, timeout time
 		attempt++
 	}
 
+	if blocked {
+		// keep the finalizer, as the deletion is blocked by live leases
+		glog.Infof("GCPRole %s/%s finalizer: deletion is blocked by live leases, retrying after %v", gcpRole.Namespace, gcpRole.Name, blockedDeletionInterval)
+		c.finalizerInfo.Delete(id)
+		requeueBlockedDeletion(c.gcpRoleQueue, gcpRole.ObjectMeta)
+		return
+	}
+
 	err := c.removeGCPRoleFinalizer(gcpRole)
 	if err != nil {
 		glog.Errorf("GCPRole %s/%s finalizer: removing finalizer %v", gcpRole.Namespace, gcpRole.Name, err)
@@ -211,9 +235,15 @@ func (c *VaultController) runGCPRoleFinalizer(gcpRole *api.GCPRole, timeout time
 }
 
 // Do:
+//	- apply the revocation policy to the leases issued against the role
 //	- delete role in vault
 func (c *VaultController) finalizeGCPRole(gcpRClient gcp.GCPRoleInterface, gcpRole *api.GCPRole) error {
-	err := gcpRClient.DeleteRole(gcpRole.RoleName())
+	err := c.revokeRoleLeases(gcpRClient, gcpRole.Spec.RevocationPolicy, api.ResourceKindGCPRole, gcpRole.ObjectMeta, gcpRole.RoleName())
+	if err != nil {
+		return err
+	}
+
+	err = gcpRClient.DeleteRole(gcpRole.RoleName())
 	if err != nil {
 		return errors.Wrap(err, "failed to delete gcp role")
 	}
@@ -65,6 +65,14 @@ func (f *fakeGCPRole) DeleteRole(name string) error {
 	return nil
 }
 
+func (f *fakeGCPRole) HasLeases(name string) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeGCPRole) RevokeLeases(name string) error {
+	return nil
+}
+
 func TestGCPRole_reconcileGCPRole(t *testing.T) {
 
 	gRole := &api.GCPRole{
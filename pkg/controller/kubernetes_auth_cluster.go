@@ -0,0 +1,418 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/kubevault/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault"
+
+	"github.com/golang/glog"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+const (
+	KubernetesAuthClusterConditionFailed string = "Failed"
+	KubernetesAuthClusterFinalizer       string = "kubernetesauthcluster.kubevault.com"
+)
+
+func (c *VaultController) initKubernetesAuthClusterWatcher() {
+	c.kacInformer = c.extInformerFactory.Kubevault().V1alpha1().KubernetesAuthClusters().Informer()
+	c.kacQueue = queue.New(api.ResourceKindKubernetesAuthCluster, c.MaxNumRequeues, c.NumThreads, c.runKubernetesAuthClusterInjector)
+	c.kacInformer.AddEventHandler(queue.NewReconcilableHandler(c.kacQueue.GetQueue()))
+	c.kacLister = c.extInformerFactory.Kubevault().V1alpha1().KubernetesAuthClusters().Lister()
+}
+
+func (c *VaultController) runKubernetesAuthClusterInjector(key string) error {
+	obj, exist, err := c.kacInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exist {
+		glog.Warningf("KubernetesAuthCluster %s does not exist anymore", key)
+
+	} else {
+		kac := obj.(*api.KubernetesAuthCluster).DeepCopy()
+
+		glog.Infof("Sync/Add/Update for KubernetesAuthCluster %s/%s", kac.Namespace, kac.Name)
+
+		if kac.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(kac.ObjectMeta, KubernetesAuthClusterFinalizer) {
+				go c.runKubernetesAuthClusterFinalizer(kac, finalizerTimeout, finalizerInterval)
+			}
+		} else {
+			if !core_util.HasFinalizer(kac.ObjectMeta, KubernetesAuthClusterFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchKubernetesAuthCluster(c.extClient.KubevaultV1alpha1(), kac, func(in *api.KubernetesAuthCluster) *api.KubernetesAuthCluster {
+					in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, KubernetesAuthClusterFinalizer)
+					return in
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set KubernetesAuthCluster finalizer for %s/%s", kac.Namespace, kac.Name)
+				}
+			}
+
+			err = c.reconcileKubernetesAuthCluster(kac)
+
+			// requeue to keep the reviewer token fresh, even if the reconciliation is failed,
+			// because the queue drops the key after a limited number of retries
+			c.kacQueue.GetQueue().AddAfter(key, kac.GetTokenRefreshInterval())
+
+			if err != nil {
+				return errors.Wrapf(err, "for KubernetesAuthCluster %s/%s", kac.Namespace, kac.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Will do:
+//
+//	For vault:
+//	  - disable the kubernetes auth method in the previous path, if the cluster name is changed
+//	  - enable kubernetes auth method in kubernetes-<cluster> path
+//	  - configure the auth method with the host, ca cert and reviewer token of the remote cluster
+func (c *VaultController) reconcileKubernetesAuthCluster(kac *api.KubernetesAuthCluster) error {
+	status := kac.Status
+
+	if err := kac.IsValid(); err != nil {
+		return c.failKubernetesAuthCluster(kac, "InvalidClusterName", err)
+	}
+
+	vc, err := vault.NewClient(c.kubeClient, c.appCatalogClient, &appcat.AppReference{
+		Namespace: kac.Namespace,
+		Name:      kac.Spec.VaultRef.Name,
+	})
+	if err != nil {
+		return c.failKubernetesAuthCluster(kac, "FailedToCreateVaultClient", err)
+	}
+
+	payload, expiration, err := c.kubernetesAuthClusterConfig(kac)
+	if err != nil {
+		return c.failKubernetesAuthCluster(kac, "FailedToGetClusterConfig", err)
+	}
+
+	path := kac.AuthPath()
+	err = disableStaleKubernetesAuth(vc, kac.Status.Path, path)
+	if err != nil {
+		return c.failKubernetesAuthCluster(kac, "FailedToDisableAuthMethod", err)
+	}
+	err = enableKubernetesAuth(vc, path, kac.AuthDescription())
+	if err != nil {
+		return c.failKubernetesAuthCluster(kac, "FailedToEnableAuthMethod", err)
+	}
+
+	req := vc.NewRequest("POST", fmt.Sprintf("/v1/auth/%s/config", path))
+	if err := req.SetJSONBody(payload); err != nil {
+		return c.failKubernetesAuthCluster(kac, "FailedToConfigureAuthMethod", err)
+	}
+	resp, err := vc.RawRequest(req)
+	if resp != nil {
+		resp.Body.Close()
+	}
+	if err != nil {
+		return c.failKubernetesAuthCluster(kac, "FailedToConfigureAuthMethod", err)
+	}
+
+	status.ObservedGeneration = kac.Generation
+	status.Phase = api.KubernetesAuthClusterSuccess
+	status.Path = path
+	status.TokenExpirationTime = expiration
+	status.Conditions = []api.KubernetesAuthClusterCondition{}
+
+	_, err = patchutil.UpdateKubernetesAuthClusterStatus(c.extClient.KubevaultV1alpha1(), kac, func(s *api.KubernetesAuthClusterStatus) *api.KubernetesAuthClusterStatus {
+		return &status
+	})
+	return err
+}
+
+func (c *VaultController) failKubernetesAuthCluster(kac *api.KubernetesAuthCluster, reason string, err error) error {
+	_, err2 := patchutil.UpdateKubernetesAuthClusterStatus(c.extClient.KubevaultV1alpha1(), kac, func(s *api.KubernetesAuthClusterStatus) *api.KubernetesAuthClusterStatus {
+		s.Phase = api.KubernetesAuthClusterFailed
+		s.Conditions = []api.KubernetesAuthClusterCondition{
+			{
+				Type:    KubernetesAuthClusterConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  reason,
+				Message: err.Error(),
+			},
+		}
+		return s
+	})
+	if err2 != nil {
+		return errors.Wrapf(err, "failed to update status with %v", err2)
+	}
+	return err
+}
+
+// kubernetesAuthClusterConfig returns the config payload of the kubernetes auth method
+// and the expiration time of the reviewer token, if it's requested for a service account
+// More info: https://www.vaultproject.io/api/auth/kubernetes/index.html#configure-method
+func (c *VaultController) kubernetesAuthClusterConfig(kac *api.KubernetesAuthCluster) (map[string]interface{}, *metav1.Time, error) {
+	var (
+		host       string
+		caCert     string
+		reviewJWT  string
+		expiration *metav1.Time
+		restConfig *rest.Config
+	)
+
+	if kac.Spec.Kubeconfig != nil {
+		data, err := c.getSecretKey(kac.Namespace, kac.Spec.Kubeconfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		restConfig, err = clientcmd.RESTConfigFromKubeConfig(data)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to parse kubeconfig")
+		}
+		host = restConfig.Host
+		caCert = string(restConfig.CAData)
+	}
+	if kac.Spec.KubernetesHost != "" {
+		host = kac.Spec.KubernetesHost
+	}
+	if kac.Spec.KubernetesCACert != "" {
+		caCert = kac.Spec.KubernetesCACert
+	}
+
+	if sa := kac.Spec.TokenReviewerServiceAccount; sa != nil {
+		if restConfig == nil {
+			return nil, nil, errors.New("spec.kubeconfig is required to request token for spec.tokenReviewerServiceAccount")
+		}
+		remote, err := kubernetes.NewForConfig(restConfig)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to create client for remote cluster")
+		}
+		// token is valid for two refresh intervals, so that it is refreshed before expiry
+		expSeconds := int64(2 * kac.GetTokenRefreshInterval() / time.Second)
+		tr, err := remote.CoreV1().ServiceAccounts(sa.Namespace).CreateToken(sa.Name, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				ExpirationSeconds: &expSeconds,
+			},
+		})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to request token for service account %s/%s", sa.Namespace, sa.Name)
+		}
+		reviewJWT = tr.Status.Token
+		expiration = &tr.Status.ExpirationTimestamp
+	} else if kac.Spec.TokenReviewerJWT != nil {
+		data, err := c.getSecretKey(kac.Namespace, kac.Spec.TokenReviewerJWT)
+		if err != nil {
+			return nil, nil, err
+		}
+		reviewJWT = string(data)
+	}
+
+	if host == "" {
+		return nil, nil, errors.New("kubernetes host of the remote cluster is empty")
+	}
+	return kubernetesAuthConfigPayload(host, caCert, reviewJWT, kac.Spec.Issuer), expiration, nil
+}
+
+func kubernetesAuthConfigPayload(host, caCert, reviewJWT, issuer string) map[string]interface{} {
+	payload := map[string]interface{}{
+		"kubernetes_host": host,
+	}
+	if caCert != "" {
+		payload["kubernetes_ca_cert"] = caCert
+	}
+	// if reviewer jwt is empty, vault uses the jwt of the login request to access the TokenReview API
+	if reviewJWT != "" {
+		payload["token_reviewer_jwt"] = reviewJWT
+	}
+	if issuer != "" {
+		payload["issuer"] = issuer
+	} else {
+		payload["disable_iss_validation"] = true
+	}
+	return payload
+}
+
+func (c *VaultController) getSecretKey(namespace string, sel *core.SecretKeySelector) ([]byte, error) {
+	secret, err := c.kubeClient.CoreV1().Secrets(namespace).Get(sel.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get secret %s/%s", namespace, sel.Name)
+	}
+	data, ok := secret.Data[sel.Key]
+	if !ok {
+		return nil, errors.Errorf("secret %s/%s doesn't have key %s", namespace, sel.Name, sel.Key)
+	}
+	return data, nil
+}
+
+// enableKubernetesAuth enables kubernetes auth method in the path, if it is not enabled.
+// The description identifies the auth method enabled by the operator, an existing auth method
+// with a different description is not adopted, because it is disabled by the finalizer.
+func enableKubernetesAuth(vc *vaultapi.Client, path, description string) error {
+	authList, err := vc.Sys().ListAuth()
+	if err != nil {
+		return err
+	}
+	if got, ok := authList[filepath.Clean(path)+"/"]; ok {
+		if got.Type != string(api.AuthTypeKubernetes) {
+			return errors.Errorf("%s type auth already enabled in path %s", got.Type, path)
+		}
+		if got.Description != description {
+			return errors.Errorf("auth method in path %s is not enabled by the operator", path)
+		}
+		return nil
+	}
+	return vc.Sys().EnableAuthWithOptions(path, &vaultapi.EnableAuthOptions{
+		Type:        string(api.AuthTypeKubernetes),
+		Description: description,
+	})
+}
+
+// disableStaleKubernetesAuth disables the auth method in the previous path, if it differs from the current path,
+// so that the remote cluster can't login through the previous path anymore
+func disableStaleKubernetesAuth(vc *vaultapi.Client, oldPath, path string) error {
+	if oldPath == "" || oldPath == path {
+		return nil
+	}
+	if err := vc.Sys().DisableAuth(oldPath); err != nil {
+		return errors.Wrapf(err, "failed to disable auth method in previous path %s", oldPath)
+	}
+	return nil
+}
+
+func (c *VaultController) runKubernetesAuthClusterFinalizer(kac *api.KubernetesAuthCluster, timeout time.Duration, interval time.Duration) {
+	if kac == nil {
+		glog.Infoln("KubernetesAuthCluster is nil")
+		return
+	}
+
+	id := getKubernetesAuthClusterId(kac)
+	if c.finalizerInfo.IsAlreadyProcessing(id) {
+		// already processing
+		return
+	}
+
+	glog.Infof("Processing finalizer for KubernetesAuthCluster %s/%s", kac.Namespace, kac.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this KubernetesAuthCluster
+	c.finalizerInfo.Add(id)
+
+	stopCh := time.After(timeout)
+	finalizationDone := false
+	timeOutOccured := false
+	attempt := 0
+
+	for {
+		glog.Infof("KubernetesAuthCluster %s/%s finalizer: attempt %d\n", kac.Namespace, kac.Name, attempt)
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		if !finalizationDone {
+			err := c.finalizeKubernetesAuthCluster(kac)
+			if err != nil {
+				glog.Errorf("KubernetesAuthCluster %s/%s finalizer: %v", kac.Namespace, kac.Name, err)
+			} else {
+				finalizationDone = true
+			}
+		}
+
+		if finalizationDone {
+			err := c.removeKubernetesAuthClusterFinalizer(kac)
+			if err != nil {
+				glog.Errorf("KubernetesAuthCluster %s/%s finalizer: removing finalizer %v", kac.Namespace, kac.Name, err)
+			} else {
+				break
+			}
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+		attempt++
+	}
+
+	err := c.removeKubernetesAuthClusterFinalizer(kac)
+	if err != nil {
+		glog.Errorf("KubernetesAuthCluster %s/%s finalizer: removing finalizer %v", kac.Namespace, kac.Name, err)
+	} else {
+		glog.Infof("Removed finalizer for KubernetesAuthCluster %s/%s", kac.Namespace, kac.Name)
+	}
+
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(id)
+}
+
+// Do:
+//	- disable the kubernetes auth method of the remote cluster in vault
+func (c *VaultController) finalizeKubernetesAuthCluster(kac *api.KubernetesAuthCluster) error {
+	if kac.Status.Path == "" {
+		return nil
+	}
+	vc, err := vault.NewClient(c.kubeClient, c.appCatalogClient, &appcat.AppReference{
+		Namespace: kac.Namespace,
+		Name:      kac.Spec.VaultRef.Name,
+	})
+	if err != nil {
+		return err
+	}
+	err = vc.Sys().DisableAuth(kac.Status.Path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to disable auth method in path %s", kac.Status.Path)
+	}
+	return nil
+}
+
+func (c *VaultController) removeKubernetesAuthClusterFinalizer(kac *api.KubernetesAuthCluster) error {
+	m, err := c.extClient.KubevaultV1alpha1().KubernetesAuthClusters(kac.Namespace).Get(kac.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, _, err = patchutil.PatchKubernetesAuthCluster(c.extClient.KubevaultV1alpha1(), m, func(in *api.KubernetesAuthCluster) *api.KubernetesAuthCluster {
+		in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, KubernetesAuthClusterFinalizer)
+		return in
+	})
+	return err
+}
+
+func getKubernetesAuthClusterId(kac *api.KubernetesAuthCluster) string {
+	return fmt.Sprintf("%s/%s/%s", api.ResourceKubernetesAuthCluster, kac.Namespace, kac.Name)
+}
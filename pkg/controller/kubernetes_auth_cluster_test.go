@@ -0,0 +1,264 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+
+	"github.com/gorilla/mux"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+const demoKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: remote
+  cluster:
+    server: https://remote.example.com:6443
+    certificate-authority-data: Y2EtY2VydA==
+contexts:
+- name: remote
+  context:
+    cluster: remote
+    user: admin
+current-context: remote
+users:
+- name: admin
+  user:
+    token: admin-token
+`
+
+func TestKubernetesAuthClusterConfig(t *testing.T) {
+	c := &VaultController{
+		kubeClient: kfake.NewSimpleClientset(&core.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "remote",
+				Namespace: "demo",
+			},
+			Data: map[string][]byte{
+				"kubeconfig":  []byte(demoKubeconfig),
+				"reviewerJWT": []byte("reviewer-jwt"),
+			},
+		}),
+	}
+
+	cases := []struct {
+		testName  string
+		spec      api.KubernetesAuthClusterSpec
+		expected  map[string]interface{}
+		expectErr bool
+	}{
+		{
+			testName: "kubeconfig and reviewer jwt, expect no error",
+			spec: api.KubernetesAuthClusterSpec{
+				Kubeconfig: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: "remote"},
+					Key:                  "kubeconfig",
+				},
+				TokenReviewerJWT: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: "remote"},
+					Key:                  "reviewerJWT",
+				},
+				Issuer: "https://remote.example.com",
+			},
+			expected: map[string]interface{}{
+				"kubernetes_host":    "https://remote.example.com:6443",
+				"kubernetes_ca_cert": "ca-cert",
+				"token_reviewer_jwt": "reviewer-jwt",
+				"issuer":             "https://remote.example.com",
+			},
+		},
+		{
+			testName: "host and ca cert, expect no error",
+			spec: api.KubernetesAuthClusterSpec{
+				KubernetesHost:   "https://10.0.0.1",
+				KubernetesCACert: "pem",
+			},
+			expected: map[string]interface{}{
+				"kubernetes_host":        "https://10.0.0.1",
+				"kubernetes_ca_cert":     "pem",
+				"disable_iss_validation": true,
+			},
+		},
+		{
+			testName: "reviewer service account without kubeconfig, expect error",
+			spec: api.KubernetesAuthClusterSpec{
+				KubernetesHost: "https://10.0.0.1",
+				TokenReviewerServiceAccount: &api.TokenReviewerServiceAccount{
+					Name:      "vault-reviewer",
+					Namespace: "kube-system",
+				},
+			},
+			expectErr: true,
+		},
+		{
+			testName:  "no host, expect error",
+			spec:      api.KubernetesAuthClusterSpec{},
+			expectErr: true,
+		},
+		{
+			testName: "missing secret key, expect error",
+			spec: api.KubernetesAuthClusterSpec{
+				Kubeconfig: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: "remote"},
+					Key:                  "config",
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			kac := &api.KubernetesAuthCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "us-east",
+					Namespace: "demo",
+				},
+				Spec: tc.spec,
+			}
+			payload, expiration, err := c.kubernetesAuthClusterConfig(kac)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else if assert.Nil(t, err) {
+				assert.Equal(t, tc.expected, payload)
+				assert.Nil(t, expiration)
+			}
+		})
+	}
+}
+
+func TestKubernetesAuthClusterAuthPath(t *testing.T) {
+	kac := api.KubernetesAuthCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "us-east",
+			Namespace: "demo",
+		},
+	}
+	assert.Equal(t, "kubernetes-us-east", kac.AuthPath())
+	assert.Nil(t, kac.IsValid())
+
+	kac.Spec.ClusterName = "prod"
+	assert.Equal(t, "kubernetes-prod", kac.AuthPath())
+	assert.Nil(t, kac.IsValid())
+
+	kac.Spec.ClusterName = "prod/../sys"
+	assert.NotNil(t, kac.IsValid())
+
+	kac.Spec.ClusterName = "prod.us-east"
+	assert.NotNil(t, kac.IsValid())
+}
+
+func TestDisableStaleKubernetesAuth(t *testing.T) {
+	var disabled []string
+	router := mux.NewRouter()
+	router.HandleFunc("/v1/sys/auth/{path}", func(w http.ResponseWriter, r *http.Request) {
+		disabled = append(disabled, mux.Vars(r)["path"])
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodDelete)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	vc, err := vaultapi.NewClient(&vaultapi.Config{Address: srv.URL})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	assert.Nil(t, disableStaleKubernetesAuth(vc, "", "kubernetes-prod"))
+	assert.Nil(t, disableStaleKubernetesAuth(vc, "kubernetes-prod", "kubernetes-prod"))
+	assert.Empty(t, disabled, "auth method should not be disabled")
+
+	assert.Nil(t, disableStaleKubernetesAuth(vc, "kubernetes.demo.prod", "kubernetes-prod"))
+	assert.Equal(t, []string{"kubernetes.demo.prod"}, disabled)
+}
+
+func TestEnableKubernetesAuth(t *testing.T) {
+	enabled := false
+	router := mux.NewRouter()
+	router.HandleFunc("/v1/sys/auth", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":{
+"kubernetes-prod/":{"type":"kubernetes","description":"kubernetes auth for KubernetesAuthCluster demo/prod"},
+"kubernetes-dev/":{"type":"kubernetes","description":"created manually"},
+"kubernetes-qa/":{"type":"approle"}}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodGet)
+	router.HandleFunc("/v1/sys/auth/kubernetes-staging", func(w http.ResponseWriter, r *http.Request) {
+		enabled = true
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPost)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	vc, err := vaultapi.NewClient(&vaultapi.Config{Address: srv.URL})
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	cases := []struct {
+		testName  string
+		name      string
+		expectErr bool
+	}{
+		{
+			testName:  "enabled by the operator, expect no error",
+			name:      "prod",
+			expectErr: false,
+		},
+		{
+			testName:  "not enabled by the operator, expect error",
+			name:      "dev",
+			expectErr: true,
+		},
+		{
+			testName:  "different type, expect error",
+			name:      "qa",
+			expectErr: true,
+		},
+		{
+			testName:  "not enabled, expect no error",
+			name:      "staging",
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			kac := api.KubernetesAuthCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      tc.name,
+					Namespace: "demo",
+				},
+			}
+			err := enableKubernetesAuth(vc, kac.AuthPath(), kac.AuthDescription())
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+	assert.True(t, enabled, "auth method should be enabled")
+}
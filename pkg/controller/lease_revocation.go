@@ -0,0 +1,257 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault"
+	"kubevault.dev/operator/pkg/vault/engine"
+	"kubevault.dev/operator/pkg/vault/lease"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/tools/queue"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+const (
+	RevokedByLeaseRevocation = "RevokedByLeaseRevocation"
+)
+
+// revocationTarget is a lease prefix to revoke,
+// along with the access requests holding leases under it
+type revocationTarget struct {
+	vaultRef appcat.AppReference
+	prefix   string
+	holders  []leaseHolder
+}
+
+func (c *VaultController) initLeaseRevocationWatcher() {
+	c.leaseRevocationInformer = c.extInformerFactory.Engine().V1alpha1().LeaseRevocations().Informer()
+	c.leaseRevocationQueue = queue.New(api.ResourceKindLeaseRevocation, c.MaxNumRequeues, c.NumThreads, c.runLeaseRevocationInjector)
+	c.leaseRevocationInformer.AddEventHandler(queue.NewReconcilableHandler(c.leaseRevocationQueue.GetQueue()))
+	c.leaseRevocationLister = c.extInformerFactory.Engine().V1alpha1().LeaseRevocations().Lister()
+}
+
+func (c *VaultController) runLeaseRevocationInjector(key string) error {
+	obj, exist, err := c.leaseRevocationInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exist {
+		glog.Warningf("LeaseRevocation %s does not exist anymore", key)
+
+	} else {
+		lr := obj.(*api.LeaseRevocation).DeepCopy()
+
+		glog.Infof("Sync/Add/Update for LeaseRevocation %s/%s", lr.Namespace, lr.Name)
+
+		if lr.DeletionTimestamp != nil {
+			return nil
+		}
+		// leases are revoked once per generation
+		if lr.Status.ObservedGeneration == lr.Generation && lr.Status.Phase == api.LeaseRevocationPhaseSucceeded {
+			return nil
+		}
+
+		err = c.reconcileLeaseRevocation(lr)
+		if err != nil {
+			return errors.Wrapf(err, "for LeaseRevocation %s/%s:", lr.Namespace, lr.Name)
+		}
+	}
+	return nil
+}
+
+// Will do:
+//
+//	For vault:
+//	  - revoke the leases under the prefixes of the scope
+//	  - mark the access requests holding the revoked leases as Revoked
+//	  - report the counts and the failures in status
+func (c *VaultController) reconcileLeaseRevocation(lr *api.LeaseRevocation) error {
+	now := metav1.Now()
+	status := api.LeaseRevocationStatus{
+		ObservedGeneration: lr.Generation,
+		RevocationTime:     &now,
+	}
+
+	if err := lr.IsValid(); err != nil {
+		status.Phase = api.LeaseRevocationPhaseFailed
+		status.Failures = []api.LeaseRevocationFailure{
+			{
+				Target:  "spec.scope",
+				Message: err.Error(),
+			},
+		}
+		status.Failed = 1
+		// invalid spec is not retried
+		return c.updateLeaseRevocationStatus(&status, lr)
+	}
+
+	holders, err := c.listLeaseHolders()
+	if err != nil {
+		return errors.Wrap(err, "failed to list access requests")
+	}
+
+	targets, failures := c.getRevocationTargets(lr, holders)
+	status.Failures = failures
+
+	msg := fmt.Sprintf("lease is revoked by LeaseRevocation %s/%s", lr.Namespace, lr.Name)
+	for _, t := range targets {
+		vc, err := vault.NewClient(c.kubeClient, c.appCatalogClient, &t.vaultRef)
+		if err == nil {
+			if lr.Spec.Force {
+				err = lease.RevokeForce(vc, t.prefix)
+			} else {
+				err = lease.RevokePrefix(vc, t.prefix)
+			}
+		}
+		if err != nil {
+			status.Failures = append(status.Failures, api.LeaseRevocationFailure{
+				Target:  t.prefix,
+				Message: err.Error(),
+			})
+			continue
+		}
+		status.Revoked++
+
+		for _, h := range t.holders {
+			err := c.markLeaseHolder(h, api.AccessRevoked, RevokedByLeaseRevocation, msg)
+			if err != nil {
+				status.Failures = append(status.Failures, api.LeaseRevocationFailure{
+					Target:  h.String(),
+					Message: err.Error(),
+				})
+				continue
+			}
+			status.RevokedRequests++
+		}
+	}
+
+	status.Failed = int32(len(status.Failures))
+	status.Phase = api.LeaseRevocationPhaseSucceeded
+	if status.Failed > 0 {
+		status.Phase = api.LeaseRevocationPhaseFailed
+	}
+
+	glog.Infof("LeaseRevocation %s/%s: revoked %d lease prefixes and %d access requests, %d failures",
+		lr.Namespace, lr.Name, status.Revoked, status.RevokedRequests, status.Failed)
+
+	err = c.updateLeaseRevocationStatus(&status, lr)
+	if err != nil {
+		return errors.Wrap(err, "failed to update status")
+	}
+	if status.Failed > 0 {
+		return errors.Errorf("failed to revoke %d targets", status.Failed)
+	}
+	return nil
+}
+
+// getRevocationTargets returns the lease prefixes to revoke for the scope
+// of the LeaseRevocation, along with the access requests holding leases under them.
+// The targets, which can not be resolved, are returned as failures.
+func (c *VaultController) getRevocationTargets(lr *api.LeaseRevocation, holders []leaseHolder) ([]revocationTarget, []api.LeaseRevocationFailure) {
+	var targets []revocationTarget
+	var failures []api.LeaseRevocationFailure
+
+	// vault reference of the roles of the access requests
+	holderVaultRefs := make([]*appcat.AppReference, len(holders))
+	for i, h := range holders {
+		holderVaultRefs[i], _, _, _ = c.resolveRoleLeases(h.roleKind, h.roleRef.Namespace, h.roleRef.Name)
+	}
+
+	scope := lr.Spec.Scope
+	switch {
+	case scope.RoleRef != nil:
+		// the scope is limited to the namespace of the LeaseRevocation
+		ns := lr.Namespace
+		ref, _, prefixes, err := c.resolveRoleLeases(scope.RoleRef.Kind, ns, scope.RoleRef.Name)
+		if err != nil {
+			failures = append(failures, api.LeaseRevocationFailure{
+				Target:  fmt.Sprintf("%s %s/%s", scope.RoleRef.Kind, ns, scope.RoleRef.Name),
+				Message: err.Error(),
+			})
+			break
+		}
+		for _, p := range prefixes {
+			targets = append(targets, revocationTarget{vaultRef: *ref, prefix: p})
+		}
+
+	case scope.SecretEngineRef != nil:
+		se, err := c.secretEngineLister.SecretEngines(lr.Namespace).Get(scope.SecretEngineRef.Name)
+		if err != nil {
+			failures = append(failures, api.LeaseRevocationFailure{
+				Target:  fmt.Sprintf("%s %s/%s", api.ResourceKindSecretEngine, lr.Namespace, scope.SecretEngineRef.Name),
+				Message: err.Error(),
+			})
+			break
+		}
+		targets = append(targets, revocationTarget{
+			vaultRef: appcat.AppReference{Namespace: se.Namespace, Name: se.Spec.VaultRef.Name},
+			prefix:   engine.GetSecretEnginePath(se),
+		})
+
+	case scope.Namespace != "":
+		for i, h := range holders {
+			if h.namespace != lr.Namespace {
+				continue
+			}
+			if holderVaultRefs[i] == nil {
+				failures = append(failures, api.LeaseRevocationFailure{
+					Target:  h.String(),
+					Message: fmt.Sprintf("failed to get %s %s/%s", h.roleKind, h.roleRef.Namespace, h.roleRef.Name),
+				})
+				continue
+			}
+			targets = append(targets, revocationTarget{vaultRef: *holderVaultRefs[i], prefix: h.leaseID})
+		}
+
+	case scope.Prefix != "":
+		targets = append(targets, revocationTarget{
+			vaultRef: appcat.AppReference{Namespace: lr.Namespace, Name: lr.Spec.VaultRef.Name},
+			prefix:   scope.Prefix,
+		})
+	}
+
+	for i := range targets {
+		targets[i].prefix = strings.Trim(targets[i].prefix, "/")
+		for j, h := range holders {
+			if holderVaultRefs[j] != nil && *holderVaultRefs[j] == targets[i].vaultRef && isUnderLeasePrefix(h.leaseID, targets[i].prefix) {
+				targets[i].holders = append(targets[i].holders, h)
+			}
+		}
+	}
+	return targets, failures
+}
+
+// isUnderLeasePrefix checks whether the lease is under the prefix
+func isUnderLeasePrefix(leaseID, prefix string) bool {
+	return leaseID == prefix || strings.HasPrefix(leaseID, prefix+"/")
+}
+
+func (c *VaultController) updateLeaseRevocationStatus(status *api.LeaseRevocationStatus, lr *api.LeaseRevocation) error {
+	_, err := patchutil.UpdateLeaseRevocationStatus(c.extClient.EngineV1alpha1(), lr, func(s *api.LeaseRevocationStatus) *api.LeaseRevocationStatus {
+		return status
+	})
+	return err
+}
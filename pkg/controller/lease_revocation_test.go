@@ -0,0 +1,197 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	engine_listers "kubevault.dev/operator/client/listers/engine/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+func TestIsUnderLeasePrefix(t *testing.T) {
+	assert.True(t, isUnderLeasePrefix("database/creds/my-role/abcd", "database/creds/my-role"))
+	assert.True(t, isUnderLeasePrefix("database/creds/my-role/abcd", "database/creds/my-role/abcd"))
+	assert.True(t, isUnderLeasePrefix("database/creds/my-role/abcd", "database"))
+	assert.False(t, isUnderLeasePrefix("database/creds/my-role-2/abcd", "database/creds/my-role"))
+	assert.False(t, isUnderLeasePrefix("aws/creds/my-role/abcd", "database"))
+}
+
+func TestGetRevocationTargets(t *testing.T) {
+	awsRole := &api.AWSRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-role",
+			Namespace: "demo",
+		},
+		Spec: api.AWSRoleSpec{
+			VaultRef: core.LocalObjectReference{Name: "vault"},
+		},
+	}
+	roleIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, roleIndexer.Add(awsRole))
+
+	c := &VaultController{
+		awsRoleLister:      engine_listers.NewAWSRoleLister(roleIndexer),
+		secretEngineLister: engine_listers.NewSecretEngineLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+	}
+
+	roleRef := api.RoleRef{Name: "my-role", Namespace: "demo"}
+	credsPrefix := "aws/creds/" + awsRole.RoleName()
+	stsPrefix := "aws/sts/" + awsRole.RoleName()
+	holders := []leaseHolder{
+		{api.ResourceKindAWSAccessKeyRequest, "demo", "creds", api.ResourceKindAWSRole, roleRef, credsPrefix + "/abcd"},
+		{api.ResourceKindAWSAccessKeyRequest, "app", "sts", api.ResourceKindAWSRole, roleRef, stsPrefix + "/efgh"},
+	}
+	vaultRef := appcat.AppReference{Namespace: "demo", Name: "vault"}
+
+	cases := []struct {
+		testName       string
+		scope          api.LeaseRevocationScope
+		expectTargets  []revocationTarget
+		expectFailures int
+	}{
+		{
+			testName: "role scope",
+			scope: api.LeaseRevocationScope{
+				RoleRef: &api.RoleRef{Kind: api.ResourceKindAWSRole, Name: "my-role"},
+			},
+			expectTargets: []revocationTarget{
+				{vaultRef: vaultRef, prefix: credsPrefix, holders: holders[:1]},
+				{vaultRef: vaultRef, prefix: stsPrefix, holders: holders[1:]},
+			},
+		},
+		{
+			testName: "namespace scope",
+			scope: api.LeaseRevocationScope{
+				Namespace: "demo",
+			},
+			expectTargets: []revocationTarget{
+				{vaultRef: vaultRef, prefix: credsPrefix + "/abcd", holders: holders[:1]},
+			},
+		},
+		{
+			testName: "prefix scope",
+			scope: api.LeaseRevocationScope{
+				Prefix: "aws/",
+			},
+			expectTargets: []revocationTarget{
+				{vaultRef: vaultRef, prefix: "aws", holders: holders},
+			},
+		},
+		{
+			testName: "unknown secret engine",
+			scope: api.LeaseRevocationScope{
+				SecretEngineRef: &core.LocalObjectReference{Name: "unknown"},
+			},
+			expectFailures: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			lr := &api.LeaseRevocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "revoke",
+					Namespace: "demo",
+				},
+				Spec: api.LeaseRevocationSpec{
+					Scope:    tc.scope,
+					VaultRef: core.LocalObjectReference{Name: "vault"},
+				},
+			}
+			targets, failures := c.getRevocationTargets(lr, holders)
+			assert.Equal(t, tc.expectTargets, targets)
+			assert.Len(t, failures, tc.expectFailures)
+		})
+	}
+}
+
+func TestLeaseRevocationIsValid(t *testing.T) {
+	cases := []struct {
+		testName  string
+		scope     api.LeaseRevocationScope
+		expectErr bool
+	}{
+		{
+			testName: "role in the same namespace",
+			scope: api.LeaseRevocationScope{
+				RoleRef: &api.RoleRef{Kind: api.ResourceKindAWSRole, Name: "my-role", Namespace: "demo"},
+			},
+		},
+		{
+			testName: "role in another namespace",
+			scope: api.LeaseRevocationScope{
+				RoleRef: &api.RoleRef{Kind: api.ResourceKindAWSRole, Name: "my-role", Namespace: "app"},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "another namespace",
+			scope: api.LeaseRevocationScope{
+				Namespace: "app",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "lease prefix",
+			scope: api.LeaseRevocationScope{
+				Prefix: "database/creds/my-role",
+			},
+		},
+		{
+			testName: "root lease prefix",
+			scope: api.LeaseRevocationScope{
+				Prefix: "/",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "more than one scope",
+			scope: api.LeaseRevocationScope{
+				Namespace: "demo",
+				Prefix:    "database",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.testName, func(t *testing.T) {
+			lr := api.LeaseRevocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "revoke",
+					Namespace: "demo",
+				},
+				Spec: api.LeaseRevocationSpec{
+					Scope:    tc.scope,
+					VaultRef: core.LocalObjectReference{Name: "vault"},
+				},
+			}
+			err := lr.IsValid()
+			if tc.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
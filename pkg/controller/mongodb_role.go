@@ -155,6 +155,7 @@ func (c *VaultController) runMongoDBRoleFinalizer(mRole *api.MongoDBRole, timeou
 
 	stopCh := time.After(timeout)
 	finalizationDone := false
+	blocked := false
 	timeOutOccured := false
 	attempt := 0
 
@@ -177,6 +178,7 @@ func (c *VaultController) runMongoDBRoleFinalizer(mRole *api.MongoDBRole, timeou
 				glog.Errorf("MongoDBRole %s/%s finalizer: %v", mRole.Namespace, mRole.Name, err)
 			} else {
 				err = c.finalizeMongoDBRole(d, mRole)
+				blocked = errors.Cause(err) == errDeletionBlocked
 				if err != nil {
 					glog.Errorf("MongoDBRole %s/%s finalizer: %v", mRole.Namespace, mRole.Name, err)
 				} else {
@@ -202,6 +204,14 @@ func (c *VaultController) runMongoDBRoleFinalizer(mRole *api.MongoDBRole, timeou
 		attempt++
 	}
 
+	if blocked {
+		// keep the finalizer, as the deletion is blocked by live leases
+		glog.Infof("MongoDBRole %s/%s finalizer: deletion is blocked by live leases, retrying after %v", mRole.Namespace, mRole.Name, blockedDeletionInterval)
+		c.finalizerInfo.Delete(id)
+		requeueBlockedDeletion(c.mgRoleQueue, mRole.ObjectMeta)
+		return
+	}
+
 	err := c.removeMongoDBRoleFinalizer(mRole)
 	if err != nil {
 		glog.Errorf("MongoDBRole %s/%s finalizer: removing finalizer %v", mRole.Namespace, mRole.Name, err)
@@ -214,10 +224,15 @@ func (c *VaultController) runMongoDBRoleFinalizer(mRole *api.MongoDBRole, timeou
 }
 
 // Do:
+//	- apply the revocation policy to the leases issued against the role
 //	- delete role in vault
-//	- revoke lease of all the corresponding mongodbRoleBinding
 func (c *VaultController) finalizeMongoDBRole(dbRClient database.DatabaseRoleInterface, mRole *api.MongoDBRole) error {
-	err := dbRClient.DeleteRole(mRole.RoleName())
+	err := c.revokeRoleLeases(dbRClient, mRole.Spec.RevocationPolicy, api.ResourceKindMongoDBRole, mRole.ObjectMeta, mRole.RoleName())
+	if err != nil {
+		return err
+	}
+
+	err = dbRClient.DeleteRole(mRole.RoleName())
 	if err != nil {
 		return errors.Wrap(err, "failed to database role")
 	}
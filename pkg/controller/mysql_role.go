@@ -153,6 +153,7 @@ func (c *VaultController) runMySQLRoleFinalizer(mRole *api.MySQLRole, timeout ti
 
 	stopCh := time.After(timeout)
 	finalizationDone := false
+	blocked := false
 	timeOutOccured := false
 	attempt := 0
 
@@ -175,6 +176,7 @@ func (c *VaultController) runMySQLRoleFinalizer(mRole *api.MySQLRole, timeout ti
 				glog.Errorf("MySQLRole %s/%s finalizer: %v", mRole.Namespace, mRole.Name, err)
 			} else {
 				err = c.finalizeMySQLRole(d, mRole)
+				blocked = errors.Cause(err) == errDeletionBlocked
 				if err != nil {
 					glog.Errorf("MySQLRole %s/%s finalizer: %v", mRole.Namespace, mRole.Name, err)
 				} else {
@@ -200,6 +202,14 @@ func (c *VaultController) runMySQLRoleFinalizer(mRole *api.MySQLRole, timeout ti
 		attempt++
 	}
 
+	if blocked {
+		// keep the finalizer, as the deletion is blocked by live leases
+		glog.Infof("MySQLRole %s/%s finalizer: deletion is blocked by live leases, retrying after %v", mRole.Namespace, mRole.Name, blockedDeletionInterval)
+		c.finalizerInfo.Delete(id)
+		requeueBlockedDeletion(c.myRoleQueue, mRole.ObjectMeta)
+		return
+	}
+
 	err := c.removeMySQLRoleFinalizer(mRole)
 	if err != nil {
 		glog.Errorf("MySQLRole %s/%s finalizer: removing finalizer %v", mRole.Namespace, mRole.Name, err)
@@ -212,10 +222,15 @@ func (c *VaultController) runMySQLRoleFinalizer(mRole *api.MySQLRole, timeout ti
 }
 
 // Do:
+//	- apply the revocation policy to the leases issued against the role
 //	- delete role in vault
-//	- revoke lease of all the corresponding mysqlRoleBinding
 func (c *VaultController) finalizeMySQLRole(dbRClient database.DatabaseRoleInterface, mRole *api.MySQLRole) error {
-	err := dbRClient.DeleteRole(mRole.RoleName())
+	err := c.revokeRoleLeases(dbRClient, mRole.Spec.RevocationPolicy, api.ResourceKindMySQLRole, mRole.ObjectMeta, mRole.RoleName())
+	if err != nil {
+		return err
+	}
+
+	err = dbRClient.DeleteRole(mRole.RoleName())
 	if err != nil {
 		return errors.Wrap(err, "failed to database role")
 	}
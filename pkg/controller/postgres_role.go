@@ -153,6 +153,7 @@ func (c *VaultController) runPostgresRoleFinalizer(pgRole *api.PostgresRole, tim
 
 	stopCh := time.After(timeout)
 	finalizationDone := false
+	blocked := false
 	timeOutOccured := false
 	attempt := 0
 
@@ -175,6 +176,7 @@ func (c *VaultController) runPostgresRoleFinalizer(pgRole *api.PostgresRole, tim
 				glog.Errorf("PostgresRole %s/%s finalizer: %v", pgRole.Namespace, pgRole.Name, err)
 			} else {
 				err = c.finalizePostgresRole(d, pgRole)
+				blocked = errors.Cause(err) == errDeletionBlocked
 				if err != nil {
 					glog.Errorf("PostgresRole %s/%s finalizer: %v", pgRole.Namespace, pgRole.Name, err)
 				} else {
@@ -200,6 +202,14 @@ func (c *VaultController) runPostgresRoleFinalizer(pgRole *api.PostgresRole, tim
 		attempt++
 	}
 
+	if blocked {
+		// keep the finalizer, as the deletion is blocked by live leases
+		glog.Infof("PostgresRole %s/%s finalizer: deletion is blocked by live leases, retrying after %v", pgRole.Namespace, pgRole.Name, blockedDeletionInterval)
+		c.finalizerInfo.Delete(id)
+		requeueBlockedDeletion(c.pgRoleQueue, pgRole.ObjectMeta)
+		return
+	}
+
 	err := c.removePostgresRoleFinalizer(pgRole)
 	if err != nil {
 		glog.Errorf("PostgresRole %s/%s finalizer: removing finalizer %v", pgRole.Namespace, pgRole.Name, err)
@@ -212,10 +222,15 @@ func (c *VaultController) runPostgresRoleFinalizer(pgRole *api.PostgresRole, tim
 }
 
 // Do:
+//	- apply the revocation policy to the leases issued against the role
 //	- delete role in vault
-//	- revoke lease of all the corresponding postgresRoleBinding
 func (c *VaultController) finalizePostgresRole(dbRClient database.DatabaseRoleInterface, pgRole *api.PostgresRole) error {
-	err := dbRClient.DeleteRole(pgRole.RoleName())
+	err := c.revokeRoleLeases(dbRClient, pgRole.Spec.RevocationPolicy, api.ResourceKindPostgresRole, pgRole.ObjectMeta, pgRole.RoleName())
+	if err != nil {
+		return err
+	}
+
+	err = dbRClient.DeleteRole(pgRole.RoleName())
 	if err != nil {
 		return errors.Wrap(err, "failed to database role")
 	}
@@ -53,6 +53,14 @@ func (f *fakeDRole) DeleteRole(name string) error {
 	return nil
 }
 
+func (f *fakeDRole) HasLeases(name string) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeDRole) RevokeLeases(name string) error {
+	return nil
+}
+
 func (f *fakeDRole) CreateConfig() error {
 	if f.errorOccurredInCreateConfig {
 		return fmt.Errorf("error")
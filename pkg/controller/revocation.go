@@ -0,0 +1,345 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault/role"
+	"kubevault.dev/operator/pkg/vault/role/aws"
+	"kubevault.dev/operator/pkg/vault/role/azure"
+	"kubevault.dev/operator/pkg/vault/role/database"
+	"kubevault.dev/operator/pkg/vault/role/gcp"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"kmodules.xyz/client-go/tools/queue"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+const (
+	// interval to retry the deletion, which is blocked by live leases
+	blockedDeletionInterval = time.Minute
+
+	RoleDeleted           = "RoleDeleted"
+	SecretEngineDeleted   = "SecretEngineDeleted"
+	SecretEngineRemounted = "SecretEngineRemounted"
+)
+
+var errDeletionBlocked = errors.New("deletion is blocked by live leases")
+
+// applyRevocationPolicy applies the revocation policy to the leases
+// issued against a role or secret engine, before it is deleted.
+// It returns true if the leases are revoked.
+func applyRevocationPolicy(policy api.RevocationPolicy, hasLeases func() (bool, error), revokeLeases func() error) (bool, error) {
+	switch policy {
+	case api.RevocationPolicyRevokeLeases:
+		if err := revokeLeases(); err != nil {
+			return false, err
+		}
+		return true, nil
+	case api.RevocationPolicyBlock:
+		ok, err := hasLeases()
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return false, errDeletionBlocked
+		}
+	}
+	return false, nil
+}
+
+// requeueBlockedDeletion retries the deletion later, while it is blocked by live leases
+func requeueBlockedDeletion(q *queue.Worker, meta metav1.ObjectMeta) {
+	if q == nil {
+		return
+	}
+	q.GetQueue().AddAfter(fmt.Sprintf("%s/%s", meta.Namespace, meta.Name), blockedDeletionInterval)
+}
+
+// refersToRole checks whether the RoleRef of an access request refers to the role.
+// Kind is only checked if it is set, as it is optional for all but database roles.
+func refersToRole(ref api.RoleRef, kind, namespace, name string) bool {
+	if ref.Kind != "" && ref.Kind != kind {
+		return false
+	}
+	return ref.Namespace == namespace && ref.Name == name
+}
+
+// leaseHolder is an access request, which holds the lease of a credential
+type leaseHolder struct {
+	kind      string
+	namespace string
+	name      string
+	roleKind  string
+	roleRef   api.RoleRef
+	leaseID   string
+}
+
+func (h leaseHolder) String() string {
+	return fmt.Sprintf("%s %s/%s", h.kind, h.namespace, h.name)
+}
+
+// listLeaseHolders lists the access requests, which hold the lease of a credential
+func (c *VaultController) listLeaseHolders() ([]leaseHolder, error) {
+	var holders []leaseHolder
+	sel := labels.Everything()
+
+	dbReqs, err := c.dbAccessLister.List(sel)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range dbReqs {
+		if r.Status.Lease != nil {
+			holders = append(holders, leaseHolder{api.ResourceKindDatabaseAccessRequest, r.Namespace, r.Name, r.Spec.RoleRef.Kind, r.Spec.RoleRef, r.Status.Lease.ID})
+		}
+	}
+
+	awsReqs, err := c.awsAccessLister.List(sel)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range awsReqs {
+		if r.Status.Lease != nil {
+			holders = append(holders, leaseHolder{api.ResourceKindAWSAccessKeyRequest, r.Namespace, r.Name, api.ResourceKindAWSRole, r.Spec.RoleRef, r.Status.Lease.ID})
+		}
+	}
+
+	gcpReqs, err := c.gcpAccessLister.List(sel)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range gcpReqs {
+		if r.Status.Lease != nil {
+			holders = append(holders, leaseHolder{api.ResourceKindGCPAccessKeyRequest, r.Namespace, r.Name, api.ResourceKindGCPRole, r.Spec.RoleRef, r.Status.Lease.ID})
+		}
+	}
+
+	azureReqs, err := c.azureAccessLister.List(sel)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range azureReqs {
+		if r.Status.Lease != nil {
+			holders = append(holders, leaseHolder{api.ResourceKindAzureAccessKeyRequest, r.Namespace, r.Name, api.ResourceKindAzureRole, r.Spec.RoleRef, r.Status.Lease.ID})
+		}
+	}
+	return holders, nil
+}
+
+// markLeaseHolder adds the condition to the access request
+func (c *VaultController) markLeaseHolder(h leaseHolder, condType api.RequestConditionType, reason, msg string) error {
+	var err error
+	switch h.kind {
+	case api.ResourceKindDatabaseAccessRequest:
+		r, err2 := c.dbAccessLister.DatabaseAccessRequests(h.namespace).Get(h.name)
+		if err2 != nil {
+			return err2
+		}
+		_, err = patchutil.UpdateDatabaseAccessRequestStatus(c.extClient.EngineV1alpha1(), r, func(s *api.DatabaseAccessRequestStatus) *api.DatabaseAccessRequestStatus {
+			s.Conditions = UpsertDatabaseAccessCondition(s.Conditions, api.DatabaseAccessRequestCondition{
+				Type:           condType,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+			return s
+		})
+
+	case api.ResourceKindAWSAccessKeyRequest:
+		r, err2 := c.awsAccessLister.AWSAccessKeyRequests(h.namespace).Get(h.name)
+		if err2 != nil {
+			return err2
+		}
+		_, err = patchutil.UpdateAWSAccessKeyRequestStatus(c.extClient.EngineV1alpha1(), r, func(s *api.AWSAccessKeyRequestStatus) *api.AWSAccessKeyRequestStatus {
+			s.Conditions = UpsertAWSAccessKeyCondition(s.Conditions, api.AWSAccessKeyRequestCondition{
+				Type:           condType,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+			return s
+		})
+
+	case api.ResourceKindGCPAccessKeyRequest:
+		r, err2 := c.gcpAccessLister.GCPAccessKeyRequests(h.namespace).Get(h.name)
+		if err2 != nil {
+			return err2
+		}
+		_, err = patchutil.UpdateGCPAccessKeyRequestStatus(c.extClient.EngineV1alpha1(), r, func(s *api.GCPAccessKeyRequestStatus) *api.GCPAccessKeyRequestStatus {
+			s.Conditions = UpsertGCPAccessKeyCondition(s.Conditions, api.GCPAccessKeyRequestCondition{
+				Type:           condType,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+			return s
+		})
+
+	case api.ResourceKindAzureAccessKeyRequest:
+		r, err2 := c.azureAccessLister.AzureAccessKeyRequests(h.namespace).Get(h.name)
+		if err2 != nil {
+			return err2
+		}
+		_, err = patchutil.UpdateAzureAccessKeyRequestStatus(c.extClient.EngineV1alpha1(), r, func(s *api.AzureAccessKeyRequestStatus) *api.AzureAccessKeyRequestStatus {
+			s.Conditions = UpsertAzureAccessKeyCondition(s.Conditions, api.AzureAccessKeyRequestCondition{
+				Type:           condType,
+				Reason:         reason,
+				Message:        msg,
+				LastUpdateTime: metav1.Now(),
+			})
+			return s
+		})
+	}
+	return err
+}
+
+// resolveRoleLeases returns the vault reference, the secret engine path
+// and the lease prefixes of the role
+func (c *VaultController) resolveRoleLeases(kind, namespace, name string) (*appcat.AppReference, string, []string, error) {
+	var vaultRef, path string
+	var prefixes []string
+
+	switch kind {
+	case api.ResourceKindAWSRole:
+		r, err := c.awsRoleLister.AWSRoles(namespace).Get(name)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		path, _ = aws.GetAWSPath(r)
+		vaultRef, prefixes = r.Spec.VaultRef.Name, aws.LeasePrefixes(path, r.RoleName())
+
+	case api.ResourceKindGCPRole:
+		r, err := c.gcpRoleLister.GCPRoles(namespace).Get(name)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		path, _ = gcp.GetGCPPath(r)
+		vaultRef, prefixes = r.Spec.VaultRef.Name, gcp.LeasePrefixes(path, r.RoleType(), r.RoleName())
+
+	case api.ResourceKindAzureRole:
+		r, err := c.azureRoleLister.AzureRoles(namespace).Get(name)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		path, _ = azure.GetAzurePath(r)
+		vaultRef, prefixes = r.Spec.VaultRef.Name, azure.LeasePrefixes(path, r.RoleName())
+
+	case api.ResourceKindMySQLRole:
+		r, err := c.myRoleLister.MySQLRoles(namespace).Get(name)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		path, _ = database.GetMySQLDatabasePath(r)
+		vaultRef, prefixes = r.Spec.VaultRef.Name, database.LeasePrefixes(path, r.RoleName())
+
+	case api.ResourceKindPostgresRole:
+		r, err := c.pgRoleLister.PostgresRoles(namespace).Get(name)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		path, _ = database.GetPostgresDatabasePath(r)
+		vaultRef, prefixes = r.Spec.VaultRef.Name, database.LeasePrefixes(path, r.RoleName())
+
+	case api.ResourceKindMongoDBRole:
+		r, err := c.mgRoleLister.MongoDBRoles(namespace).Get(name)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		path, _ = database.GetMongoDBDatabasePath(r)
+		vaultRef, prefixes = r.Spec.VaultRef.Name, database.LeasePrefixes(path, r.RoleName())
+
+	default:
+		return nil, "", nil, errors.Errorf("unknown role kind %q", kind)
+	}
+
+	return &appcat.AppReference{Namespace: namespace, Name: vaultRef}, path, prefixes, nil
+}
+
+// reportLeaseRevoked reports through the access requests that referenced the role,
+// that the leases of their credentials are revoked
+func (c *VaultController) reportLeaseRevoked(kind, namespace, name, reason, msg string) error {
+	holders, err := c.listLeaseHolders()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, h := range holders {
+		if h.roleKind == kind && refersToRole(h.roleRef, kind, namespace, name) {
+			errs = append(errs, c.markLeaseHolder(h, api.LeaseRevoked, reason, msg))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// reportSecretEngineLeaseRevoked reports through the access requests that referenced
+// the roles of the secret engine at any of the paths, that the leases of their credentials are revoked
+func (c *VaultController) reportSecretEngineLeaseRevoked(secretEngine *api.SecretEngine, paths []string, reason, msg string) error {
+	holders, err := c.listLeaseHolders()
+	if err != nil {
+		return err
+	}
+
+	vaultRef := appcat.AppReference{Namespace: secretEngine.Namespace, Name: secretEngine.Spec.VaultRef.Name}
+
+	var errs []error
+	for _, h := range holders {
+		ref, p, _, err := c.resolveRoleLeases(h.roleKind, h.roleRef.Namespace, h.roleRef.Name)
+		if err != nil || !containsPath(paths, p) || *ref != vaultRef {
+			continue
+		}
+		errs = append(errs, c.markLeaseHolder(h, api.LeaseRevoked, reason, msg))
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+// revokeRoleLeases applies the revocation policy of the role, before it is deleted
+func (c *VaultController) revokeRoleLeases(l role.LeaseInterface, policy api.RevocationPolicy, kind string, meta metav1.ObjectMeta, roleName string) error {
+	revoked, err := applyRevocationPolicy(policy, func() (bool, error) {
+		return l.HasLeases(roleName)
+	}, func() error {
+		return l.RevokeLeases(roleName)
+	})
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return nil
+	}
+
+	msg := fmt.Sprintf("lease is revoked, as %s %s/%s is deleted", kind, meta.Namespace, meta.Name)
+	err = c.reportLeaseRevoked(kind, meta.Namespace, meta.Name, RoleDeleted, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed to report revoked leases")
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	opfake "kubevault.dev/operator/client/clientset/versioned/fake"
+	engine_listers "kubevault.dev/operator/client/listers/engine/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestApplyRevocationPolicy(t *testing.T) {
+	cases := []struct {
+		testName      string
+		policy        api.RevocationPolicy
+		hasLeases     bool
+		lookupErr     error
+		expectRevoked bool
+		expectErr     error
+	}{
+		{
+			testName:      "default policy retains leases",
+			policy:        "",
+			hasLeases:     true,
+			expectRevoked: false,
+		},
+		{
+			testName:      "Retain keeps leases",
+			policy:        api.RevocationPolicyRetain,
+			hasLeases:     true,
+			expectRevoked: false,
+		},
+		{
+			testName:      "RevokeLeases revokes leases",
+			policy:        api.RevocationPolicyRevokeLeases,
+			hasLeases:     true,
+			expectRevoked: true,
+		},
+		{
+			testName:      "Block refuses deletion while leases exist",
+			policy:        api.RevocationPolicyBlock,
+			hasLeases:     true,
+			expectRevoked: false,
+			expectErr:     errDeletionBlocked,
+		},
+		{
+			testName:      "Block allows deletion without leases",
+			policy:        api.RevocationPolicyBlock,
+			hasLeases:     false,
+			expectRevoked: false,
+		},
+		{
+			testName:      "Block fails on lookup error",
+			policy:        api.RevocationPolicyBlock,
+			lookupErr:     fmt.Errorf("error"),
+			expectRevoked: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			revokeCalled := false
+			revoked, err := applyRevocationPolicy(c.policy, func() (bool, error) {
+				return c.hasLeases, c.lookupErr
+			}, func() error {
+				revokeCalled = true
+				return nil
+			})
+
+			assert.Equal(t, c.expectRevoked, revoked)
+			assert.Equal(t, c.expectRevoked, revokeCalled)
+			if c.lookupErr != nil {
+				assert.NotNil(t, err)
+			} else {
+				assert.Equal(t, c.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestRefersToRole(t *testing.T) {
+	cases := []struct {
+		testName string
+		ref      api.RoleRef
+		expected bool
+	}{
+		{
+			testName: "same role",
+			ref:      api.RoleRef{Kind: api.ResourceKindMySQLRole, Namespace: "demo", Name: "my-role"},
+			expected: true,
+		},
+		{
+			testName: "kind is not set",
+			ref:      api.RoleRef{Namespace: "demo", Name: "my-role"},
+			expected: true,
+		},
+		{
+			testName: "different kind",
+			ref:      api.RoleRef{Kind: api.ResourceKindPostgresRole, Namespace: "demo", Name: "my-role"},
+			expected: false,
+		},
+		{
+			testName: "different namespace",
+			ref:      api.RoleRef{Kind: api.ResourceKindMySQLRole, Namespace: "default", Name: "my-role"},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			assert.Equal(t, c.expected, refersToRole(c.ref, api.ResourceKindMySQLRole, "demo", "my-role"))
+		})
+	}
+}
+
+func TestReportSecretEngineLeaseRevoked(t *testing.T) {
+	secretEngine := &api.SecretEngine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "aws-engine",
+			Namespace: "demo",
+		},
+		Spec: api.SecretEngineSpec{
+			VaultRef: core.LocalObjectReference{Name: "vault"},
+			Path:     "new-aws",
+		},
+	}
+
+	roleIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	reqIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	cs := opfake.NewSimpleClientset()
+	for _, r := range []struct{ name, path, vault string }{
+		{"old-path", "aws", "vault"},
+		{"new-path", "new-aws", "vault"},
+		{"other-path", "other-aws", "vault"},
+		{"other-vault", "aws", "other-vault"},
+	} {
+		role := &api.AWSRole{
+			ObjectMeta: metav1.ObjectMeta{Name: r.name, Namespace: "demo"},
+			Spec: api.AWSRoleSpec{
+				VaultRef: core.LocalObjectReference{Name: r.vault},
+				Path:     r.path,
+			},
+		}
+		assert.Nil(t, roleIndexer.Add(role))
+
+		req := &api.AWSAccessKeyRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: r.name, Namespace: "demo"},
+			Spec: api.AWSAccessKeyRequestSpec{
+				RoleRef: api.RoleRef{Name: r.name, Namespace: "demo"},
+			},
+			Status: api.AWSAccessKeyRequestStatus{
+				Lease: &api.Lease{ID: r.path + "/creds/" + r.name + "/abcd"},
+			},
+		}
+		assert.Nil(t, reqIndexer.Add(req))
+		_, err := cs.EngineV1alpha1().AWSAccessKeyRequests("demo").Create(req)
+		assert.Nil(t, err)
+	}
+
+	emptyIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	c := &VaultController{
+		extClient:         cs,
+		awsRoleLister:     engine_listers.NewAWSRoleLister(roleIndexer),
+		awsAccessLister:   engine_listers.NewAWSAccessKeyRequestLister(reqIndexer),
+		dbAccessLister:    engine_listers.NewDatabaseAccessRequestLister(emptyIndexer),
+		gcpAccessLister:   engine_listers.NewGCPAccessKeyRequestLister(emptyIndexer),
+		azureAccessLister: engine_listers.NewAzureAccessKeyRequestLister(emptyIndexer),
+	}
+
+	err := c.reportSecretEngineLeaseRevoked(secretEngine, []string{"aws", "new-aws"}, SecretEngineRemounted, "remounted")
+	assert.Nil(t, err)
+
+	expectRevoked := map[string]bool{
+		"old-path":    true,
+		"new-path":    true,
+		"other-path":  false,
+		"other-vault": false,
+	}
+	for name, revoked := range expectRevoked {
+		req, err := cs.EngineV1alpha1().AWSAccessKeyRequests("demo").Get(name, metav1.GetOptions{})
+		if assert.Nil(t, err) {
+			found := false
+			for _, cond := range req.Status.Conditions {
+				if cond.Type == api.LeaseRevoked && cond.Reason == SecretEngineRemounted {
+					found = true
+				}
+			}
+			assert.Equal(t, revoked, found, name)
+		}
+	}
+}
@@ -91,7 +91,9 @@ func (c *VaultController) runSecretEngineInjector(key string) error {
 
 //	For vault:
 //	  - create policy and update auth role for s/a of VaultAppRef
+//	  - move the secrets engine, if the path is changed
 //	  - enable the secrets engine if it is not already enabled
+//	  - tune the mount configuration of the secrets engine
 //	  - configure Vault secret engine
 //    - create policy and policybinding for s/a of VaultAppRef
 func (c *VaultController) reconcileSecretEngine(secretEngineClient engine.EngineInterface, secretEngine *api.SecretEngine) error {
@@ -133,6 +135,36 @@ func (c *VaultController) reconcileSecretEngine(secretEngineClient engine.Engine
 		return errors.Wrap(err, "failed to update auth role")
 	}
 
+	// move the secret engine to the new path, if the path is changed.
+	// Vault revokes the leases of the old path, so the access requests
+	// holding them are marked with the LeaseRevoked condition.
+	path := engine.GetSecretEnginePath(secretEngine)
+	if status.Path != "" && status.Path != path {
+		err = secretEngineClient.RemountSecretEngine(status.Path)
+		if err != nil {
+			status.Conditions = []api.SecretEngineCondition{
+				{
+					Type:    SecretEngineConditionFailed,
+					Status:  core.ConditionTrue,
+					Reason:  "FailedToRemountSecretEngine",
+					Message: err.Error(),
+				},
+			}
+			err2 := c.updatedSecretEngineStatus(&status, secretEngine)
+			if err2 != nil {
+				return errors.Wrap(err2, "failed to update secret engine status")
+			}
+			return errors.Wrap(err, "failed to remount secret engine")
+		}
+
+		// the roles may still refer to the old path or already to the new one
+		msg := fmt.Sprintf("lease is revoked, as SecretEngine %s/%s is moved from %s to %s", secretEngine.Namespace, secretEngine.Name, status.Path, path)
+		err = c.reportSecretEngineLeaseRevoked(secretEngine, []string{status.Path, path}, SecretEngineRemounted, msg)
+		if err != nil {
+			return errors.Wrap(err, "failed to report revoked leases")
+		}
+	}
+
 	// enable the secret engine if it is not already enabled
 	err = secretEngineClient.EnableSecretEngine()
 	if err != nil {
@@ -151,6 +183,24 @@ func (c *VaultController) reconcileSecretEngine(secretEngineClient engine.Engine
 		return errors.Wrap(err, "failed to enable secret engine")
 	}
 
+	// tune the mount configuration on every sync
+	err = secretEngineClient.TuneSecretEngine()
+	if err != nil {
+		status.Conditions = []api.SecretEngineCondition{
+			{
+				Type:    SecretEngineConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  "FailedToTuneSecretEngine",
+				Message: err.Error(),
+			},
+		}
+		err2 := c.updatedSecretEngineStatus(&status, secretEngine)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to update secret engine status")
+		}
+		return errors.Wrap(err, "failed to tune secret engine")
+	}
+
 	// Create secret engine config
 	err = secretEngineClient.CreateConfig()
 	if err != nil {
@@ -169,7 +219,26 @@ func (c *VaultController) reconcileSecretEngine(secretEngineClient engine.Engine
 		return errors.Wrap(err, "failed to create secret engine config")
 	}
 
+	mount, err := secretEngineClient.GetSecretEngineMount()
+	if err != nil {
+		status.Conditions = []api.SecretEngineCondition{
+			{
+				Type:    SecretEngineConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  "FailedToGetSecretEngineMount",
+				Message: err.Error(),
+			},
+		}
+		err2 := c.updatedSecretEngineStatus(&status, secretEngine)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to update status")
+		}
+		return errors.Wrap(err, "failed to get secret engine mount")
+	}
+
 	// update status
+	status.Path = path
+	status.Mount = mount
 	status.ObservedGeneration = secretEngine.Generation
 	status.Conditions = []api.SecretEngineCondition{}
 	status.Phase = SecretEnginePhaseSuccess
@@ -206,6 +275,7 @@ func (c *VaultController) runSecretEngineFinalizer(secretEngine *api.SecretEngin
 
 	stopCh := time.After(timeout)
 	finalizationDone := false
+	blocked := false
 	timeOutOccured := false
 	attempt := 0
 
@@ -227,7 +297,8 @@ func (c *VaultController) runSecretEngineFinalizer(secretEngine *api.SecretEngin
 			if err != nil {
 				glog.Errorf("SecretEngine %s/%s finalizer: %v", secretEngine.Namespace, secretEngine.Name, err)
 			} else {
-				err = c.finalizeSecretEngine(secretEngineClient)
+				err = c.finalizeSecretEngine(secretEngineClient, secretEngine)
+				blocked = errors.Cause(err) == errDeletionBlocked
 				if err != nil {
 					glog.Errorf("SecretEngine %s/%s finalizer: %v", secretEngine.Namespace, secretEngine.Name, err)
 				} else {
@@ -253,6 +324,14 @@ func (c *VaultController) runSecretEngineFinalizer(secretEngine *api.SecretEngin
 		attempt++
 	}
 
+	if blocked {
+		// keep the finalizer, as the deletion is blocked by live leases
+		glog.Infof("SecretEngine %s/%s finalizer: deletion is blocked by live leases, retrying after %v", secretEngine.Namespace, secretEngine.Name, blockedDeletionInterval)
+		c.finalizerInfo.Delete(id)
+		requeueBlockedDeletion(c.secretEngineQueue, secretEngine.ObjectMeta)
+		return
+	}
+
 	err := c.removeSecretEngineFinalizer(secretEngine)
 	if err != nil {
 		glog.Errorf("SecretEngine %s/%s finalizer: removing finalizer %v", secretEngine.Namespace, secretEngine.Name, err)
@@ -265,11 +344,18 @@ func (c *VaultController) runSecretEngineFinalizer(secretEngine *api.SecretEngin
 }
 
 // will do:
+//	- apply the revocation policy to the leases issued by the secret engine
 //	- Delete the policy created for this secret engine
 //	- remove the policy from policy controller role
 //	- disable secret engine
-func (c *VaultController) finalizeSecretEngine(secretEngineClient *engine.SecretEngine) error {
-	err := secretEngineClient.DeletePolicyAndUpdateRole()
+//	- report the revoked leases through the access requests
+func (c *VaultController) finalizeSecretEngine(secretEngineClient *engine.SecretEngine, secretEngine *api.SecretEngine) error {
+	_, err := applyRevocationPolicy(secretEngine.Spec.RevocationPolicy, secretEngineClient.HasLeases, secretEngineClient.RevokeLeases)
+	if err != nil {
+		return err
+	}
+
+	err = secretEngineClient.DeletePolicyAndUpdateRole()
 	if err != nil {
 		return errors.Wrap(err, "failed to delete policy or update policy controller role")
 	}
@@ -278,6 +364,15 @@ func (c *VaultController) finalizeSecretEngine(secretEngineClient *engine.Secret
 	if err != nil {
 		return errors.Wrap(err, "failed to disable secret engine")
 	}
+
+	// vault revokes the remaining leases, when the secret engine is disabled
+	if secretEngine.Spec.RevocationPolicy != api.RevocationPolicyBlock {
+		msg := fmt.Sprintf("lease is revoked, as SecretEngine %s/%s is deleted", secretEngine.Namespace, secretEngine.Name)
+		err = c.reportSecretEngineLeaseRevoked(secretEngine, []string{engine.GetSecretEnginePath(secretEngine)}, SecretEngineDeleted, msg)
+		if err != nil {
+			return errors.Wrap(err, "failed to report revoked leases")
+		}
+	}
 	return nil
 }
 
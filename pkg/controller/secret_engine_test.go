@@ -33,7 +33,10 @@ type fakeSecretEngine struct {
 	errorOccurredInCreatePolicy   bool
 	errorOccurredInUpdateAuthRole bool
 	errorOccurredInEnableSE       bool
+	errorOccurredInRemountSE      bool
+	errorOccurredInTuneSE         bool
 	errorOccurredInCreateConfig   bool
+	remountedFrom                 string
 }
 
 func (f *fakeSecretEngine) IsSecretEngineEnabled() (bool, error) {
@@ -47,6 +50,28 @@ func (f *fakeSecretEngine) EnableSecretEngine() error {
 	return nil
 }
 
+func (f *fakeSecretEngine) RemountSecretEngine(from string) error {
+	if f.errorOccurredInRemountSE {
+		return fmt.Errorf("error remounting secret engine")
+	}
+	f.remountedFrom = from
+	return nil
+}
+
+func (f *fakeSecretEngine) TuneSecretEngine() error {
+	if f.errorOccurredInTuneSE {
+		return fmt.Errorf("error tuning secret engine")
+	}
+	return nil
+}
+
+func (f *fakeSecretEngine) GetSecretEngineMount() (*api.SecretEngineMountStatus, error) {
+	return &api.SecretEngineMountStatus{
+		Type:     "gcp",
+		Accessor: "gcp_4e1a5b8c",
+	}, nil
+}
+
 func (f *fakeSecretEngine) CreatePolicy() error {
 	if f.errorOccurredInCreatePolicy {
 		return fmt.Errorf("error creating policy")
@@ -86,6 +111,9 @@ func TestVaultController_reconcileSecretEngine(t *testing.T) {
 		},
 	}
 
+	movedSecretEng := secretEng.DeepCopy()
+	movedSecretEng.Status.Path = "old-gcp"
+
 	tests := []struct {
 		name               string
 		secretEngineClient engine.EngineInterface
@@ -126,6 +154,24 @@ func TestVaultController_reconcileSecretEngine(t *testing.T) {
 			secretEngine:       secretEng,
 			wantErr:            true,
 		},
+		{
+			name:               "TuneSecretEngine failed",
+			secretEngineClient: &fakeSecretEngine{errorOccurredInTuneSE: true},
+			secretEngine:       secretEng,
+			wantErr:            true,
+		},
+		{
+			name:               "Path changed, remount successful",
+			secretEngineClient: &fakeSecretEngine{},
+			secretEngine:       movedSecretEng,
+			wantErr:            false,
+		},
+		{
+			name:               "Path changed, RemountSecretEngine failed",
+			secretEngineClient: &fakeSecretEngine{errorOccurredInRemountSE: true},
+			secretEngine:       movedSecretEng,
+			wantErr:            true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -150,6 +196,11 @@ func TestVaultController_reconcileSecretEngine(t *testing.T) {
 					assert.Condition(t, func() (success bool) {
 						return len(se.Status.Conditions) == 0 && se.Status.Phase == SecretEnginePhaseSuccess
 					}, "Shouldn't have status.conditions")
+					assert.Equal(t, engine.GetSecretEnginePath(tt.secretEngine), se.Status.Path, "status.path")
+					assert.NotNil(t, se.Status.Mount, "status.mount")
+					if tt.secretEngine.Status.Path != "" {
+						assert.Equal(t, tt.secretEngine.Status.Path, tt.secretEngineClient.(*fakeSecretEngine).remountedFrom, "remounted from")
+					}
 				}
 			}
 
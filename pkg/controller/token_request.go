@@ -0,0 +1,497 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"kubevault.dev/operator/apis"
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
+	tokenrole "kubevault.dev/operator/pkg/vault/role/token"
+	"kubevault.dev/operator/pkg/vault/util"
+
+	"github.com/appscode/go/crypto/rand"
+	"github.com/golang/glog"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+	rbac_util "kmodules.xyz/client-go/rbac/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	VaultTokenRequestFailed    api.RequestConditionType = "Failed"
+	VaultTokenRequestFinalizer string                   = "vaulttokenrequest.engine.kubevault.com"
+
+	VaultTokenAccessorKey = "accessor"
+
+	// vaultTokenRenewalRetryInterval is the minimum delay to retry the failed renewal of a token
+	vaultTokenRenewalRetryInterval = time.Minute
+)
+
+func (c *VaultController) initVaultTokenRequestWatcher() {
+	c.tokenRequestInformer = c.extInformerFactory.Engine().V1alpha1().VaultTokenRequests().Informer()
+	c.tokenRequestQueue = queue.New(api.ResourceKindVaultTokenRequest, c.MaxNumRequeues, c.NumThreads, c.runVaultTokenRequestInjector)
+	c.tokenRequestInformer.AddEventHandler(queue.NewEventHandler(c.tokenRequestQueue.GetQueue(), func(oldObj, newObj interface{}) bool {
+		old := oldObj.(*api.VaultTokenRequest)
+		nu := newObj.(*api.VaultTokenRequest)
+
+		oldCondType := ""
+		nuCondType := ""
+		for _, c := range old.Status.Conditions {
+			if c.Type == api.AccessApproved || c.Type == api.AccessDenied {
+				oldCondType = string(c.Type)
+			}
+		}
+		for _, c := range nu.Status.Conditions {
+			if c.Type == api.AccessApproved || c.Type == api.AccessDenied {
+				nuCondType = string(c.Type)
+			}
+		}
+		if oldCondType != nuCondType {
+			return true
+		}
+		return nu.GetDeletionTimestamp() != nil
+	}))
+	c.tokenRequestLister = c.extInformerFactory.Engine().V1alpha1().VaultTokenRequests().Lister()
+}
+
+func (c *VaultController) runVaultTokenRequestInjector(key string) error {
+	obj, exist, err := c.tokenRequestInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exist {
+		glog.Warningf("VaultTokenRequest %s does not exist anymore", key)
+
+	} else {
+		tokenReq := obj.(*api.VaultTokenRequest).DeepCopy()
+
+		glog.Infof("Sync/Add/Update for VaultTokenRequest %s/%s", tokenReq.Namespace, tokenReq.Name)
+
+		if tokenReq.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(tokenReq.ObjectMeta, VaultTokenRequestFinalizer) {
+				go c.runVaultTokenRequestFinalizer(tokenReq, finalizerTimeout, finalizerInterval)
+			}
+		} else {
+			if !core_util.HasFinalizer(tokenReq.ObjectMeta, VaultTokenRequestFinalizer) {
+				// Add finalizer
+				_, _, err = patchutil.PatchVaultTokenRequest(c.extClient.EngineV1alpha1(), tokenReq, func(in *api.VaultTokenRequest) *api.VaultTokenRequest {
+					in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, VaultTokenRequestFinalizer)
+					return in
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set VaultTokenRequest finalizer for %s/%s", tokenReq.Namespace, tokenReq.Name)
+				}
+			}
+
+			var condType api.RequestConditionType
+			for _, c := range tokenReq.Status.Conditions {
+				if c.Type == api.AccessApproved || c.Type == api.AccessDenied {
+					condType = c.Type
+				}
+			}
+
+			if condType == api.AccessApproved {
+				trClient, err := c.newTokenRoleClientForRequest(tokenReq)
+				if err != nil {
+					return err
+				}
+
+				status, err := c.reconcileVaultTokenRequest(trClient, tokenReq)
+				if err != nil {
+					// requeue to renew the token before it expires, even if the reconciliation is failed,
+					// because the queue drops the key after a limited number of retries
+					if t := tokenReq.RenewalTime(); t != nil {
+						c.tokenRequestQueue.GetQueue().AddAfter(key, vaultTokenRenewalDelay(*t, true))
+					}
+					return errors.Wrapf(err, "For VaultTokenRequest %s/%s", tokenReq.Namespace, tokenReq.Name)
+				}
+
+				// requeue to renew the token before it expires.
+				// There is no separate lease manager for the tokens, the request is reconciled
+				// again at the renewal time, i.e. when two thirds of the TTL are passed.
+				tokenReq.Status = *status
+				if t := tokenReq.RenewalTime(); t != nil {
+					c.tokenRequestQueue.GetQueue().AddAfter(key, vaultTokenRenewalDelay(*t, false))
+				}
+			} else if condType == api.AccessDenied {
+				glog.Infof("For VaultTokenRequest %s/%s: request is denied", tokenReq.Namespace, tokenReq.Name)
+			} else {
+				glog.Infof("For VaultTokenRequest %s/%s: request is not approved yet", tokenReq.Namespace, tokenReq.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *VaultController) newTokenRoleClientForRequest(tokenReq *api.VaultTokenRequest) (tokenrole.TokenRoleInterface, error) {
+	ref := tokenReq.Spec.RoleRef
+	r, err := c.extClient.EngineV1alpha1().VaultTokenRoles(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "VaultTokenRole %s/%s", ref.Namespace, ref.Name)
+	}
+	return tokenrole.NewVaultTokenRole(c.kubeClient, c.appCatalogClient, r)
+}
+
+// Will do:
+//	For vault:
+//	  - create token against the token role
+//	  - create secret containing the token
+//	  - renew the token, if it is due
+//	  - create rbac role and role binding
+//    - sync role binding
+// It returns the updated status.
+func (c *VaultController) reconcileVaultTokenRequest(trClient tokenrole.TokenRoleInterface, tokenReq *api.VaultTokenRequest) (*api.VaultTokenRequestStatus, error) {
+	var (
+		name   = tokenReq.Name
+		ns     = tokenReq.Namespace
+		status = tokenReq.Status
+	)
+
+	var secretName string
+	if tokenReq.Status.Secret != nil {
+		secretName = tokenReq.Status.Secret.Name
+	}
+
+	// check whether token is already issued or not
+	// if not, then create token
+	if tokenReq.Status.Accessor == "" {
+		secret, err := trClient.CreateToken(tokenReq.Spec)
+		if err != nil {
+			return nil, c.failVaultTokenRequest(tokenReq, status, "FailedToCreateToken", err)
+		}
+
+		secretName = rand.WithUniqSuffix(name)
+		err = c.createVaultTokenSecret(secretName, tokenReq, secret.Auth)
+		if err != nil {
+			err2 := trClient.RevokeTokenAccessor(secret.Auth.Accessor)
+			if err2 != nil {
+				return nil, errors.Wrapf(err, "failed to revoke token with %v", err2)
+			}
+			return nil, c.failVaultTokenRequest(tokenReq, status, "FailedToCreateSecret", err)
+		}
+
+		status.Accessor = secret.Auth.Accessor
+		status.Secret = &core.LocalObjectReference{
+			Name: secretName,
+		}
+		setVaultTokenLease(&status, secret.Auth, time.Now())
+	} else if t := tokenReq.RenewalTime(); t != nil && !time.Now().Before(*t) {
+		secret, err := c.renewVaultToken(trClient, tokenReq)
+		if err != nil {
+			return nil, c.failVaultTokenRequest(tokenReq, status, "FailedToRenewToken", err)
+		}
+		setVaultTokenLease(&status, secret.Auth, time.Now())
+	}
+
+	roleName := getSecretAccessRoleName(api.ResourceKindVaultTokenRequest, ns, name)
+
+	err := c.createVaultTokenRBAC(roleName, secretName, tokenReq)
+	if err != nil {
+		return nil, c.failVaultTokenRequest(tokenReq, status, "FailedToCreateRoleBinding", err)
+	}
+
+	status.Conditions = DeleteVaultTokenCondition(status.Conditions, VaultTokenRequestFailed)
+	err = c.updateVaultTokenRequestStatus(&status, tokenReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update status")
+	}
+	return &status, nil
+}
+
+// failVaultTokenRequest sets the failure condition in the status of VaultTokenRequest
+// and returns the original error
+func (c *VaultController) failVaultTokenRequest(tokenReq *api.VaultTokenRequest, status api.VaultTokenRequestStatus, reason string, err error) error {
+	status.Conditions = UpsertVaultTokenCondition(status.Conditions, api.VaultTokenRequestCondition{
+		Type:           VaultTokenRequestFailed,
+		Reason:         reason,
+		Message:        err.Error(),
+		LastUpdateTime: metav1.Now(),
+	})
+
+	err2 := c.updateVaultTokenRequestStatus(&status, tokenReq)
+	if err2 != nil {
+		return errors.Wrapf(err, "failed to update status with %v", err2)
+	}
+	return errors.WithStack(err)
+}
+
+// renewVaultToken reads the token from the secret and renews it
+func (c *VaultController) renewVaultToken(trClient tokenrole.TokenRoleInterface, tokenReq *api.VaultTokenRequest) (*vaultapi.Secret, error) {
+	if tokenReq.Status.Secret == nil {
+		return nil, errors.New("token secret is not found")
+	}
+	secret, err := c.kubeClient.CoreV1().Secrets(tokenReq.Namespace).Get(tokenReq.Status.Secret.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get secret %s/%s", tokenReq.Namespace, tokenReq.Status.Secret.Name)
+	}
+	token, ok := secret.Data[apis.TokenAuthTokenKey]
+	if !ok {
+		return nil, errors.Errorf("token is missing in secret %s/%s", secret.Namespace, secret.Name)
+	}
+	return trClient.RenewToken(string(token))
+}
+
+// setVaultTokenLease sets the lease of the token in the status.
+// Tokens without TTL, e.g. root tokens, are not renewed.
+func setVaultTokenLease(status *api.VaultTokenRequestStatus, auth *vaultapi.SecretAuth, now time.Time) {
+	status.LeaseDuration = int64(auth.LeaseDuration)
+	status.Renewable = auth.Renewable && auth.LeaseDuration > 0
+	if auth.LeaseDuration > 0 {
+		status.ExpirationTime = &metav1.Time{Time: now.Add(time.Duration(auth.LeaseDuration) * time.Second)}
+	} else {
+		status.ExpirationTime = nil
+	}
+}
+
+// vaultTokenRenewalDelay returns the delay to renew the token at the renewal time.
+// After a failure, the renewal is retried no sooner than vaultTokenRenewalRetryInterval,
+// as the renewal time has already passed.
+func vaultTokenRenewalDelay(renewalTime time.Time, failed bool) time.Duration {
+	d := time.Until(renewalTime)
+	if failed && d < vaultTokenRenewalRetryInterval {
+		return vaultTokenRenewalRetryInterval
+	}
+	return d
+}
+
+// createVaultTokenSecret creates the secret containing the token.
+// The secret can be used as the token auth secret of an AppBinding.
+func (c *VaultController) createVaultTokenSecret(name string, tokenReq *api.VaultTokenRequest, auth *vaultapi.SecretAuth) error {
+	obj := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: tokenReq.Namespace,
+	}
+	_, _, err := core_util.CreateOrPatchSecret(c.kubeClient, obj, func(in *core.Secret) *core.Secret {
+		in.Type = apis.SecretTypeTokenAuth
+		in.Data = map[string][]byte{
+			apis.TokenAuthTokenKey: []byte(auth.ClientToken),
+			VaultTokenAccessorKey:  []byte(auth.Accessor),
+		}
+		util.EnsureOwnerRefToObject(in, getVaultTokenRequestOwnerRef(tokenReq))
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create/update secret %s/%s", tokenReq.Namespace, name)
+	}
+	return nil
+}
+
+// createVaultTokenRBAC creates rbac role and role binding that
+// grant the subjects of the request to read the secret
+func (c *VaultController) createVaultTokenRBAC(roleName, secretName string, tokenReq *api.VaultTokenRequest) error {
+	obj := metav1.ObjectMeta{
+		Name:      roleName,
+		Namespace: tokenReq.Namespace,
+	}
+	ownerRef := getVaultTokenRequestOwnerRef(tokenReq)
+
+	_, _, err := rbac_util.CreateOrPatchRole(c.kubeClient, obj, func(in *rbac.Role) *rbac.Role {
+		in.Rules = []rbac.PolicyRule{
+			{
+				APIGroups: []string{
+					"", // represents core api
+				},
+				Resources: []string{
+					"secrets",
+				},
+				ResourceNames: []string{
+					secretName,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+		}
+		util.EnsureOwnerRefToObject(in, ownerRef)
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create rbac role %s/%s", tokenReq.Namespace, roleName)
+	}
+
+	_, _, err = rbac_util.CreateOrPatchRoleBinding(c.kubeClient, obj, func(in *rbac.RoleBinding) *rbac.RoleBinding {
+		in.RoleRef = rbac.RoleRef{
+			APIGroup: rbac.GroupName,
+			Kind:     "Role",
+			Name:     roleName,
+		}
+		in.Subjects = tokenReq.Spec.Subjects
+		util.EnsureOwnerRefToObject(in, ownerRef)
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create/update rbac role binding %s/%s", tokenReq.Namespace, roleName)
+	}
+	return nil
+}
+
+func (c *VaultController) updateVaultTokenRequestStatus(status *api.VaultTokenRequestStatus, tokenReq *api.VaultTokenRequest) error {
+	_, err := patchutil.UpdateVaultTokenRequestStatus(c.extClient.EngineV1alpha1(), tokenReq, func(s *api.VaultTokenRequestStatus) *api.VaultTokenRequestStatus {
+		return status
+	})
+	return err
+}
+
+func (c *VaultController) runVaultTokenRequestFinalizer(tokenReq *api.VaultTokenRequest, timeout time.Duration, interval time.Duration) {
+	if tokenReq == nil {
+		glog.Infoln("VaultTokenRequest is nil")
+		return
+	}
+
+	id := getVaultTokenRequestId(tokenReq)
+	if c.finalizerInfo.IsAlreadyProcessing(id) {
+		// already processing
+		return
+	}
+
+	glog.Infof("Processing finalizer for VaultTokenRequest %s/%s", tokenReq.Namespace, tokenReq.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this VaultTokenRequest
+	c.finalizerInfo.Add(id)
+
+	stopCh := time.After(timeout)
+	finalizationDone := false
+	timeOutOccured := false
+	attempt := 0
+
+	for {
+		glog.Infof("VaultTokenRequest %s/%s finalizer: attempt %d\n", tokenReq.Namespace, tokenReq.Name, attempt)
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		if !finalizationDone {
+			err := c.finalizeVaultTokenRequest(tokenReq)
+			if err != nil {
+				glog.Errorf("VaultTokenRequest %s/%s finalizer: %v", tokenReq.Namespace, tokenReq.Name, err)
+			} else {
+				finalizationDone = true
+			}
+		}
+
+		if finalizationDone {
+			err := c.removeVaultTokenRequestFinalizer(tokenReq)
+			if err != nil {
+				glog.Errorf("VaultTokenRequest %s/%s finalizer: %v", tokenReq.Namespace, tokenReq.Name, err)
+			} else {
+				break
+			}
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+		attempt++
+	}
+
+	err := c.removeVaultTokenRequestFinalizer(tokenReq)
+	if err != nil {
+		glog.Errorf("VaultTokenRequest %s/%s finalizer: %v", tokenReq.Namespace, tokenReq.Name, err)
+	} else {
+		glog.Infof("Removed finalizer for VaultTokenRequest %s/%s", tokenReq.Namespace, tokenReq.Name)
+	}
+
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(id)
+}
+
+// Do:
+//	- revoke the issued token in vault
+func (c *VaultController) finalizeVaultTokenRequest(tokenReq *api.VaultTokenRequest) error {
+	if tokenReq.Status.Accessor == "" {
+		return nil
+	}
+	trClient, err := c.newTokenRoleClientForRequest(tokenReq)
+	if err != nil {
+		return err
+	}
+	return trClient.RevokeTokenAccessor(tokenReq.Status.Accessor)
+}
+
+func (c *VaultController) removeVaultTokenRequestFinalizer(tokenReq *api.VaultTokenRequest) error {
+	m, err := c.extClient.EngineV1alpha1().VaultTokenRequests(tokenReq.Namespace).Get(tokenReq.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, _, err = patchutil.PatchVaultTokenRequest(c.extClient.EngineV1alpha1(), m, func(in *api.VaultTokenRequest) *api.VaultTokenRequest {
+		in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, VaultTokenRequestFinalizer)
+		return in
+	})
+	return err
+}
+
+func getVaultTokenRequestOwnerRef(tokenReq *api.VaultTokenRequest) metav1.OwnerReference {
+	trueVar := true
+	return metav1.OwnerReference{
+		APIVersion: api.SchemeGroupVersion.String(),
+		Kind:       api.ResourceKindVaultTokenRequest,
+		Name:       tokenReq.Name,
+		UID:        tokenReq.UID,
+		Controller: &trueVar,
+	}
+}
+
+func getVaultTokenRequestId(tokenReq *api.VaultTokenRequest) string {
+	return fmt.Sprintf("%s/%s/%s", api.ResourceVaultTokenRequest, tokenReq.Namespace, tokenReq.Name)
+}
+
+func UpsertVaultTokenCondition(condList []api.VaultTokenRequestCondition, cond api.VaultTokenRequestCondition) []api.VaultTokenRequestCondition {
+	res := []api.VaultTokenRequestCondition{}
+	inserted := false
+	for _, c := range condList {
+		if c.Type == cond.Type {
+			res = append(res, cond)
+			inserted = true
+		} else {
+			res = append(res, c)
+		}
+	}
+	if !inserted {
+		res = append(res, cond)
+	}
+	return res
+}
+
+func DeleteVaultTokenCondition(condList []api.VaultTokenRequestCondition, condType api.RequestConditionType) []api.VaultTokenRequestCondition {
+	res := []api.VaultTokenRequestCondition{}
+	for _, c := range condList {
+		if c.Type != condType {
+			res = append(res, c)
+		}
+	}
+	return res
+}
@@ -0,0 +1,231 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"kubevault.dev/operator/apis"
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	csfake "kubevault.dev/operator/client/clientset/versioned/fake"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeTokenRole struct {
+	renewed []string
+}
+
+func (f *fakeTokenRole) CreateRole() error {
+	return nil
+}
+
+func (f *fakeTokenRole) DeleteRole(name string) error {
+	return nil
+}
+
+func (f *fakeTokenRole) CreateToken(spec api.VaultTokenRequestSpec) (*vaultapi.Secret, error) {
+	return &vaultapi.Secret{
+		Auth: &vaultapi.SecretAuth{
+			ClientToken:   "s.token",
+			Accessor:      "accessor-1",
+			LeaseDuration: 3600,
+			Renewable:     true,
+		},
+	}, nil
+}
+
+func (f *fakeTokenRole) RenewToken(token string) (*vaultapi.Secret, error) {
+	f.renewed = append(f.renewed, token)
+	return f.CreateToken(api.VaultTokenRequestSpec{})
+}
+
+func (f *fakeTokenRole) RevokeTokenAccessor(accessor string) error {
+	return nil
+}
+
+func TestSetVaultTokenLease(t *testing.T) {
+	now := time.Now()
+
+	cases := []struct {
+		testName           string
+		auth               *vaultapi.SecretAuth
+		expectedRenewable  bool
+		expectedExpiration *time.Time
+	}{
+		{
+			testName: "renewable token with ttl",
+			auth: &vaultapi.SecretAuth{
+				Renewable:     true,
+				LeaseDuration: 3600,
+			},
+			expectedRenewable:  true,
+			expectedExpiration: timePtr(now.Add(time.Hour)),
+		},
+		{
+			testName: "non-renewable token with ttl",
+			auth: &vaultapi.SecretAuth{
+				Renewable:     false,
+				LeaseDuration: 60,
+			},
+			expectedRenewable:  false,
+			expectedExpiration: timePtr(now.Add(time.Minute)),
+		},
+		{
+			testName: "token without ttl",
+			auth: &vaultapi.SecretAuth{
+				Renewable:     true,
+				LeaseDuration: 0,
+			},
+			expectedRenewable: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			status := &api.VaultTokenRequestStatus{}
+			setVaultTokenLease(status, c.auth, now)
+
+			assert.Equal(t, int64(c.auth.LeaseDuration), status.LeaseDuration)
+			assert.Equal(t, c.expectedRenewable, status.Renewable)
+			if c.expectedExpiration == nil {
+				assert.Nil(t, status.ExpirationTime)
+			} else if assert.NotNil(t, status.ExpirationTime) {
+				assert.True(t, c.expectedExpiration.Equal(status.ExpirationTime.Time))
+			}
+		})
+	}
+}
+
+func TestVaultTokenRenewalDelay(t *testing.T) {
+	cases := []struct {
+		testName    string
+		renewalTime time.Time
+		failed      bool
+		expectedMin time.Duration
+		expectedMax time.Duration
+	}{
+		{
+			testName:    "renewal is due later",
+			renewalTime: time.Now().Add(time.Hour),
+			expectedMin: 59 * time.Minute,
+			expectedMax: time.Hour,
+		},
+		{
+			testName:    "renewal is failed before the renewal time",
+			renewalTime: time.Now().Add(time.Hour),
+			failed:      true,
+			expectedMin: 59 * time.Minute,
+			expectedMax: time.Hour,
+		},
+		{
+			testName:    "renewal is failed after the renewal time",
+			renewalTime: time.Now().Add(-time.Minute),
+			failed:      true,
+			expectedMin: vaultTokenRenewalRetryInterval,
+			expectedMax: vaultTokenRenewalRetryInterval,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			d := vaultTokenRenewalDelay(c.renewalTime, c.failed)
+			assert.True(t, d >= c.expectedMin && d <= c.expectedMax, "delay %s", d)
+		})
+	}
+}
+
+func TestReconcileVaultTokenRequest_Renewal(t *testing.T) {
+	cases := []struct {
+		testName      string
+		issuedBefore  time.Duration
+		expectRenewal bool
+	}{
+		{
+			testName:      "periodic token at one third of its ttl, expect not renewed",
+			issuedBefore:  20 * time.Minute,
+			expectRenewal: false,
+		},
+		{
+			testName:      "periodic token at three quarters of its ttl, expect renewed before expiry",
+			issuedBefore:  45 * time.Minute,
+			expectRenewal: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			now := time.Now()
+			tokenReq := &api.VaultTokenRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "legacy",
+					Namespace: "demo",
+				},
+				Status: api.VaultTokenRequestStatus{
+					Secret:         &core.LocalObjectReference{Name: "legacy-token"},
+					Accessor:       "accessor-1",
+					Renewable:      true,
+					LeaseDuration:  3600,
+					ExpirationTime: &metav1.Time{Time: now.Add(time.Hour - c.issuedBefore)},
+				},
+			}
+			ctrl := &VaultController{
+				kubeClient: kfake.NewSimpleClientset(&core.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "legacy-token",
+						Namespace: "demo",
+					},
+					Data: map[string][]byte{
+						apis.TokenAuthTokenKey: []byte("s.token"),
+					},
+				}),
+				extClient: csfake.NewSimpleClientset(tokenReq),
+			}
+			trClient := &fakeTokenRole{}
+
+			status, err := ctrl.reconcileVaultTokenRequest(trClient, tokenReq)
+			if !assert.Nil(t, err) {
+				return
+			}
+			tokenReq.Status = *status
+			renewalTime := tokenReq.RenewalTime()
+			if !assert.NotNil(t, renewalTime) {
+				return
+			}
+			// the request is requeued to be renewed before the token expires
+			assert.True(t, renewalTime.Before(tokenReq.Status.ExpirationTime.Time))
+			assert.True(t, vaultTokenRenewalDelay(*renewalTime, false) > 0)
+
+			if c.expectRenewal {
+				assert.Equal(t, []string{"s.token"}, trClient.renewed)
+				assert.True(t, status.ExpirationTime.Time.After(now.Add(59*time.Minute)), "expiration time should be extended")
+			} else {
+				assert.Empty(t, trClient.renewed)
+				assert.True(t, status.ExpirationTime.Time.Before(now.Add(41*time.Minute)), "expiration time should not be extended")
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,241 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/engine/v1alpha1/util"
+	tokenrole "kubevault.dev/operator/pkg/vault/role/token"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	VaultTokenRolePhaseSuccess    api.VaultTokenRolePhase = "Success"
+	VaultTokenRoleConditionFailed string                  = "Failed"
+	VaultTokenRoleFinalizer       string                  = "vaulttokenrole.engine.kubevault.com"
+)
+
+func (c *VaultController) initVaultTokenRoleWatcher() {
+	c.tokenRoleInformer = c.extInformerFactory.Engine().V1alpha1().VaultTokenRoles().Informer()
+	c.tokenRoleQueue = queue.New(api.ResourceKindVaultTokenRole, c.MaxNumRequeues, c.NumThreads, c.runVaultTokenRoleInjector)
+	c.tokenRoleInformer.AddEventHandler(queue.NewReconcilableHandler(c.tokenRoleQueue.GetQueue()))
+	c.tokenRoleLister = c.extInformerFactory.Engine().V1alpha1().VaultTokenRoles().Lister()
+}
+
+func (c *VaultController) runVaultTokenRoleInjector(key string) error {
+	obj, exist, err := c.tokenRoleInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exist {
+		glog.Warningf("VaultTokenRole %s does not exist anymore", key)
+
+	} else {
+		tokenRole := obj.(*api.VaultTokenRole).DeepCopy()
+
+		glog.Infof("Sync/Add/Update for VaultTokenRole %s/%s", tokenRole.Namespace, tokenRole.Name)
+
+		if tokenRole.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(tokenRole.ObjectMeta, VaultTokenRoleFinalizer) {
+				go c.runVaultTokenRoleFinalizer(tokenRole, finalizerTimeout, finalizerInterval)
+			}
+		} else {
+			if !core_util.HasFinalizer(tokenRole.ObjectMeta, VaultTokenRoleFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchVaultTokenRole(c.extClient.EngineV1alpha1(), tokenRole, func(role *api.VaultTokenRole) *api.VaultTokenRole {
+					role.ObjectMeta = core_util.AddFinalizer(role.ObjectMeta, VaultTokenRoleFinalizer)
+					return role
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set VaultTokenRole finalizer for %s/%s", tokenRole.Namespace, tokenRole.Name)
+				}
+			}
+
+			trClient, err := tokenrole.NewVaultTokenRole(c.kubeClient, c.appCatalogClient, tokenRole)
+			if err != nil {
+				return err
+			}
+
+			err = c.reconcileVaultTokenRole(trClient, tokenRole)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultTokenRole %s/%s:", tokenRole.Namespace, tokenRole.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Will do:
+//	For vault:
+// 	  - configure a token role
+//    - sync role
+func (c *VaultController) reconcileVaultTokenRole(trClient tokenrole.TokenRoleInterface, tokenRole *api.VaultTokenRole) error {
+	status := tokenRole.Status
+
+	// create role
+	err := trClient.CreateRole()
+	if err != nil {
+		status.Conditions = []api.VaultTokenRoleCondition{
+			{
+				Type:    VaultTokenRoleConditionFailed,
+				Status:  core.ConditionTrue,
+				Reason:  "FailedToCreateRole",
+				Message: err.Error(),
+			},
+		}
+
+		err2 := c.updatedVaultTokenRoleStatus(&status, tokenRole)
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to update status")
+		}
+		return errors.Wrap(err, "failed to create role")
+	}
+
+	status.Conditions = []api.VaultTokenRoleCondition{}
+	status.Phase = VaultTokenRolePhaseSuccess
+	status.ObservedGeneration = tokenRole.Generation
+
+	err = c.updatedVaultTokenRoleStatus(&status, tokenRole)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update VaultTokenRole status")
+	}
+	return nil
+}
+
+func (c *VaultController) updatedVaultTokenRoleStatus(status *api.VaultTokenRoleStatus, tokenRole *api.VaultTokenRole) error {
+	_, err := patchutil.UpdateVaultTokenRoleStatus(c.extClient.EngineV1alpha1(), tokenRole, func(s *api.VaultTokenRoleStatus) *api.VaultTokenRoleStatus {
+		return status
+	})
+	return err
+}
+
+func (c *VaultController) runVaultTokenRoleFinalizer(tokenRole *api.VaultTokenRole, timeout time.Duration, interval time.Duration) {
+	if tokenRole == nil {
+		glog.Infoln("VaultTokenRole is nil")
+		return
+	}
+
+	id := getVaultTokenRoleId(tokenRole)
+	if c.finalizerInfo.IsAlreadyProcessing(id) {
+		// already processing
+		return
+	}
+
+	glog.Infof("Processing finalizer for VaultTokenRole %s/%s", tokenRole.Namespace, tokenRole.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this VaultTokenRole
+	c.finalizerInfo.Add(id)
+
+	stopCh := time.After(timeout)
+	finalizationDone := false
+	timeOutOccured := false
+	attempt := 0
+
+	for {
+		glog.Infof("VaultTokenRole %s/%s finalizer: attempt %d\n", tokenRole.Namespace, tokenRole.Name, attempt)
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		if !finalizationDone {
+			d, err := tokenrole.NewVaultTokenRole(c.kubeClient, c.appCatalogClient, tokenRole)
+			if err != nil {
+				glog.Errorf("VaultTokenRole %s/%s finalizer: %v", tokenRole.Namespace, tokenRole.Name, err)
+			} else {
+				err = c.finalizeVaultTokenRole(d, tokenRole)
+				if err != nil {
+					glog.Errorf("VaultTokenRole %s/%s finalizer: %v", tokenRole.Namespace, tokenRole.Name, err)
+				} else {
+					finalizationDone = true
+				}
+			}
+		}
+
+		if finalizationDone {
+			err := c.removeVaultTokenRoleFinalizer(tokenRole)
+			if err != nil {
+				glog.Errorf("VaultTokenRole %s/%s finalizer: removing finalizer %v", tokenRole.Namespace, tokenRole.Name, err)
+			} else {
+				break
+			}
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+		attempt++
+	}
+
+	err := c.removeVaultTokenRoleFinalizer(tokenRole)
+	if err != nil {
+		glog.Errorf("VaultTokenRole %s/%s finalizer: removing finalizer %v", tokenRole.Namespace, tokenRole.Name, err)
+	} else {
+		glog.Infof("Removed finalizer for VaultTokenRole %s/%s", tokenRole.Namespace, tokenRole.Name)
+	}
+
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(id)
+}
+
+// Do:
+//	- delete role in vault
+func (c *VaultController) finalizeVaultTokenRole(trClient tokenrole.TokenRoleInterface, tokenRole *api.VaultTokenRole) error {
+	err := trClient.DeleteRole(tokenRole.RoleName())
+	if err != nil {
+		return errors.Wrap(err, "failed to delete token role")
+	}
+	return nil
+}
+
+func (c *VaultController) removeVaultTokenRoleFinalizer(tokenRole *api.VaultTokenRole) error {
+	m, err := c.extClient.EngineV1alpha1().VaultTokenRoles(tokenRole.Namespace).Get(tokenRole.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// remove finalizer
+	_, _, err = patchutil.PatchVaultTokenRole(c.extClient.EngineV1alpha1(), m, func(role *api.VaultTokenRole) *api.VaultTokenRole {
+		role.ObjectMeta = core_util.RemoveFinalizer(role.ObjectMeta, VaultTokenRoleFinalizer)
+		return role
+	})
+	return err
+}
+
+func getVaultTokenRoleId(tokenRole *api.VaultTokenRole) string {
+	return fmt.Sprintf("%s/%s/%s", api.ResourceVaultTokenRole, tokenRole.Namespace, tokenRole.Name)
+}
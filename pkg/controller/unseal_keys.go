@@ -0,0 +1,124 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"bytes"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/eventer"
+	k8s "kubevault.dev/operator/pkg/vault/unsealer/kubernetes"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// UnsealKeySecretKey is the key of the encrypted unseal key share
+	// in the custodian secret
+	UnsealKeySecretKey = "unseal-key"
+	// UnsealKeyCustodianAnnotation is the annotation of the custodian secret
+	// holding the name of the custodian whose pgp key encrypted the share
+	UnsealKeyCustodianAnnotation = "vaultserver.kubevault.com/unseal-key-custodian"
+)
+
+// ensureUnsealKeysPublished publishes the pgp encrypted unseal key shares
+// written by the unsealer at initialization to one secret per custodian,
+// so that access to each share can be granted separately.
+//
+// Only the kubernetes secret mode is supported, because the key stores of
+// the other modes are not accessible by the operator.
+func (c *VaultController) ensureUnsealKeysPublished(vs *api.VaultServer) error {
+	unslr := vs.Spec.Unsealer
+	if unslr == nil || len(unslr.PGPKeys) == 0 || unslr.Mode.KubernetesSecret == nil {
+		return nil
+	}
+
+	sr, err := c.kubeClient.CoreV1().Secrets(vs.Namespace).Get(unslr.Mode.KubernetesSecret.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get unseal keys secret %s/%s", vs.Namespace, unslr.Mode.KubernetesSecret.SecretName)
+	}
+
+	secrets, err := getUnsealKeySecrets(vs, sr)
+	if err != nil {
+		return err
+	}
+	if isUnsealKeysPublished(c.kubeClient, secrets) {
+		return nil
+	}
+
+	for _, s := range secrets {
+		err = ensureSecret(c.kubeClient, vs, s)
+		if err != nil {
+			return errors.Wrapf(err, "failed to publish unseal key to secret %s/%s", s.Namespace, s.Name)
+		}
+	}
+
+	c.recorder.Eventf(
+		vs,
+		core.EventTypeNormal,
+		eventer.EventReasonUnsealKeysPublished,
+		"Successfully published %d encrypted unseal keys",
+		len(secrets),
+	)
+	return nil
+}
+
+// getUnsealKeySecrets returns the custodian secrets for the encrypted
+// unseal key shares found in the key store secret
+func getUnsealKeySecrets(vs *api.VaultServer, keyStore *core.Secret) ([]*core.Secret, error) {
+	var secrets []*core.Secret
+	for i, k := range vs.Spec.Unsealer.PGPKeys {
+		key, ok := keyStore.Data[k8s.UnsealKeyID(i)]
+		if !ok || len(key) == 0 {
+			return nil, errors.Errorf("unseal key %s not found in secret %s/%s", k8s.UnsealKeyID(i), keyStore.Namespace, keyStore.Name)
+		}
+		secrets = append(secrets, &core.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vs.UnsealKeySecretName(k.Custodian),
+				Namespace: vs.Namespace,
+				Labels:    vs.OffshootLabels(),
+				Annotations: map[string]string{
+					UnsealKeyCustodianAnnotation: k.Custodian,
+				},
+			},
+			Data: map[string][]byte{
+				UnsealKeySecretKey: key,
+			},
+		})
+	}
+	return secrets, nil
+}
+
+// isUnsealKeysPublished checks whether all the custodian secrets exist
+// with the expected custodian and encrypted unseal key share
+func isUnsealKeysPublished(kc kubernetes.Interface, secrets []*core.Secret) bool {
+	for _, s := range secrets {
+		sr, err := kc.CoreV1().Secrets(s.Namespace).Get(s.Name, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		if sr.Annotations[UnsealKeyCustodianAnnotation] != s.Annotations[UnsealKeyCustodianAnnotation] {
+			return false
+		}
+		if !bytes.Equal(sr.Data[UnsealKeySecretKey], s.Data[UnsealKeySecretKey]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetUnsealKeySecrets(t *testing.T) {
+	vs := &api.VaultServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vaultTestName,
+			Namespace: vaultTestNamespace,
+		},
+		Spec: api.VaultServerSpec{
+			Unsealer: &api.UnsealerSpec{
+				SecretShares:    2,
+				SecretThreshold: 1,
+				PGPKeys: []api.PGPKeySource{
+					{Custodian: "alice"},
+					{Custodian: "bob"},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		testName    string
+		keyStore    map[string][]byte
+		expectErr   bool
+		expectedLen int
+	}{
+		{
+			testName: "all keys available",
+			keyStore: map[string][]byte{
+				"vault-unseal-0": []byte("key-0"),
+				"vault-unseal-1": []byte("key-1"),
+				"vault-root":     []byte("root"),
+			},
+			expectErr:   false,
+			expectedLen: 2,
+		},
+		{
+			testName: "vault is not initialized yet",
+			keyStore: map[string][]byte{
+				"vault-unseal-0": []byte("key-0"),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			secrets, err := getUnsealKeySecrets(vs, &core.Secret{Data: c.keyStore})
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else if assert.Nil(t, err) && assert.Len(t, secrets, c.expectedLen) {
+				assert.Equal(t, vaultTestName+"-unseal-key-alice", secrets[0].Name)
+				assert.Equal(t, []byte("key-0"), secrets[0].Data[UnsealKeySecretKey])
+				assert.Equal(t, "alice", secrets[0].Annotations[UnsealKeyCustodianAnnotation])
+				assert.Equal(t, vaultTestName+"-unseal-key-bob", secrets[1].Name)
+				assert.Equal(t, []byte("key-1"), secrets[1].Data[UnsealKeySecretKey])
+			}
+		})
+	}
+}
+
+func TestIsUnsealKeysPublished(t *testing.T) {
+	expected := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vaultTestName + "-unseal-key-alice",
+			Namespace: vaultTestNamespace,
+			Annotations: map[string]string{
+				UnsealKeyCustodianAnnotation: "alice",
+			},
+		},
+		Data: map[string][]byte{
+			UnsealKeySecretKey: []byte("key-0"),
+		},
+	}
+
+	cases := []struct {
+		testName string
+		existing *core.Secret
+		expected bool
+	}{
+		{
+			testName: "secret not found",
+			existing: nil,
+			expected: false,
+		},
+		{
+			testName: "secret has a different unseal key",
+			existing: func() *core.Secret {
+				s := expected.DeepCopy()
+				s.Data[UnsealKeySecretKey] = []byte("key-1")
+				return s
+			}(),
+			expected: false,
+		},
+		{
+			testName: "secret has a different custodian",
+			existing: func() *core.Secret {
+				s := expected.DeepCopy()
+				s.Annotations[UnsealKeyCustodianAnnotation] = "bob"
+				return s
+			}(),
+			expected: false,
+		},
+		{
+			testName: "secret is published",
+			existing: expected.DeepCopy(),
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			kc := kfake.NewSimpleClientset()
+			if c.existing != nil {
+				_, err := kc.CoreV1().Secrets(c.existing.Namespace).Create(c.existing)
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, c.expected, isUnsealKeysPublished(kc, []*core.Secret{expected}))
+		})
+	}
+}
@@ -17,7 +17,7 @@ package controller
 
 import (
 	"fmt"
-	"net"
+	"net/url"
 	"path/filepath"
 	"strconv"
 
@@ -28,11 +28,7 @@ import (
 	"kubevault.dev/operator/pkg/vault/unsealer"
 	"kubevault.dev/operator/pkg/vault/util"
 
-	"github.com/golang/glog"
 	"github.com/pkg/errors"
-	"github.com/spf13/afero"
-	"gomodules.xyz/cert"
-	"gomodules.xyz/cert/certstore"
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
@@ -44,11 +40,13 @@ import (
 )
 
 const (
-	EnvVaultAddr            = "VAULT_API_ADDR"
-	EnvVaultClusterAddr     = "VAULT_CLUSTER_ADDR"
-	VaultClientPort         = 8200
-	VaultClusterPort        = 8201
-	vaultTLSAssetVolumeName = "vault-tls-secret"
+	EnvVaultAddr               = "VAULT_API_ADDR"
+	EnvVaultClusterAddr        = "VAULT_CLUSTER_ADDR"
+	EnvPodIP                   = "POD_IP"
+	VaultClientPort            = 8200
+	VaultClusterPort           = 8201
+	vaultTLSAssetVolumeName    = "vault-tls-secret"
+	vaultTLSClientCAVolumeName = "vault-tls-client-ca"
 )
 
 var (
@@ -61,6 +59,7 @@ type Vault interface {
 	GetConfig() (*core.ConfigMap, error)
 	Apply(pt *core.PodTemplateSpec) error
 	GetService() *core.Service
+	GetRoleServices() []core.Service
 	GetDeployment(pt *core.PodTemplateSpec) *apps.Deployment
 	GetServiceAccounts() []core.ServiceAccount
 	GetRBACRolesAndRoleBindings() ([]rbac.Role, []rbac.RoleBinding)
@@ -76,6 +75,10 @@ type vaultSrv struct {
 	exprtr     exporter.Exporter
 	kubeClient kubernetes.Interface
 	image      string
+
+	// tls assets of the vault server, that are read or issued once per reconciliation
+	tlsSecret *core.Secret
+	caBundle  []byte
 }
 
 func NewVault(vs *api.VaultServer, config *rest.Config, kc kubernetes.Interface, vc cs.Interface) (Vault, error) {
@@ -91,7 +94,7 @@ func NewVault(vs *api.VaultServer, config *rest.Config, kc kubernetes.Interface,
 	}
 
 	// it is not required to have unsealer
-	unslr, err := unsealer.NewUnsealerService(config, vs, version.Spec.Unsealer.Image)
+	unslr, err := unsealer.NewUnsealerService(config, vs, version.Spec.Unsealer)
 	if err != nil {
 		return nil, err
 	}
@@ -117,77 +120,53 @@ func NewVault(vs *api.VaultServer, config *rest.Config, kc kubernetes.Interface,
 //  - server.key : <vault-server-key>
 //
 // if user provide TLS secrets, then it will be used.
-// Otherwise self signed certificates will be used
+// if user refer to a cert-manager issuer, then the issued secret will be used.
+// Otherwise operator generated certificates will be used, which are rotated before they expire
+//
+// The result of the first call is returned afterwards.
 func (v *vaultSrv) GetServerTLS() (*core.Secret, []byte, error) {
-	tls := v.vs.Spec.TLS
-	if tls != nil && tls.TLSSecret != "" {
-		sr, err := v.kubeClient.CoreV1().Secrets(v.vs.Namespace).Get(tls.TLSSecret, metav1.GetOptions{})
-		return sr, tls.CABundle, err
-	}
-
-	if v.vs.Spec.TLS == nil {
-		v.vs.Spec.TLS = &api.TLSPolicy{
-			TLSSecret: v.vs.TLSSecretName(),
-		}
+	if v.tlsSecret != nil {
+		return v.tlsSecret, v.caBundle, nil
 	}
 
-	tlsSecretName := v.vs.Spec.TLS.TLSSecret
-	sr, err := v.kubeClient.CoreV1().Secrets(v.vs.Namespace).Get(tlsSecretName, metav1.GetOptions{})
-	if err == nil {
-		glog.Infof("secret %s/%s already exists", v.vs.Namespace, tlsSecretName)
-		return sr, v.vs.Spec.TLS.CABundle, nil
-	}
-
-	store, err := certstore.NewCertStore(afero.NewMemMapFs(), filepath.Join("", "pki"))
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "certificate store create error")
-	}
-
-	err = store.NewCA()
+	sr, ca, err := v.getServerTLS()
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "ca certificate create error")
+		return nil, nil, err
 	}
+	v.tlsSecret, v.caBundle = sr, ca
+	return sr, ca, nil
+}
 
-	// ref: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/
-	altNames := cert.AltNames{
-		DNSNames: []string{
-			"localhost",
-			fmt.Sprintf("*.%s.pod", v.vs.Namespace),
-			fmt.Sprintf("%s.%s.svc", v.vs.Name, v.vs.Namespace),
-		},
-		IPs: []net.IP{
-			net.ParseIP("127.0.0.1"),
-		},
+func (v *vaultSrv) getServerTLS() (*core.Secret, []byte, error) {
+	if isIssuedTLS(v.vs) {
+		return v.getIssuedTLS()
 	}
 
-	srvCrt, srvKey, err := store.NewServerCertPairBytes(altNames)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "vault server create crt/key pair error")
+	tls := v.vs.Spec.TLS
+	if !isOperatorManagedTLS(v.vs) {
+		sr, err := v.kubeClient.CoreV1().Secrets(v.vs.Namespace).Get(tls.TLSSecret, metav1.GetOptions{})
+		return sr, tls.CABundle, err
 	}
 
-	tlsSr := &core.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      tlsSecretName,
-			Namespace: v.vs.Namespace,
-			Labels:    v.vs.OffshootLabels(),
-		},
-		Data: map[string][]byte{
-			core.TLSCertKey:       srvCrt,
-			core.TLSPrivateKeyKey: srvKey,
-		},
+	if v.vs.Spec.TLS == nil {
+		v.vs.Spec.TLS = &api.TLSPolicy{}
 	}
-	v.vs.Spec.TLS.CABundle = store.CACertBytes()
-	return tlsSr, store.CACertBytes(), nil
+	v.vs.Spec.TLS.TLSSecret = v.vs.TLSSecretName()
+	return v.getOperatorTLS()
 }
 
 // GetConfig will return the vault config in ConfigMap
 // ConfigMap will contain:
 // - listener config
+// - server config
 // - storage config
 // - user provided extra config
 func (v *vaultSrv) GetConfig() (*core.ConfigMap, error) {
 	configMapName := v.vs.ConfigMapName()
-	cfgData := util.GetListenerConfig()
+	cfgData := util.GetListenerConfig(v.vs.Spec.Listener)
+	if srvCfg := util.GetServerConfig(v.vs.Spec.ServerConfig); srvCfg != "" {
+		cfgData = fmt.Sprintf("%s\n%s", cfgData, srvCfg)
+	}
 
 	storageCfg, err := v.strg.GetStorageConfig()
 	if err != nil {
@@ -308,6 +287,22 @@ func (v *vaultSrv) Apply(pt *core.PodTemplateSpec) error {
 		MountPath: filepath.Dir(util.VaultConfigFile),
 	})
 
+	if l := v.vs.Spec.Listener; l != nil && l.TLSClientCASecret != "" {
+		cont.VolumeMounts = core_util.UpsertVolumeMount(cont.VolumeMounts, core.VolumeMount{
+			Name:      vaultTLSClientCAVolumeName,
+			MountPath: util.VaultTLSClientCADir,
+		})
+
+		pt.Spec.Volumes = core_util.UpsertVolume(pt.Spec.Volumes, core.Volume{
+			Name: vaultTLSClientCAVolumeName,
+			VolumeSource: core.VolumeSource{
+				Secret: &core.SecretVolumeSource{
+					SecretName: l.TLSClientCASecret,
+				},
+			},
+		})
+	}
+
 	for indx, data := range v.vs.Spec.DataSources {
 		cont.VolumeMounts = core_util.UpsertVolumeMount(cont.VolumeMounts, core.VolumeMount{
 			Name:      "data-" + strconv.Itoa(indx),
@@ -376,6 +371,54 @@ func (v *vaultSrv) GetService() *core.Service {
 	}
 }
 
+// GetRoleServices returns the services selecting the vault pods by the role labels
+// set by the status monitor:
+//	- <name>-active selects the active vault pod
+//	- <name>-standby selects the unsealed standby vault pods
+//	- <name>-internal is a headless service selecting all vault pods, used for cluster traffic
+// These services publish not ready addresses, since the standby pods may not pass the readiness probe.
+func (v *vaultSrv) GetRoleServices() []core.Service {
+	ports := []core.ServicePort{
+		{
+			Name:     "client",
+			Protocol: core.ProtocolTCP,
+			Port:     VaultClientPort,
+		},
+		{
+			Name:     "cluster",
+			Protocol: core.ProtocolTCP,
+			Port:     VaultClusterPort,
+		},
+	}
+	newService := func(name string, selector map[string]string) core.Service {
+		return core.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: v.vs.Namespace,
+				Labels:    v.vs.OffshootLabels(),
+			},
+			Spec: core.ServiceSpec{
+				Selector:                 core_util.UpsertMap(v.vs.OffshootSelectors(), selector),
+				Ports:                    ports,
+				PublishNotReadyAddresses: true,
+			},
+		}
+	}
+
+	active := newService(v.vs.ActiveServiceName(), map[string]string{
+		VaultActiveLabel: "true",
+	})
+	standby := newService(v.vs.StandbyServiceName(), map[string]string{
+		VaultActiveLabel:      "false",
+		VaultSealedLabel:      "false",
+		VaultInitializedLabel: "true",
+	})
+	internal := newService(v.vs.InternalServiceName(), nil)
+	internal.Spec.ClusterIP = core.ClusterIPNone
+
+	return []core.Service{active, standby, internal}
+}
+
 func (v *vaultSrv) GetDeployment(pt *core.PodTemplateSpec) *apps.Deployment {
 	return &apps.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -522,8 +565,18 @@ func (v *vaultSrv) GetContainer() core.Container {
 				Value: util.VaultServiceURL(v.vs.Name, v.vs.Namespace, VaultClientPort),
 			},
 			{
+				Name: EnvPodIP,
+				ValueFrom: &core.EnvVarSource{
+					FieldRef: &core.ObjectFieldSelector{
+						FieldPath: "status.podIP",
+					},
+				},
+			},
+			{
+				// each vault pod advertises its own cluster address,
+				// so that the standby pods forward requests to the active pod
 				Name:  EnvVaultClusterAddr,
-				Value: util.VaultServiceURL(v.vs.Name, v.vs.Namespace, VaultClusterPort),
+				Value: fmt.Sprintf("https://$(%s):%d", EnvPodIP, VaultClusterPort),
 			},
 		},
 		SecurityContext: &core.SecurityContext{
@@ -543,7 +596,7 @@ func (v *vaultSrv) GetContainer() core.Container {
 		ReadinessProbe: &core.Probe{
 			Handler: core.Handler{
 				HTTPGet: &core.HTTPGetAction{
-					Path:   "/v1/sys/health",
+					Path:   healthCheckPath(v.vs.Spec.HealthCheck),
 					Port:   intstr.FromInt(VaultClientPort),
 					Scheme: core.URISchemeHTTPS,
 				},
@@ -556,3 +609,23 @@ func (v *vaultSrv) GetContainer() core.Container {
 		Resources: v.vs.Spec.PodTemplate.Spec.Resources,
 	}
 }
+
+// healthCheckPath returns the path of the health endpoint used by the readiness probe
+func healthCheckPath(hc *api.HealthCheckSpec) string {
+	path := "/v1/sys/health"
+	if hc == nil {
+		return path
+	}
+
+	q := url.Values{}
+	if hc.StandbyOK {
+		q.Set("standbyok", "true")
+	}
+	if hc.PerfStandbyOK {
+		q.Set("perfstandbyok", "true")
+	}
+	if len(q) > 0 {
+		path = path + "?" + q.Encode()
+	}
+	return path
+}
@@ -0,0 +1,347 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"path/filepath"
+	"sort"
+	"time"
+
+	policyapi "kubevault.dev/operator/apis/policy/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/policy/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault"
+	"kubevault.dev/operator/pkg/vault/identity"
+	"kubevault.dev/operator/pkg/vault/policybinding"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+func (c *VaultController) initVaultIdentityEntityWatcher() {
+	c.identityEntityInformer = c.extInformerFactory.Policy().V1alpha1().VaultIdentityEntities().Informer()
+	c.identityEntityQueue = queue.New(policyapi.ResourceKindVaultIdentityEntity, c.MaxNumRequeues, c.NumThreads, c.runVaultIdentityEntityInjector)
+	c.identityEntityInformer.AddEventHandler(queue.NewReconcilableHandler(c.identityEntityQueue.GetQueue()))
+	c.identityEntityLister = c.extInformerFactory.Policy().V1alpha1().VaultIdentityEntities().Lister()
+}
+
+// runVaultIdentityEntityInjector gets the vault identity entity object indexed by the key from cache
+// and initializes, reconciles or garbage collects the vault entity as needed.
+func (c *VaultController) runVaultIdentityEntityInjector(key string) error {
+	obj, exists, err := c.identityEntityInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		glog.Warningf("VaultIdentityEntity %s does not exist anymore\n", key)
+	} else {
+		vie := obj.(*policyapi.VaultIdentityEntity).DeepCopy()
+		glog.Infof("Sync/Add/Update for VaultIdentityEntity %s/%s\n", vie.Namespace, vie.Name)
+
+		if vie.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(vie.ObjectMeta, VaultPolicyFinalizer) {
+				// Finalize VaultIdentityEntity
+				go c.runIdentityEntityFinalizer(vie, timeoutForFinalizer, timeIntervalForFinalizer)
+			} else {
+				glog.Infof("Finalizer not found for VaultIdentityEntity %s/%s", vie.Namespace, vie.Name)
+			}
+		} else {
+			if !core_util.HasFinalizer(vie.ObjectMeta, VaultPolicyFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchVaultIdentityEntity(c.extClient.PolicyV1alpha1(), vie, func(in *policyapi.VaultIdentityEntity) *policyapi.VaultIdentityEntity {
+					in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+					return in
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set VaultIdentityEntity finalizer for %s/%s", vie.Namespace, vie.Name)
+				}
+			}
+
+			idClient, err := c.newIdentityClient(vie.Namespace, vie.Spec.VaultRef)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultIdentityEntity %s/%s", vie.Namespace, vie.Name)
+			}
+
+			err = c.reconcileIdentityEntity(vie, idClient)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultIdentityEntity %s/%s", vie.Namespace, vie.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileIdentityEntity creates or updates the entity in vault and creates its aliases.
+// The aliases that are no longer specified are deleted from vault.
+func (c *VaultController) reconcileIdentityEntity(vie *policyapi.VaultIdentityEntity, idClient identity.Identity) error {
+	status := vie.Status
+
+	if err := vie.IsValid(); err != nil {
+		return c.failIdentityEntity(vie, status, "InvalidSpec", err)
+	}
+
+	policies, err := policybinding.PolicyNames(c.extClient, vie.Namespace, vie.Spec.Policies)
+	if err != nil {
+		return c.failIdentityEntity(vie, status, "FailedToGetPolicies", errors.Wrap(err, "for .spec.policies"))
+	}
+
+	status.ID, err = idClient.EnsureEntity(identity.Entity{
+		Name:     vie.EntityName(),
+		Owner:    vie.GetKey(),
+		Policies: policies,
+		Metadata: vie.Spec.Metadata,
+		Disabled: vie.Spec.Disabled,
+	})
+	if err != nil {
+		return c.failIdentityEntity(vie, status, "FailedToPutEntity", err)
+	}
+
+	aliases, err := c.identityEntityAliases(vie, idClient)
+	if err != nil {
+		return c.failIdentityEntity(vie, status, "FailedToGetAliases", err)
+	}
+
+	applied := map[string]bool{}
+	var created []policyapi.IdentityAliasStatus
+	for _, a := range aliases {
+		a.CanonicalID = status.ID
+		id, err := idClient.EnsureEntityAlias(a)
+		if err != nil {
+			return c.failIdentityEntity(vie, status, "FailedToCreateAlias", err)
+		}
+		applied[id] = true
+		created = append(created, policyapi.IdentityAliasStatus{
+			Name:          a.Name,
+			MountAccessor: a.MountAccessor,
+			ID:            id,
+		})
+	}
+
+	// delete the aliases that are no longer specified
+	var deleteErr error
+	for _, a := range status.Aliases {
+		if applied[a.ID] {
+			continue
+		}
+		if err := idClient.DeleteEntityAlias(a.ID); err != nil {
+			// keep it in the status, so that the deletion is retried
+			created = append(created, a)
+			deleteErr = err
+		}
+	}
+	sort.Slice(created, func(i, j int) bool {
+		return created[i].ID < created[j].ID
+	})
+	status.Aliases = created
+	if deleteErr != nil {
+		return c.failIdentityEntity(vie, status, "FailedToDeleteAlias", deleteErr)
+	}
+
+	// update status
+	status.ObservedGeneration = vie.Generation
+	status.Conditions = []policyapi.PolicyCondition{}
+	status.Phase = policyapi.PolicySuccess
+	err = c.updateIdentityEntityStatus(&status, vie)
+	if err != nil {
+		return errors.Wrap(err, "failed to update VaultIdentityEntity status")
+	}
+	return nil
+}
+
+// identityEntityAliases returns the aliases of the entity with the resolved mount accessors.
+// For the service accounts, the alias name is resolved the same way the kubernetes auth method does.
+func (c *VaultController) identityEntityAliases(vie *policyapi.VaultIdentityEntity, idClient identity.Identity) ([]identity.Alias, error) {
+	var aliases []identity.Alias
+	for _, a := range vie.Spec.Aliases {
+		accessor, err := c.resolveMountAccessor(vie.Namespace, vie.Spec.VaultRef, a, idClient)
+		if err != nil {
+			return nil, errors.Wrapf(err, "for alias %s", a.Name)
+		}
+		aliases = append(aliases, identity.Alias{
+			Name:          a.Name,
+			MountAccessor: accessor,
+		})
+	}
+
+	for _, it := range vie.Spec.ServiceAccounts {
+		sa := it.DeepCopy()
+		sa.SetDefaults(vie.Namespace)
+
+		obj, err := c.kubeClient.CoreV1().ServiceAccounts(sa.Namespace).Get(sa.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "for service account %s/%s", sa.Namespace, sa.Name)
+		}
+		accessor, err := c.resolveMountAccessor(vie.Namespace, vie.Spec.VaultRef, policyapi.IdentityAlias{MountPath: sa.MountPath}, idClient)
+		if err != nil {
+			return nil, errors.Wrapf(err, "for service account %s/%s", sa.Namespace, sa.Name)
+		}
+		aliases = append(aliases, identity.Alias{
+			Name:          sa.AliasName(string(obj.UID)),
+			MountAccessor: accessor,
+		})
+	}
+	return aliases, nil
+}
+
+// resolveMountAccessor returns the mount accessor of the alias.
+// If the auth method is managed by the operator, the accessor is taken from the
+// .status.authMethodStatus of the VaultServer. Otherwise, it is read from vault.
+func (c *VaultController) resolveMountAccessor(namespace string, vaultRef core.LocalObjectReference, a policyapi.IdentityAlias, idClient identity.Identity) (string, error) {
+	if a.MountAccessor != "" {
+		return a.MountAccessor, nil
+	}
+
+	// AppBinding of the VaultServer has the same name as the VaultServer
+	vs, err := c.extClient.KubevaultV1alpha1().VaultServers(namespace).Get(vaultRef.Name, metav1.GetOptions{})
+	if err != nil && !kerr.IsNotFound(err) {
+		return "", err
+	}
+	if err == nil {
+		for _, au := range vs.Status.AuthMethodStatus {
+			if filepath.Clean(au.Path) == filepath.Clean(a.MountPath) && au.Accessor != "" {
+				return au.Accessor, nil
+			}
+		}
+	}
+	return idClient.MountAccessor(a.MountPath)
+}
+
+// newIdentityClient returns a identity client for the vault referred by the AppBinding
+func (c *VaultController) newIdentityClient(namespace string, vaultRef core.LocalObjectReference) (identity.Identity, error) {
+	if vaultRef.Name == "" {
+		return nil, errors.New("spec.vaultRef must not be empty")
+	}
+	vc, err := vault.NewClient(c.kubeClient, c.appCatalogClient, &appcat.AppReference{
+		Namespace: namespace,
+		Name:      vaultRef.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return identity.NewIdentity(vc), nil
+}
+
+// failIdentityEntity sets the failure condition in the status of VaultIdentityEntity
+// and returns the original error
+func (c *VaultController) failIdentityEntity(vie *policyapi.VaultIdentityEntity, status policyapi.VaultIdentityEntityStatus, reason string, err error) error {
+	status.Phase = policyapi.PolicyFailed
+	status.Conditions = []policyapi.PolicyCondition{
+		{
+			Type:    policyapi.PolicyConditionFailure,
+			Status:  core.ConditionTrue,
+			Reason:  reason,
+			Message: err.Error(),
+		},
+	}
+
+	err2 := c.updateIdentityEntityStatus(&status, vie)
+	if err2 != nil {
+		return errors.Wrap(err2, "failed to update VaultIdentityEntity status")
+	}
+	return err
+}
+
+// updateIdentityEntityStatus updates identity entity status
+func (c *VaultController) updateIdentityEntityStatus(status *policyapi.VaultIdentityEntityStatus, vie *policyapi.VaultIdentityEntity) error {
+	_, err := patchutil.UpdateVaultIdentityEntityStatus(c.extClient.PolicyV1alpha1(), vie, func(s *policyapi.VaultIdentityEntityStatus) *policyapi.VaultIdentityEntityStatus {
+		return status
+	})
+	return err
+}
+
+// runIdentityEntityFinalizer wil periodically run the finalizeIdentityEntity until finalizeIdentityEntity func produces no error or timeout occurs.
+// After that it will remove the finalizer string from the objectMeta of VaultIdentityEntity
+func (c *VaultController) runIdentityEntityFinalizer(vie *policyapi.VaultIdentityEntity, timeout time.Duration, interval time.Duration) {
+	if vie == nil {
+		glog.Infoln("VaultIdentityEntity in nil")
+		return
+	}
+
+	key := vie.GetKey()
+	if c.finalizerInfo.IsAlreadyProcessing(key) {
+		// already processing it
+		return
+	}
+
+	glog.Infof("Processing finalizer for VaultIdentityEntity %s/%s", vie.Namespace, vie.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this VaultIdentityEntity
+	c.finalizerInfo.Add(key)
+	stopCh := time.After(timeout)
+	timeOutOccured := false
+	for {
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		// finalize entity
+		if err := c.finalizeIdentityEntity(vie); err == nil {
+			glog.Infof("For VaultIdentityEntity %s/%s: successfully removed entity from vault", vie.Namespace, vie.Name)
+			break
+		} else {
+			glog.Infof("For VaultIdentityEntity %s/%s: %v", vie.Namespace, vie.Name, err)
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+	}
+
+	// Remove finalizer
+	_, err := patchutil.TryPatchVaultIdentityEntity(c.extClient.PolicyV1alpha1(), vie, func(in *policyapi.VaultIdentityEntity) *policyapi.VaultIdentityEntity {
+		in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+		return in
+	})
+	if err != nil {
+		glog.Errorf("For VaultIdentityEntity %s/%s: %v", vie.Namespace, vie.Name, err)
+	} else {
+		glog.Infof("For VaultIdentityEntity %s/%s: removed finalizer '%s'", vie.Namespace, vie.Name, VaultPolicyFinalizer)
+	}
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(key)
+	glog.Infof("Removed finalizer for VaultIdentityEntity %s/%s", vie.Namespace, vie.Name)
+}
+
+// finalizeIdentityEntity will delete the entity in vault,
+// vault deletes the aliases of the entity along with it
+func (c *VaultController) finalizeIdentityEntity(vie *policyapi.VaultIdentityEntity) error {
+	out, err := c.extClient.PolicyV1alpha1().VaultIdentityEntities(vie.Namespace).Get(vie.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	idClient, err := c.newIdentityClient(out.Namespace, out.Spec.VaultRef)
+	if err != nil {
+		return err
+	}
+	return idClient.DeleteEntity(out.EntityName(), out.GetKey())
+}
@@ -0,0 +1,189 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	vsapi "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	policyapi "kubevault.dev/operator/apis/policy/v1alpha1"
+	opfake "kubevault.dev/operator/client/clientset/versioned/fake"
+	"kubevault.dev/operator/pkg/vault/identity"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeIdentity struct {
+	identity.Identity
+	accessors map[string]string
+}
+
+func (f *fakeIdentity) MountAccessor(path string) (string, error) {
+	if a, ok := f.accessors[path]; ok {
+		return a, nil
+	}
+	return "", errors.Errorf("auth method is not enabled in path %s", path)
+}
+
+func TestIdentityEntityAliases(t *testing.T) {
+	c := &VaultController{
+		kubeClient: kfake.NewSimpleClientset(&core.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app",
+				Namespace: "demo",
+				UID:       "4b1c1d6e-2e5a-4f3c-9c8d-0a1b2c3d4e5f",
+			},
+		}),
+		extClient: opfake.NewSimpleClientset(&vsapi.VaultServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vault",
+				Namespace: "demo",
+			},
+			Status: vsapi.VaultServerStatus{
+				AuthMethodStatus: []vsapi.AuthMethodStatus{
+					{
+						Type:     "kubernetes",
+						Path:     "kubernetes",
+						Status:   vsapi.AuthMethodEnableSucceeded,
+						Accessor: "auth_kubernetes_1",
+					},
+				},
+			},
+		}),
+	}
+	idClient := &fakeIdentity{
+		accessors: map[string]string{
+			"github": "auth_github_1",
+		},
+	}
+
+	testData := []struct {
+		testName    string
+		spec        policyapi.VaultIdentityEntitySpec
+		expected    []identity.Alias
+		expectedErr bool
+	}{
+		{
+			testName: "accessor is resolved from VaultServer status",
+			spec: policyapi.VaultIdentityEntitySpec{
+				VaultRef: core.LocalObjectReference{Name: "vault"},
+				ServiceAccounts: []policyapi.ServiceAccountAlias{
+					{
+						Name: "app",
+					},
+				},
+			},
+			expected: []identity.Alias{
+				{
+					Name:          "4b1c1d6e-2e5a-4f3c-9c8d-0a1b2c3d4e5f",
+					MountAccessor: "auth_kubernetes_1",
+				},
+			},
+		},
+		{
+			testName: "alias name is resolved from service account name",
+			spec: policyapi.VaultIdentityEntitySpec{
+				VaultRef: core.LocalObjectReference{Name: "vault"},
+				ServiceAccounts: []policyapi.ServiceAccountAlias{
+					{
+						Name:            "app",
+						AliasNameSource: policyapi.AliasNameSourceServiceAccountName,
+					},
+				},
+			},
+			expected: []identity.Alias{
+				{
+					Name:          "demo/app",
+					MountAccessor: "auth_kubernetes_1",
+				},
+			},
+		},
+		{
+			testName: "accessor is read from vault",
+			spec: policyapi.VaultIdentityEntitySpec{
+				VaultRef: core.LocalObjectReference{Name: "vault"},
+				Aliases: []policyapi.IdentityAlias{
+					{
+						Name:      "octocat",
+						MountPath: "github",
+					},
+					{
+						Name:          "admin",
+						MountAccessor: "auth_userpass_1",
+					},
+				},
+			},
+			expected: []identity.Alias{
+				{
+					Name:          "octocat",
+					MountAccessor: "auth_github_1",
+				},
+				{
+					Name:          "admin",
+					MountAccessor: "auth_userpass_1",
+				},
+			},
+		},
+		{
+			testName: "auth method is not enabled",
+			spec: policyapi.VaultIdentityEntitySpec{
+				VaultRef: core.LocalObjectReference{Name: "vault"},
+				Aliases: []policyapi.IdentityAlias{
+					{
+						Name:      "admin",
+						MountPath: "ldap",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			testName: "service account doesn't exist",
+			spec: policyapi.VaultIdentityEntitySpec{
+				VaultRef: core.LocalObjectReference{Name: "vault"},
+				ServiceAccounts: []policyapi.ServiceAccountAlias{
+					{
+						Name: "unknown",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			vie := &policyapi.VaultIdentityEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "app",
+					Namespace: "demo",
+				},
+				Spec: test.spec,
+			}
+			aliases, err := c.identityEntityAliases(vie, idClient)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, aliases)
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"time"
+
+	policyapi "kubevault.dev/operator/apis/policy/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/policy/v1alpha1/util"
+	"kubevault.dev/operator/pkg/vault/identity"
+	"kubevault.dev/operator/pkg/vault/policybinding"
+
+	"github.com/appscode/go/sets"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+func (c *VaultController) initVaultIdentityGroupWatcher() {
+	c.identityGroupInformer = c.extInformerFactory.Policy().V1alpha1().VaultIdentityGroups().Informer()
+	c.identityGroupQueue = queue.New(policyapi.ResourceKindVaultIdentityGroup, c.MaxNumRequeues, c.NumThreads, c.runVaultIdentityGroupInjector)
+	c.identityGroupInformer.AddEventHandler(queue.NewReconcilableHandler(c.identityGroupQueue.GetQueue()))
+	c.identityGroupLister = c.extInformerFactory.Policy().V1alpha1().VaultIdentityGroups().Lister()
+
+	// reconcile the groups when the vault id of their member entities or groups is changed
+	c.identityEntityInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldE, ok1 := oldObj.(*policyapi.VaultIdentityEntity)
+			newE, ok2 := newObj.(*policyapi.VaultIdentityEntity)
+			if ok1 && ok2 && oldE.Status.ID != newE.Status.ID {
+				c.enqueueReferringIdentityGroups(newE.Namespace, func(g *policyapi.VaultIdentityGroup) []string {
+					return g.Spec.MemberEntityRefs
+				}, newE.Name)
+			}
+		},
+	})
+	c.identityGroupInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldG, ok1 := oldObj.(*policyapi.VaultIdentityGroup)
+			newG, ok2 := newObj.(*policyapi.VaultIdentityGroup)
+			if ok1 && ok2 && oldG.Status.ID != newG.Status.ID {
+				c.enqueueReferringIdentityGroups(newG.Namespace, func(g *policyapi.VaultIdentityGroup) []string {
+					return g.Spec.MemberGroupRefs
+				}, newG.Name)
+			}
+		},
+	})
+}
+
+// enqueueReferringIdentityGroups enqueues the VaultIdentityGroups of the namespace
+// whose member refs contain the name
+func (c *VaultController) enqueueReferringIdentityGroups(namespace string, refs func(*policyapi.VaultIdentityGroup) []string, name string) {
+	groups, err := c.identityGroupLister.VaultIdentityGroups(namespace).List(labels.Everything())
+	if err != nil {
+		glog.Errorf("failed to list VaultIdentityGroups: %v", err)
+		return
+	}
+	for _, g := range groups {
+		if sets.NewString(refs(g)...).Has(name) {
+			queue.Enqueue(c.identityGroupQueue.GetQueue(), g)
+		}
+	}
+}
+
+// runVaultIdentityGroupInjector gets the vault identity group object indexed by the key from cache
+// and initializes, reconciles or garbage collects the vault group as needed.
+func (c *VaultController) runVaultIdentityGroupInjector(key string) error {
+	obj, exists, err := c.identityGroupInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		glog.Warningf("VaultIdentityGroup %s does not exist anymore\n", key)
+	} else {
+		vig := obj.(*policyapi.VaultIdentityGroup).DeepCopy()
+		glog.Infof("Sync/Add/Update for VaultIdentityGroup %s/%s\n", vig.Namespace, vig.Name)
+
+		if vig.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(vig.ObjectMeta, VaultPolicyFinalizer) {
+				// Finalize VaultIdentityGroup
+				go c.runIdentityGroupFinalizer(vig, timeoutForFinalizer, timeIntervalForFinalizer)
+			} else {
+				glog.Infof("Finalizer not found for VaultIdentityGroup %s/%s", vig.Namespace, vig.Name)
+			}
+		} else {
+			if !core_util.HasFinalizer(vig.ObjectMeta, VaultPolicyFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchVaultIdentityGroup(c.extClient.PolicyV1alpha1(), vig, func(in *policyapi.VaultIdentityGroup) *policyapi.VaultIdentityGroup {
+					in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+					return in
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set VaultIdentityGroup finalizer for %s/%s", vig.Namespace, vig.Name)
+				}
+			}
+
+			idClient, err := c.newIdentityClient(vig.Namespace, vig.Spec.VaultRef)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultIdentityGroup %s/%s", vig.Namespace, vig.Name)
+			}
+
+			err = c.reconcileIdentityGroup(vig, idClient)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultIdentityGroup %s/%s", vig.Namespace, vig.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileIdentityGroup creates or updates the group in vault.
+// For the external groups, it creates the group alias and
+// deletes the previous alias if it is changed.
+func (c *VaultController) reconcileIdentityGroup(vig *policyapi.VaultIdentityGroup, idClient identity.Identity) error {
+	status := vig.Status
+
+	if err := vig.IsValid(); err != nil {
+		return c.failIdentityGroup(vig, status, "InvalidSpec", err)
+	}
+
+	policies, err := policybinding.PolicyNames(c.extClient, vig.Namespace, vig.Spec.Policies)
+	if err != nil {
+		return c.failIdentityGroup(vig, status, "FailedToGetPolicies", errors.Wrap(err, "for .spec.policies"))
+	}
+
+	entityIDs, groupIDs, err := c.identityGroupMembers(vig)
+	if err != nil {
+		return c.failIdentityGroup(vig, status, "FailedToGetMembers", err)
+	}
+
+	status.ID, err = idClient.EnsureGroup(identity.Group{
+		Name:            vig.GroupName(),
+		Owner:           vig.GetKey(),
+		Type:            string(vig.GroupType()),
+		Policies:        policies,
+		Metadata:        vig.Spec.Metadata,
+		MemberEntityIDs: entityIDs,
+		MemberGroupIDs:  groupIDs,
+	})
+	if err != nil {
+		return c.failIdentityGroup(vig, status, "FailedToPutGroup", err)
+	}
+
+	var alias *policyapi.IdentityAliasStatus
+	if a := vig.Spec.Alias; a != nil && vig.GroupType() == policyapi.IdentityGroupTypeExternal {
+		accessor, err := c.resolveMountAccessor(vig.Namespace, vig.Spec.VaultRef, *a, idClient)
+		if err != nil {
+			return c.failIdentityGroup(vig, status, "FailedToGetAlias", errors.Wrapf(err, "for alias %s", a.Name))
+		}
+		id, err := idClient.EnsureGroupAlias(identity.Alias{
+			Name:          a.Name,
+			MountAccessor: accessor,
+			CanonicalID:   status.ID,
+		})
+		if err != nil {
+			return c.failIdentityGroup(vig, status, "FailedToCreateAlias", err)
+		}
+		alias = &policyapi.IdentityAliasStatus{
+			Name:          a.Name,
+			MountAccessor: accessor,
+			ID:            id,
+		}
+	}
+
+	// delete the previous alias
+	if status.Alias != nil && (alias == nil || alias.ID != status.Alias.ID) {
+		if err := idClient.DeleteGroupAlias(status.Alias.ID); err != nil {
+			return c.failIdentityGroup(vig, status, "FailedToDeleteAlias", err)
+		}
+	}
+	status.Alias = alias
+
+	// update status
+	status.ObservedGeneration = vig.Generation
+	status.Conditions = []policyapi.PolicyCondition{}
+	status.Phase = policyapi.PolicySuccess
+	err = c.updateIdentityGroupStatus(&status, vig)
+	if err != nil {
+		return errors.Wrap(err, "failed to update VaultIdentityGroup status")
+	}
+	return nil
+}
+
+// identityGroupMembers returns the vault ids of the member entities and groups.
+// The referred VaultIdentityEntities and VaultIdentityGroups must be created in vault.
+func (c *VaultController) identityGroupMembers(vig *policyapi.VaultIdentityGroup) ([]string, []string, error) {
+	entityIDs := append([]string{}, vig.Spec.MemberEntityIDs...)
+	for _, ref := range vig.Spec.MemberEntityRefs {
+		e, err := c.extClient.PolicyV1alpha1().VaultIdentityEntities(vig.Namespace).Get(ref, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "for .spec.memberEntityRefs")
+		}
+		if e.Status.ID == "" {
+			return nil, nil, errors.Errorf("for .spec.memberEntityRefs: VaultIdentityEntity %s/%s is not created in vault yet", e.Namespace, e.Name)
+		}
+		entityIDs = append(entityIDs, e.Status.ID)
+	}
+
+	groupIDs := append([]string{}, vig.Spec.MemberGroupIDs...)
+	for _, ref := range vig.Spec.MemberGroupRefs {
+		g, err := c.extClient.PolicyV1alpha1().VaultIdentityGroups(vig.Namespace).Get(ref, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "for .spec.memberGroupRefs")
+		}
+		if g.Status.ID == "" {
+			return nil, nil, errors.Errorf("for .spec.memberGroupRefs: VaultIdentityGroup %s/%s is not created in vault yet", g.Namespace, g.Name)
+		}
+		groupIDs = append(groupIDs, g.Status.ID)
+	}
+	return sets.NewString(entityIDs...).List(), sets.NewString(groupIDs...).List(), nil
+}
+
+// failIdentityGroup sets the failure condition in the status of VaultIdentityGroup
+// and returns the original error
+func (c *VaultController) failIdentityGroup(vig *policyapi.VaultIdentityGroup, status policyapi.VaultIdentityGroupStatus, reason string, err error) error {
+	status.Phase = policyapi.PolicyFailed
+	status.Conditions = []policyapi.PolicyCondition{
+		{
+			Type:    policyapi.PolicyConditionFailure,
+			Status:  core.ConditionTrue,
+			Reason:  reason,
+			Message: err.Error(),
+		},
+	}
+
+	err2 := c.updateIdentityGroupStatus(&status, vig)
+	if err2 != nil {
+		return errors.Wrap(err2, "failed to update VaultIdentityGroup status")
+	}
+	return err
+}
+
+// updateIdentityGroupStatus updates identity group status
+func (c *VaultController) updateIdentityGroupStatus(status *policyapi.VaultIdentityGroupStatus, vig *policyapi.VaultIdentityGroup) error {
+	_, err := patchutil.UpdateVaultIdentityGroupStatus(c.extClient.PolicyV1alpha1(), vig, func(s *policyapi.VaultIdentityGroupStatus) *policyapi.VaultIdentityGroupStatus {
+		return status
+	})
+	return err
+}
+
+// runIdentityGroupFinalizer wil periodically run the finalizeIdentityGroup until finalizeIdentityGroup func produces no error or timeout occurs.
+// After that it will remove the finalizer string from the objectMeta of VaultIdentityGroup
+func (c *VaultController) runIdentityGroupFinalizer(vig *policyapi.VaultIdentityGroup, timeout time.Duration, interval time.Duration) {
+	if vig == nil {
+		glog.Infoln("VaultIdentityGroup in nil")
+		return
+	}
+
+	key := vig.GetKey()
+	if c.finalizerInfo.IsAlreadyProcessing(key) {
+		// already processing it
+		return
+	}
+
+	glog.Infof("Processing finalizer for VaultIdentityGroup %s/%s", vig.Namespace, vig.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this VaultIdentityGroup
+	c.finalizerInfo.Add(key)
+	stopCh := time.After(timeout)
+	timeOutOccured := false
+	for {
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		// finalize group
+		if err := c.finalizeIdentityGroup(vig); err == nil {
+			glog.Infof("For VaultIdentityGroup %s/%s: successfully removed group from vault", vig.Namespace, vig.Name)
+			break
+		} else {
+			glog.Infof("For VaultIdentityGroup %s/%s: %v", vig.Namespace, vig.Name, err)
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+	}
+
+	// Remove finalizer
+	_, err := patchutil.TryPatchVaultIdentityGroup(c.extClient.PolicyV1alpha1(), vig, func(in *policyapi.VaultIdentityGroup) *policyapi.VaultIdentityGroup {
+		in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+		return in
+	})
+	if err != nil {
+		glog.Errorf("For VaultIdentityGroup %s/%s: %v", vig.Namespace, vig.Name, err)
+	} else {
+		glog.Infof("For VaultIdentityGroup %s/%s: removed finalizer '%s'", vig.Namespace, vig.Name, VaultPolicyFinalizer)
+	}
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(key)
+	glog.Infof("Removed finalizer for VaultIdentityGroup %s/%s", vig.Namespace, vig.Name)
+}
+
+// finalizeIdentityGroup will delete the group in vault,
+// vault deletes the alias of the group along with it
+func (c *VaultController) finalizeIdentityGroup(vig *policyapi.VaultIdentityGroup) error {
+	out, err := c.extClient.PolicyV1alpha1().VaultIdentityGroups(vig.Namespace).Get(vig.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	idClient, err := c.newIdentityClient(out.Namespace, out.Spec.VaultRef)
+	if err != nil {
+		return err
+	}
+	return idClient.DeleteGroup(out.GroupName(), out.GetKey())
+}
@@ -119,6 +119,8 @@ func (c *VaultController) reconcilePolicyBinding(vPBind *policyapi.VaultPolicyBi
 	status.ObservedGeneration = vPBind.Generation
 	status.Conditions = []policyapi.PolicyBindingCondition{}
 	status.Phase = policyapi.PolicyBindingSuccess
+	status.SharedPolicies = pBClient.SharedPolicies()
+	status.SharedSubjects = pBClient.SharedSubjects()
 	err2 := c.updatePolicyBindingStatus(&status, vPBind)
 	if err2 != nil {
 		return errors.Wrap(err2, "failed to update VaultPolicyBinding status")
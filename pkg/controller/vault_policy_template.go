@@ -0,0 +1,412 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	policyapi "kubevault.dev/operator/apis/policy/v1alpha1"
+	patchutil "kubevault.dev/operator/client/clientset/versioned/typed/policy/v1alpha1/util"
+	"kubevault.dev/operator/pkg/admission"
+	"kubevault.dev/operator/pkg/vault/policy"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+// vaultIdentityTemplate matches the vault identity templates, e.g. {{identity.entity.id}}
+// ref: https://www.vaultproject.io/docs/concepts/policies#templated-policies
+var vaultIdentityTemplate = regexp.MustCompile(`{{\s*identity\.[^{}]*}}`)
+
+const (
+	// the characters of the namespace labels and annotations, that could change the
+	// structure of the rendered policy, i.e. close a string and add another path
+	unsafePolicyValueChars = "\"{}\\\r\n"
+	// marks the unsafe values in the rendered policy
+	unsafePolicyValueMarker = "\x00"
+)
+
+func (c *VaultController) initVaultPolicyTemplateWatcher() {
+	c.vplcyTemplateInformer = c.extInformerFactory.Policy().V1alpha1().VaultPolicyTemplates().Informer()
+	c.vplcyTemplateQueue = queue.New(policyapi.ResourceKindVaultPolicyTemplate, c.MaxNumRequeues, c.NumThreads, c.runVaultPolicyTemplateInjector)
+	c.vplcyTemplateInformer.AddEventHandler(queue.NewReconcilableHandler(c.vplcyTemplateQueue.GetQueue()))
+	c.vplcyTemplateLister = c.extInformerFactory.Policy().V1alpha1().VaultPolicyTemplates().Lister()
+
+	// re-render the templates when namespaces are created, deleted or relabeled
+	c.kubeInformerFactory.Core().V1().Namespaces().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.enqueueVaultPolicyTemplates()
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNs, ok1 := oldObj.(*core.Namespace)
+			newNs, ok2 := newObj.(*core.Namespace)
+			if !ok1 || !ok2 {
+				return
+			}
+			if !reflect.DeepEqual(oldNs.Labels, newNs.Labels) ||
+				!reflect.DeepEqual(oldNs.Annotations, newNs.Annotations) ||
+				oldNs.Status.Phase != newNs.Status.Phase {
+				c.enqueueVaultPolicyTemplates()
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.enqueueVaultPolicyTemplates()
+		},
+	})
+	c.nsLister = c.kubeInformerFactory.Core().V1().Namespaces().Lister()
+}
+
+// enqueueVaultPolicyTemplates enqueues all the VaultPolicyTemplates
+func (c *VaultController) enqueueVaultPolicyTemplates() {
+	templates, err := c.vplcyTemplateLister.List(labels.Everything())
+	if err != nil {
+		glog.Errorf("failed to list VaultPolicyTemplates: %v", err)
+		return
+	}
+	for _, t := range templates {
+		queue.Enqueue(c.vplcyTemplateQueue.GetQueue(), t)
+	}
+}
+
+// runVaultPolicyTemplateInjector gets the vault policy template object indexed by the key from cache
+// and initializes, reconciles or garbage collects the rendered vault policies as needed.
+func (c *VaultController) runVaultPolicyTemplateInjector(key string) error {
+	obj, exists, err := c.vplcyTemplateInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		glog.Warningf("VaultPolicyTemplate %s does not exist anymore\n", key)
+	} else {
+		vpt := obj.(*policyapi.VaultPolicyTemplate).DeepCopy()
+		glog.Infof("Sync/Add/Update for VaultPolicyTemplate %s\n", vpt.Name)
+
+		if vpt.DeletionTimestamp != nil {
+			if core_util.HasFinalizer(vpt.ObjectMeta, VaultPolicyFinalizer) {
+				// Finalize VaultPolicyTemplate
+				go c.runPolicyTemplateFinalizer(vpt, timeoutForFinalizer, timeIntervalForFinalizer)
+			} else {
+				glog.Infof("Finalizer not found for VaultPolicyTemplate %s", vpt.Name)
+			}
+		} else {
+			if !core_util.HasFinalizer(vpt.ObjectMeta, VaultPolicyFinalizer) {
+				// Add finalizer
+				_, _, err := patchutil.PatchVaultPolicyTemplate(c.extClient.PolicyV1alpha1(), vpt, func(in *policyapi.VaultPolicyTemplate) *policyapi.VaultPolicyTemplate {
+					in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+					return in
+				})
+				if err != nil {
+					return errors.Wrapf(err, "failed to set VaultPolicyTemplate finalizer for %s", vpt.Name)
+				}
+			}
+
+			pClient, err := policy.NewPolicyClient(c.kubeClient, c.appCatalogClient, &vpt.Spec.VaultRef)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultPolicyTemplate %s", vpt.Name)
+			}
+
+			err = c.reconcilePolicyTemplate(vpt, pClient)
+			if err != nil {
+				return errors.Wrapf(err, "for VaultPolicyTemplate %s", vpt.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// reconcilePolicyTemplate renders the policy for each of the selected namespaces and
+// creates or updates them in vault. The policies rendered for the namespaces
+// that are no longer selected are deleted from vault.
+func (c *VaultController) reconcilePolicyTemplate(vpt *policyapi.VaultPolicyTemplate, pClient policy.Policy) error {
+	status := vpt.Status
+
+	namespaces, err := c.selectNamespaces(vpt.Spec.NamespaceSelector)
+	if err != nil {
+		return c.failPolicyTemplate(vpt, status, "FailedToSelectNamespaces", err)
+	}
+
+	applied := map[string]bool{}
+	for _, ns := range namespaces {
+		doc, err := renderPolicyTemplate(vpt.Spec.PolicyDocument, ns)
+		if err != nil {
+			return c.failPolicyTemplate(vpt, status, "FailedToRenderPolicy", errors.Wrapf(err, "for namespace %s", ns.Name))
+		}
+		// the rendered policy is checked like the VaultPolicies by the validating webhook
+		err = admission.ValidateVaultPolicy(&policyapi.VaultPolicy{Spec: policyapi.VaultPolicySpec{PolicyDocument: doc}}, c.PolicyDenylist)
+		if err != nil {
+			return c.failPolicyTemplate(vpt, status, "InvalidRenderedPolicy", errors.Wrapf(err, "for namespace %s", ns.Name))
+		}
+
+		name := vpt.PolicyName(ns.Name)
+		if err := pClient.EnsurePolicy(name, doc); err != nil {
+			return c.failPolicyTemplate(vpt, status, "FailedToPutPolicy", errors.Wrapf(err, "for namespace %s", ns.Name))
+		}
+		applied[name] = true
+		status.Policies = upsertRenderedPolicy(status.Policies, policyapi.RenderedPolicy{
+			Namespace: ns.Name,
+			Name:      name,
+		})
+	}
+
+	// delete the policies of the namespaces that are no longer selected
+	var (
+		policies  []policyapi.RenderedPolicy
+		deleteErr error
+	)
+	for _, p := range status.Policies {
+		if applied[p.Name] {
+			policies = append(policies, p)
+			continue
+		}
+		if err := pClient.DeletePolicy(p.Name); err != nil {
+			// keep it in the status, so that the deletion is retried
+			policies = append(policies, p)
+			deleteErr = errors.Wrapf(err, "for namespace %s", p.Namespace)
+		}
+	}
+	status.Policies = policies
+	if deleteErr != nil {
+		return c.failPolicyTemplate(vpt, status, "FailedToDeletePolicy", deleteErr)
+	}
+
+	// update status
+	status.ObservedGeneration = vpt.Generation
+	status.Conditions = []policyapi.PolicyCondition{}
+	status.Phase = policyapi.PolicySuccess
+	err = c.updatePolicyTemplateStatus(&status, vpt)
+	if err != nil {
+		return errors.Wrap(err, "failed to update VaultPolicyTemplate status")
+	}
+	return nil
+}
+
+// failPolicyTemplate sets the failure condition in the status of VaultPolicyTemplate
+// and returns the original error
+func (c *VaultController) failPolicyTemplate(vpt *policyapi.VaultPolicyTemplate, status policyapi.VaultPolicyTemplateStatus, reason string, err error) error {
+	status.Phase = policyapi.PolicyFailed
+	status.Conditions = []policyapi.PolicyCondition{
+		{
+			Type:    policyapi.PolicyConditionFailure,
+			Status:  core.ConditionTrue,
+			Reason:  reason,
+			Message: err.Error(),
+		},
+	}
+
+	err2 := c.updatePolicyTemplateStatus(&status, vpt)
+	if err2 != nil {
+		return errors.Wrap(err2, "failed to update VaultPolicyTemplate status")
+	}
+	return err
+}
+
+// updatePolicyTemplateStatus updates policy template status
+func (c *VaultController) updatePolicyTemplateStatus(status *policyapi.VaultPolicyTemplateStatus, vpt *policyapi.VaultPolicyTemplate) error {
+	_, err := patchutil.UpdateVaultPolicyTemplateStatus(c.extClient.PolicyV1alpha1(), vpt, func(s *policyapi.VaultPolicyTemplateStatus) *policyapi.VaultPolicyTemplateStatus {
+		return status
+	})
+	return err
+}
+
+// selectNamespaces returns the active namespaces matched by the selector.
+// If the selector is nil, all the active namespaces are returned.
+func (c *VaultController) selectNamespaces(ls *metav1.LabelSelector) ([]*core.Namespace, error) {
+	selector := labels.Everything()
+	if ls != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(ls)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid namespaceSelector")
+		}
+	}
+
+	namespaces, err := c.nsLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var active []*core.Namespace
+	for _, ns := range namespaces {
+		if ns.DeletionTimestamp == nil && ns.Status.Phase != core.NamespaceTerminating {
+			active = append(active, ns)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].Name < active[j].Name
+	})
+	return active, nil
+}
+
+// renderPolicyTemplate renders the policy template for the namespace.
+// Vault identity templates are kept as is, so that they are rendered by vault.
+// The labels and annotations containing any of the unsafe characters can't be rendered.
+func renderPolicyTemplate(doc string, ns *core.Namespace) (string, error) {
+	doc = vaultIdentityTemplate.ReplaceAllStringFunc(doc, func(s string) string {
+		return "{{" + strconv.Quote(s) + "}}"
+	})
+
+	tpl, err := template.New("policy").Option("missingkey=error").Parse(doc)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse policy template")
+	}
+
+	data := struct {
+		Namespace   string
+		Labels      map[string]string
+		Annotations map[string]string
+	}{
+		Namespace:   ns.Name,
+		Labels:      markUnsafePolicyValues("label", ns.Labels),
+		Annotations: markUnsafePolicyValues("annotation", ns.Annotations),
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", errors.Wrap(err, "failed to render policy template")
+	}
+
+	out := buf.String()
+	if parts := strings.SplitN(out, unsafePolicyValueMarker, 3); len(parts) == 3 {
+		return "", errors.Errorf("namespace %s contains any of the characters %q, that are not allowed in a policy", parts[1], unsafePolicyValueChars)
+	}
+	return out, nil
+}
+
+// markUnsafePolicyValues replaces the values containing any of the unsafe characters
+// with a marker, so that only the unsafe values used by the template are rejected
+func markUnsafePolicyValues(kind string, in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		if strings.ContainsAny(v, unsafePolicyValueChars) {
+			v = unsafePolicyValueMarker + kind + " " + k + unsafePolicyValueMarker
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// upsertRenderedPolicy adds the policy to the list if it doesn't exist
+func upsertRenderedPolicy(policies []policyapi.RenderedPolicy, p policyapi.RenderedPolicy) []policyapi.RenderedPolicy {
+	for _, it := range policies {
+		if it.Name == p.Name {
+			return policies
+		}
+	}
+	policies = append(policies, p)
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+	return policies
+}
+
+// runPolicyTemplateFinalizer wil periodically run the finalizePolicyTemplate until finalizePolicyTemplate func produces no error or timeout occurs.
+// After that it will remove the finalizer string from the objectMeta of VaultPolicyTemplate
+func (c *VaultController) runPolicyTemplateFinalizer(vpt *policyapi.VaultPolicyTemplate, timeout time.Duration, interval time.Duration) {
+	if vpt == nil {
+		glog.Infoln("VaultPolicyTemplate in nil")
+		return
+	}
+
+	key := vpt.GetKey()
+	if c.finalizerInfo.IsAlreadyProcessing(key) {
+		// already processing it
+		return
+	}
+
+	glog.Infof("Processing finalizer for VaultPolicyTemplate %s", vpt.Name)
+	// Add key to finalizerInfo, it will prevent other go routine to processing for this VaultPolicyTemplate
+	c.finalizerInfo.Add(key)
+	stopCh := time.After(timeout)
+	timeOutOccured := false
+	for {
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		default:
+		}
+
+		if timeOutOccured {
+			break
+		}
+
+		// finalize policies
+		if err := c.finalizePolicyTemplate(vpt); err == nil {
+			glog.Infof("For VaultPolicyTemplate %s: successfully removed policies from vault", vpt.Name)
+			break
+		} else {
+			glog.Infof("For VaultPolicyTemplate %s: %v", vpt.Name, err)
+		}
+
+		select {
+		case <-stopCh:
+			timeOutOccured = true
+		case <-time.After(interval):
+		}
+	}
+
+	// Remove finalizer
+	_, err := patchutil.TryPatchVaultPolicyTemplate(c.extClient.PolicyV1alpha1(), vpt, func(in *policyapi.VaultPolicyTemplate) *policyapi.VaultPolicyTemplate {
+		in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, VaultPolicyFinalizer)
+		return in
+	})
+	if err != nil {
+		glog.Errorf("For VaultPolicyTemplate %s: %v", vpt.Name, err)
+	} else {
+		glog.Infof("For VaultPolicyTemplate %s: removed finalizer '%s'", vpt.Name, VaultPolicyFinalizer)
+	}
+	// Delete key from finalizer info as processing is done
+	c.finalizerInfo.Delete(key)
+	glog.Infof("Removed finalizer for VaultPolicyTemplate %s", vpt.Name)
+}
+
+// finalizePolicyTemplate will delete the rendered policies in vault
+func (c *VaultController) finalizePolicyTemplate(vpt *policyapi.VaultPolicyTemplate) error {
+	out, err := c.extClient.PolicyV1alpha1().VaultPolicyTemplates().Get(vpt.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	pClient, err := policy.NewPolicyClient(c.kubeClient, c.appCatalogClient, &out.Spec.VaultRef)
+	if err != nil {
+		return err
+	}
+	for _, p := range out.Status.Policies {
+		if err := pClient.DeletePolicy(p.Name); err != nil {
+			return errors.Wrapf(err, "failed to delete policy %s", p.Name)
+		}
+	}
+	return nil
+}
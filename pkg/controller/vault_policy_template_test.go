@@ -0,0 +1,107 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+
+	policyapi "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRenderPolicyTemplate(t *testing.T) {
+	ns := &core.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "demo",
+			Labels: map[string]string{
+				"team": "payments",
+				"evil": "x/*\" { capabilities = [\"read\"] }\npath \"sys/*\" { capabilities = [\"sudo\"] } path \"y",
+			},
+			Annotations: map[string]string{
+				"vault.kubevault.com/mount":                        "kv",
+				"kubectl.kubernetes.io/last-applied-configuration": `{"kind":"Namespace"}`,
+			},
+		},
+	}
+
+	cases := []struct {
+		testName  string
+		doc       string
+		expected  string
+		expectErr bool
+	}{
+		{
+			testName: "render namespace, expect no error",
+			doc:      `path "kv/data/{{ .Namespace }}/*" { capabilities = ["read"] }`,
+			expected: `path "kv/data/demo/*" { capabilities = ["read"] }`,
+		},
+		{
+			testName: "render labels and annotations, expect no error",
+			doc:      `path "{{ index .Annotations "vault.kubevault.com/mount" }}/data/{{ .Labels.team }}/*" { capabilities = ["read"] }`,
+			expected: `path "kv/data/payments/*" { capabilities = ["read"] }`,
+		},
+		{
+			testName: "keep vault identity templates, expect no error",
+			doc:      `path "kv/data/{{ .Namespace }}/{{identity.entity.id}}/*" { capabilities = ["read"] }`,
+			expected: `path "kv/data/demo/{{identity.entity.id}}/*" { capabilities = ["read"] }`,
+		},
+		{
+			testName:  "label value closing the path, expect error",
+			doc:       `path "kv/data/{{ .Labels.evil }}" { capabilities = ["read"] }`,
+			expectErr: true,
+		},
+		{
+			testName:  "annotation value containing braces, expect error",
+			doc:       `path "kv/data/{{ index .Annotations "kubectl.kubernetes.io/last-applied-configuration" }}" { capabilities = ["read"] }`,
+			expectErr: true,
+		},
+		{
+			testName:  "missing label, expect error",
+			doc:       `path "kv/data/{{ .Labels.owner }}/*" { capabilities = ["read"] }`,
+			expectErr: true,
+		},
+		{
+			testName:  "malformed template, expect error",
+			doc:       `path "kv/data/{{ .Namespace }/*" { capabilities = ["read"] }`,
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			out, err := renderPolicyTemplate(c.doc, ns)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				if assert.Nil(t, err) {
+					assert.Equal(t, c.expected, out)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyTemplateNameDoesNotCollide(t *testing.T) {
+	vpt := policyapi.VaultPolicyTemplate{ObjectMeta: metav1.ObjectMeta{Name: "read"}}
+	vp := policyapi.VaultPolicy{ObjectMeta: metav1.ObjectMeta{Name: "read", Namespace: "demo"}}
+	cvp := policyapi.ClusterVaultPolicy{ObjectMeta: metav1.ObjectMeta{Name: "demo.read"}}
+
+	assert.NotEqual(t, vp.PolicyName(), vpt.PolicyName("demo"))
+	assert.NotEqual(t, cvp.PolicyName(), vpt.PolicyName("demo"))
+}
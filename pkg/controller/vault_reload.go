@@ -0,0 +1,352 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"path/filepath"
+	"sort"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/eventer"
+	"kubevault.dev/operator/pkg/vault/util"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+const (
+	// VaultConfigHashAnnotation is the pod template annotation containing the hash of
+	// the ConfigMaps and Secrets used by the vault pods. Any change of them rolls out
+	// the vault pods.
+	VaultConfigHashAnnotation = "vaultserver.kubevault.com/config-hash"
+
+	// VaultTLSHashAnnotation is the pod annotation containing the hash of the TLS assets
+	// last loaded by the vault container
+	VaultTLSHashAnnotation = "vaultserver.kubevault.com/tls-hash"
+
+	// PodDeletionCostAnnotation is used to make the ReplicaSet controller delete the
+	// standby vault pods before the active one during a rollout.
+	// xref: https://kubernetes.io/docs/concepts/workloads/controllers/replicaset/#pod-deletion-cost
+	PodDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+	activePodDeletionCost     = "1000"
+)
+
+// getConfigHash returns the hash of the ConfigMaps and Secrets referenced by the pod template:
+//	- vault config
+//	- user provided config source and data sources
+//	- storage and unsealer credentials
+//
+// The TLS secret is skipped, because the certificates are reloaded in place.
+// Missing objects are hashed as empty, so that their creation rolls out the pods.
+func getConfigHash(kc kubernetes.Interface, namespace string, pt *core.PodTemplateSpec) (string, error) {
+	configMaps, secrets := getReferencedObjects(pt)
+
+	h := sha256.New()
+	for _, name := range configMaps {
+		cm, err := kc.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{})
+		if err != nil && !kerr.IsNotFound(err) {
+			return "", errors.Wrapf(err, "failed to get configMap %s/%s", namespace, name)
+		}
+		data := map[string][]byte{}
+		if err == nil {
+			for k, v := range cm.Data {
+				data[k] = []byte(v)
+			}
+			for k, v := range cm.BinaryData {
+				data[k] = v
+			}
+		}
+		writeHash(h, "configmap/"+name, data)
+	}
+	for _, name := range secrets {
+		sr, err := kc.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil && !kerr.IsNotFound(err) {
+			return "", errors.Wrapf(err, "failed to get secret %s/%s", namespace, name)
+		}
+		var data map[string][]byte
+		if err == nil {
+			data = sr.Data
+		}
+		writeHash(h, "secret/"+name, data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// getTLSHash returns the hash of the vault server TLS assets
+func getTLSHash(sr *core.Secret) string {
+	h := sha256.New()
+	writeHash(h, "secret/"+sr.Name, sr.Data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// getReferencedObjects returns the sorted names of the ConfigMaps and Secrets
+// referenced by the volumes and the containers of the pod template
+func getReferencedObjects(pt *core.PodTemplateSpec) ([]string, []string) {
+	configMaps, secrets := sets.NewString(), sets.NewString()
+
+	for _, vol := range pt.Spec.Volumes {
+		if vol.Name == vaultTLSAssetVolumeName {
+			continue
+		}
+		if vol.ConfigMap != nil {
+			configMaps.Insert(vol.ConfigMap.Name)
+		}
+		if vol.Secret != nil {
+			secrets.Insert(vol.Secret.SecretName)
+		}
+		if vol.Projected != nil {
+			for _, src := range vol.Projected.Sources {
+				if src.ConfigMap != nil {
+					configMaps.Insert(src.ConfigMap.Name)
+				}
+				if src.Secret != nil {
+					secrets.Insert(src.Secret.Name)
+				}
+			}
+		}
+	}
+
+	containers := append(append([]core.Container{}, pt.Spec.InitContainers...), pt.Spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if env.ValueFrom.ConfigMapKeyRef != nil {
+				configMaps.Insert(env.ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if env.ValueFrom.SecretKeyRef != nil {
+				secrets.Insert(env.ValueFrom.SecretKeyRef.Name)
+			}
+		}
+		for _, env := range c.EnvFrom {
+			if env.ConfigMapRef != nil {
+				configMaps.Insert(env.ConfigMapRef.Name)
+			}
+			if env.SecretRef != nil {
+				secrets.Insert(env.SecretRef.Name)
+			}
+		}
+	}
+	return configMaps.List(), secrets.List()
+}
+
+// writeHash writes the data to the hash in a deterministic order
+func writeHash(h hash.Hash, name string, data map[string][]byte) {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(h, "%s\n", name)
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%d:", k, len(data[k]))
+		h.Write(data[k])
+	}
+}
+
+// checkConfigChanged requeues the VaultServer when the hash of the ConfigMaps and Secrets
+// used by the vault pods differs from the one in the pod template of the deployment, because
+// changes of those objects do not trigger the VaultServer reconciliation otherwise.
+// The VaultServer is requeued on every check until the new hash is rolled out, so that
+// a failed rollout is retried.
+func (c *VaultController) checkConfigChanged(vs *api.VaultServer) error {
+	d, err := c.kubeClient.AppsV1().Deployments(vs.Namespace).Get(vs.OffshootName(), metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get deployment %s/%s", vs.Namespace, vs.OffshootName())
+	}
+
+	configHash, err := getConfigHash(c.kubeClient, vs.Namespace, &d.Spec.Template)
+	if err != nil {
+		return err
+	}
+
+	if configHash != d.Spec.Template.Annotations[VaultConfigHashAnnotation] {
+		glog.Infof("vault config of VaultServer %s/%s has changed, rolling out the vault pods", vs.Namespace, vs.Name)
+		c.vsQueue.GetQueue().Add(vs.GetKey())
+	}
+	return nil
+}
+
+// reconcileVaultPods makes the vault pods upgrade safe and up to date:
+//	- the active pod is annotated with a higher deletion cost, so that the standby
+//	  pods are restarted first during a rollout
+//	- the vault containers still serving the previous TLS assets are sent SIGHUP,
+//	  so that certificate rotation does not restart vault
+//
+// The pods failed to reconcile are retried on the next call.
+func (c *VaultController) reconcileVaultPods(vs *api.VaultServer) error {
+	var tlsSr *core.Secret
+	if vs.Spec.TLS != nil && vs.Spec.TLS.TLSSecret != "" {
+		sr, err := c.kubeClient.CoreV1().Secrets(vs.Namespace).Get(vs.Spec.TLS.TLSSecret, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get tls secret %s/%s", vs.Namespace, vs.Spec.TLS.TLSSecret)
+		}
+		tlsSr = sr
+	}
+
+	opt := metav1.ListOptions{LabelSelector: labels.SelectorFromSet(vs.OffshootSelectors()).String()}
+	pods, err := c.kubeClient.CoreV1().Pods(vs.Namespace).List(opt)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list pods for VaultServer %s/%s", vs.Namespace, vs.Name)
+	}
+
+	var errs []error
+	for i := range pods.Items {
+		p := &pods.Items[i]
+		if p.Status.Phase != core.PodRunning || p.DeletionTimestamp != nil {
+			continue
+		}
+
+		err = c.ensurePodDeletionCost(p, p.Name == vs.Status.VaultStatus.Active)
+		if err != nil {
+			errs = append(errs, err)
+		}
+
+		if tlsSr != nil {
+			err = c.reloadTLSAssets(vs, p, tlsSr, c.execOnVaultContainer)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// ensurePodDeletionCost sets the deletion cost of the active pod and removes it from the others
+func (c *VaultController) ensurePodDeletionCost(p *core.Pod, active bool) error {
+	cost, ok := p.Annotations[PodDeletionCostAnnotation]
+	if (active && cost == activePodDeletionCost) || (!active && !ok) {
+		return nil
+	}
+
+	_, _, err := core_util.PatchPod(c.kubeClient, p, func(in *core.Pod) *core.Pod {
+		if active {
+			in.Annotations = core_util.UpsertMap(in.Annotations, map[string]string{
+				PodDeletionCostAnnotation: activePodDeletionCost,
+			})
+		} else {
+			delete(in.Annotations, PodDeletionCostAnnotation)
+		}
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to patch deletion cost of pod %s/%s", p.Namespace, p.Name)
+	}
+	return nil
+}
+
+// podExecutor runs the command in the vault container of the pod and returns the stdout
+type podExecutor func(p *core.Pod, command ...string) (string, error)
+
+// reloadTLSAssets sends SIGHUP to the vault process, if it has not loaded
+// the TLS assets of the secret yet
+func (c *VaultController) reloadTLSAssets(vs *api.VaultServer, p *core.Pod, sr *core.Secret, exec podExecutor) error {
+	tlsHash := getTLSHash(sr)
+	if p.Annotations[VaultTLSHashAnnotation] == tlsHash {
+		return nil
+	}
+
+	// kubelet updates the mounted secret eventually,
+	// so reload only after the new certificate is in place
+	crt, err := exec(p, "cat", filepath.Join(util.VaultTLSAssetDir, core.TLSCertKey))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read tls certificate of pod %s/%s", p.Namespace, p.Name)
+	}
+	if !bytes.Equal([]byte(crt), sr.Data[core.TLSCertKey]) {
+		return nil
+	}
+
+	// vault reloads the listener certificates on SIGHUP, the signal is sent by
+	// the process name, as vault may not be the init process of the container
+	_, err = exec(p, "pkill", "-HUP", "-x", "vault")
+	if err != nil {
+		return errors.Wrapf(err, "failed to send SIGHUP to pod %s/%s", p.Namespace, p.Name)
+	}
+
+	_, _, err = core_util.PatchPod(c.kubeClient, p, func(in *core.Pod) *core.Pod {
+		in.Annotations = core_util.UpsertMap(in.Annotations, map[string]string{
+			VaultTLSHashAnnotation: tlsHash,
+		})
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to patch tls hash of pod %s/%s", p.Namespace, p.Name)
+	}
+
+	c.recorder.Eventf(
+		vs,
+		core.EventTypeNormal,
+		eventer.EventReasonVaultTLSReloaded,
+		"Reloaded TLS assets of pod %s",
+		p.Name,
+	)
+	return nil
+}
+
+// execOnVaultContainer runs the command in the vault container of the pod and returns the stdout
+func (c *VaultController) execOnVaultContainer(p *core.Pod, command ...string) (string, error) {
+	var (
+		execOut bytes.Buffer
+		execErr bytes.Buffer
+	)
+
+	req := c.kubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(p.Name).
+		Namespace(p.Namespace).
+		SubResource("exec")
+	req.VersionedParams(&core.PodExecOptions{
+		Container: util.VaultContainerName,
+		Command:   command,
+		Stdout:    true,
+		Stderr:    true,
+	}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(c.clientConfig, "POST", req.URL())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to init executor")
+	}
+
+	err = exec.Stream(remotecommand.StreamOptions{
+		Stdout: &execOut,
+		Stderr: &execErr,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "could not execute")
+	}
+
+	if execErr.Len() > 0 {
+		return "", errors.Errorf("stderr: %v", execErr.String())
+	}
+	return execOut.String(), nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/util"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestGetConfigHash(t *testing.T) {
+	pt := &core.PodTemplateSpec{
+		Spec: core.PodSpec{
+			Volumes: []core.Volume{
+				{
+					Name: "controller-config",
+					VolumeSource: core.VolumeSource{
+						ConfigMap: &core.ConfigMapVolumeSource{
+							LocalObjectReference: core.LocalObjectReference{Name: "vault-config"},
+						},
+					},
+				},
+				{
+					Name: vaultTLSAssetVolumeName,
+					VolumeSource: core.VolumeSource{
+						Secret: &core.SecretVolumeSource{SecretName: "vault-tls"},
+					},
+				},
+			},
+			Containers: []core.Container{
+				{
+					Name: "vault",
+					Env: []core.EnvVar{
+						{
+							Name: "AWS_SECRET_ACCESS_KEY",
+							ValueFrom: &core.EnvVarSource{
+								SecretKeyRef: &core.SecretKeySelector{
+									LocalObjectReference: core.LocalObjectReference{Name: "s3-cred"},
+									Key:                  "secret_key",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	newObjects := func(config, cred, tls string) (*core.ConfigMap, *core.Secret, *core.Secret) {
+		return &core.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault-config", Namespace: "test"},
+			Data:       map[string]string{"vault.hcl": config},
+		}, &core.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3-cred", Namespace: "test"},
+			Data:       map[string][]byte{"secret_key": []byte(cred)},
+		}, &core.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault-tls", Namespace: "test"},
+			Data:       map[string][]byte{core.TLSCertKey: []byte(tls)},
+		}
+	}
+	hash := func(config, cred, tls string) string {
+		cm, cred1, tls1 := newObjects(config, cred, tls)
+		h, err := getConfigHash(kfake.NewSimpleClientset(cm, cred1, tls1), "test", pt)
+		assert.Nil(t, err)
+		return h
+	}
+
+	base := hash("config", "cred", "tls")
+	assert.Equal(t, base, hash("config", "cred", "tls"), "hash must be deterministic")
+	assert.NotEqual(t, base, hash("config-2", "cred", "tls"), "config change must change the hash")
+	assert.NotEqual(t, base, hash("config", "cred-2", "tls"), "credential change must change the hash")
+	assert.Equal(t, base, hash("config", "cred", "tls-2"), "tls change must not change the hash")
+
+	missing, err := getConfigHash(kfake.NewSimpleClientset(), "test", pt)
+	if assert.Nil(t, err) {
+		assert.NotEqual(t, base, missing)
+	}
+}
+
+func TestEnsurePodDeletionCost(t *testing.T) {
+	newPod := func(name string, annotations map[string]string) *core.Pod {
+		return &core.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "test",
+				Annotations: annotations,
+			},
+		}
+	}
+	active := newPod("vault-0", nil)
+	standby := newPod("vault-1", map[string]string{PodDeletionCostAnnotation: activePodDeletionCost})
+
+	ctrl := &VaultController{
+		kubeClient: kfake.NewSimpleClientset(active, standby),
+	}
+	if assert.Nil(t, ctrl.ensurePodDeletionCost(active, true)) {
+		p, err := ctrl.kubeClient.CoreV1().Pods("test").Get("vault-0", metav1.GetOptions{})
+		if assert.Nil(t, err) {
+			assert.Equal(t, activePodDeletionCost, p.Annotations[PodDeletionCostAnnotation])
+		}
+	}
+	if assert.Nil(t, ctrl.ensurePodDeletionCost(standby, false)) {
+		p, err := ctrl.kubeClient.CoreV1().Pods("test").Get("vault-1", metav1.GetOptions{})
+		if assert.Nil(t, err) {
+			assert.NotContains(t, p.Annotations, PodDeletionCostAnnotation)
+		}
+	}
+}
+
+func TestReloadTLSAssets(t *testing.T) {
+	sr := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-tls", Namespace: "test"},
+		Data:       map[string][]byte{core.TLSCertKey: []byte("new-cert")},
+	}
+	vs := &api.VaultServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "test"},
+	}
+
+	cases := []struct {
+		testName    string
+		annotations map[string]string
+		mountedCert string
+		execErr     bool
+		reloaded    bool
+		expectErr   bool
+	}{
+		{
+			testName:    "tls assets are already loaded",
+			annotations: map[string]string{VaultTLSHashAnnotation: getTLSHash(sr)},
+			mountedCert: "new-cert",
+			reloaded:    false,
+		},
+		{
+			testName:    "mounted certificate is not updated yet",
+			mountedCert: "old-cert",
+			reloaded:    false,
+		},
+		{
+			testName:    "mounted certificate is updated",
+			mountedCert: "new-cert",
+			reloaded:    true,
+		},
+		{
+			testName:  "failed to exec",
+			execErr:   true,
+			reloaded:  false,
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			p := &core.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "vault-0",
+					Namespace:   "test",
+					Annotations: c.annotations,
+				},
+			}
+			ctrl := &VaultController{
+				kubeClient: kfake.NewSimpleClientset(p),
+				recorder:   record.NewFakeRecorder(1),
+			}
+
+			var commands []string
+			exec := func(p *core.Pod, command ...string) (string, error) {
+				commands = append(commands, strings.Join(command, " "))
+				if c.execErr {
+					return "", assert.AnError
+				}
+				return c.mountedCert, nil
+			}
+
+			err := ctrl.reloadTLSAssets(vs, p, sr, exec)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, c.reloaded, len(commands) == 2 && commands[1] == "pkill -HUP -x vault")
+			if c.annotations == nil && !c.execErr {
+				assert.Equal(t, "cat "+filepath.Join(util.VaultTLSAssetDir, core.TLSCertKey), commands[0])
+			}
+
+			if c.reloaded {
+				p, err := ctrl.kubeClient.CoreV1().Pods("test").Get("vault-0", metav1.GetOptions{})
+				if assert.Nil(t, err) {
+					assert.Equal(t, getTLSHash(sr), p.Annotations[VaultTLSHashAnnotation])
+				}
+			}
+		})
+	}
+}
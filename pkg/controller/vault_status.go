@@ -31,10 +31,19 @@ import (
 	kerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	core_util "kmodules.xyz/client-go/core/v1"
 	meta_util "kmodules.xyz/client-go/meta"
 	"kmodules.xyz/client-go/tools/portforward"
 )
 
+const (
+	// Labels of the vault pods set by the status monitor.
+	// These are the same labels set by the kubernetes service registration of vault.
+	VaultActiveLabel      = "vault-active"
+	VaultSealedLabel      = "vault-sealed"
+	VaultInitializedLabel = "vault-initialized"
+)
+
 // monitorAndUpdateStatus monitors the vault service and replicas statuses, and
 // updates the status resource in the vault CR item.
 func (c *VaultController) monitorAndUpdateStatus(ctx context.Context, vs *api.VaultServer) {
@@ -52,6 +61,9 @@ func (c *VaultController) monitorAndUpdateStatus(ctx context.Context, vs *api.Va
 		},
 	}
 
+	// whether the encrypted unseal keys are published to the custodian secrets
+	keysPublished := false
+
 	for {
 		// Do not wait to update Phase ASAP.
 		latest, err := c.updateVaultCRStatus(vs.Name, vs.Namespace, &s)
@@ -71,6 +83,27 @@ func (c *VaultController) monitorAndUpdateStatus(ctx context.Context, vs *api.Va
 		}
 
 		c.updateLocalVaultCRStatus(vs, &s, tlsConfig)
+
+		if s.Initialized && !keysPublished {
+			if err := c.ensureUnsealKeysPublished(vs); err != nil {
+				glog.Errorf("vault status monitor: failed to publish unseal keys for the vault server %s/%s: %v", vs.Namespace, vs.Name, err)
+			} else {
+				keysPublished = true
+			}
+		}
+
+		if err := c.checkConfigChanged(vs); err != nil {
+			glog.Errorf("vault status monitor: failed to check config changes for the vault server %s/%s: %v", vs.Namespace, vs.Name, err)
+		}
+
+		if err := c.reconcileVaultPods(vs); err != nil {
+			glog.Errorf("vault status monitor: failed to reconcile pods for the vault server %s/%s: %v", vs.Namespace, vs.Name, err)
+		}
+
+		// rotate the operator generated certificates
+		if tls := vs.Status.TLS; tls != nil && tls.RenewalTime != nil && time.Now().After(tls.RenewalTime.Time) {
+			c.vsQueue.GetQueue().Add(vs.GetKey())
+		}
 	}
 }
 
@@ -117,9 +150,17 @@ func (c *VaultController) updateLocalVaultCRStatus(vs *api.VaultServer, s *api.V
 		hr, err := c.getVaultStatus(&p, tlsConfig)
 		if err != nil {
 			glog.Error("vault status monitor:", err)
+			// unreachable pod must not be selected by the active service
+			if err := c.ensurePodRoleLabels(&p, nil); err != nil {
+				glog.Error("vault status monitor:", err)
+			}
 			continue
 		}
 
+		if err := c.ensurePodRoleLabels(&p, hr); err != nil {
+			glog.Error("vault status monitor:", err)
+		}
+
 		changed = true
 
 		if p.Spec.Containers[0].Image == version.Spec.Vault.Image {
@@ -162,6 +203,38 @@ func (c *VaultController) updateLocalVaultCRStatus(vs *api.VaultServer, s *api.V
 	}
 }
 
+// ensurePodRoleLabels labels the vault pod according to its health response.
+// If the health response is nil, the pod is labelled as not active.
+func (c *VaultController) ensurePodRoleLabels(p *corev1.Pod, hr *vaultapi.HealthResponse) error {
+	lbl := map[string]string{
+		VaultActiveLabel: "false",
+	}
+	if hr != nil {
+		lbl[VaultActiveLabel] = strconv.FormatBool(hr.Initialized && !hr.Sealed && !hr.Standby)
+		lbl[VaultSealedLabel] = strconv.FormatBool(hr.Sealed)
+		lbl[VaultInitializedLabel] = strconv.FormatBool(hr.Initialized)
+	}
+
+	changed := false
+	for k, v := range lbl {
+		if p.Labels[k] != v {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	_, _, err := core_util.PatchPod(c.kubeClient, p, func(in *corev1.Pod) *corev1.Pod {
+		in.Labels = core_util.UpsertMap(in.Labels, lbl)
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to patch role labels of pod %s/%s", p.Namespace, p.Name)
+	}
+	return nil
+}
+
 // updateVaultCRStatus updates the status field of the Vault CR.
 func (c *VaultController) updateVaultCRStatus(name, namespace string, status *api.VaultServerStatus) (*api.VaultServer, error) {
 	vault, err := c.extClient.KubevaultV1alpha1().VaultServers(namespace).Get(name, metav1.GetOptions{})
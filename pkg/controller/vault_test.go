@@ -93,7 +93,7 @@ func getVaultObjectMeta(i int) metav1.ObjectMeta {
 }
 
 func getConfigData(extraConfig string, storageCfg string, exptrCfg string) string {
-	cfg := util.GetListenerConfig()
+	cfg := util.GetListenerConfig(nil)
 	if len(extraConfig) != 0 {
 		cfg = fmt.Sprintf("%s\n%s", cfg, extraConfig)
 	}
@@ -329,3 +329,40 @@ func TestApply(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRoleServices(t *testing.T) {
+	v := &vaultSrv{
+		vs: &api.VaultServer{
+			ObjectMeta: getVaultObjectMeta(1),
+		},
+	}
+
+	svcs := v.GetRoleServices()
+	if !assert.Len(t, svcs, 3) {
+		return
+	}
+	for _, svc := range svcs {
+		assert.True(t, svc.Spec.PublishNotReadyAddresses, "service %s must publish not ready addresses", svc.Name)
+		for k, val := range v.vs.OffshootSelectors() {
+			assert.Equal(t, val, svc.Spec.Selector[k], "service %s must select the vault pods", svc.Name)
+		}
+	}
+
+	active, standby, internal := svcs[0], svcs[1], svcs[2]
+	assert.Equal(t, v.vs.ActiveServiceName(), active.Name)
+	assert.Equal(t, "true", active.Spec.Selector[VaultActiveLabel])
+	assert.Equal(t, v.vs.StandbyServiceName(), standby.Name)
+	assert.Equal(t, "false", standby.Spec.Selector[VaultActiveLabel])
+	assert.Equal(t, "false", standby.Spec.Selector[VaultSealedLabel])
+	assert.Equal(t, "true", standby.Spec.Selector[VaultInitializedLabel])
+	assert.Equal(t, v.vs.InternalServiceName(), internal.Name)
+	assert.Equal(t, core.ClusterIPNone, internal.Spec.ClusterIP)
+	assert.NotContains(t, internal.Spec.Selector, VaultActiveLabel)
+}
+
+func TestHealthCheckPath(t *testing.T) {
+	assert.Equal(t, "/v1/sys/health", healthCheckPath(nil))
+	assert.Equal(t, "/v1/sys/health", healthCheckPath(&api.HealthCheckSpec{}))
+	assert.Equal(t, "/v1/sys/health?standbyok=true", healthCheckPath(&api.HealthCheckSpec{StandbyOK: true}))
+	assert.Equal(t, "/v1/sys/health?perfstandbyok=true&standbyok=true", healthCheckPath(&api.HealthCheckSpec{StandbyOK: true, PerfStandbyOK: true}))
+}
@@ -0,0 +1,418 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"bytes"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"path/filepath"
+	"time"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/util"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	"gomodules.xyz/cert"
+	"gomodules.xyz/cert/certstore"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+const (
+	// keys of the secret containing the operator generated CA
+	caCertKey         = "ca.crt"
+	caKeyKey          = "ca.key"
+	nextCACertKey     = "next-ca.crt"
+	nextCAKeyKey      = "next-ca.key"
+	previousCACertKey = "previous-ca.crt"
+
+	defaultTLSRenewBefore = 30 * 24 * time.Hour
+)
+
+const certManagerGroup = "cert-manager.io"
+
+// getCertificateGVR returns the resource of the cert-manager Certificates in the
+// preferred version served by the cluster, as cert-manager serves different
+// versions across its releases
+func getCertificateGVR(dc discovery.DiscoveryInterface) (schema.GroupVersionResource, error) {
+	groups, err := dc.ServerGroups()
+	if err != nil {
+		return schema.GroupVersionResource{}, errors.Wrap(err, "failed to discover api groups")
+	}
+	for _, g := range groups.Groups {
+		if g.Name == certManagerGroup {
+			return schema.GroupVersionResource{
+				Group:    certManagerGroup,
+				Version:  g.PreferredVersion.Version,
+				Resource: "certificates",
+			}, nil
+		}
+	}
+	return schema.GroupVersionResource{}, errors.Errorf("api group %s is not served, cert-manager must be installed to use issuerRef", certManagerGroup)
+}
+
+// isOperatorManagedTLS returns true if the vault server TLS assets are generated by the operator
+func isOperatorManagedTLS(vs *api.VaultServer) bool {
+	tls := vs.Spec.TLS
+	return tls == nil || (tls.IssuerRef == nil && (tls.TLSSecret == "" || tls.TLSSecret == vs.TLSSecretName()))
+}
+
+// isIssuedTLS returns true if the vault server TLS secret is issued by cert-manager
+func isIssuedTLS(vs *api.VaultServer) bool {
+	return vs.Spec.TLS != nil && vs.Spec.TLS.IssuerRef != nil
+}
+
+func tlsRenewBefore(vs *api.VaultServer) time.Duration {
+	if vs.Spec.TLS != nil && vs.Spec.TLS.RenewBefore != nil {
+		return vs.Spec.TLS.RenewBefore.Duration
+	}
+	return defaultTLSRenewBefore
+}
+
+// serverAltNames returns the names, the vault server certificate must be valid for
+// ref: https://kubernetes.io/docs/concepts/services-networking/dns-pod-service/
+func serverAltNames(vs *api.VaultServer) cert.AltNames {
+	return cert.AltNames{
+		DNSNames: []string{
+			"localhost",
+			fmt.Sprintf("*.%s.pod", vs.Namespace),
+			fmt.Sprintf("%s.%s.svc", vs.Name, vs.Namespace),
+			fmt.Sprintf("%s.%s.svc", vs.ActiveServiceName(), vs.Namespace),
+			fmt.Sprintf("%s.%s.svc", vs.StandbyServiceName(), vs.Namespace),
+		},
+		IPs: []net.IP{
+			net.ParseIP("127.0.0.1"),
+		},
+	}
+}
+
+// getIssuedTLS returns the TLS secret issued by cert-manager and the CA bundle
+func (v *vaultSrv) getIssuedTLS() (*core.Secret, []byte, error) {
+	tls := v.vs.Spec.TLS
+	if tls.TLSSecret == "" {
+		tls.TLSSecret = v.vs.TLSSecretName()
+	}
+
+	sr, err := v.kubeClient.CoreV1().Secrets(v.vs.Namespace).Get(tls.TLSSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get secret %s/%s issued by %s %s", v.vs.Namespace, tls.TLSSecret, tls.IssuerRef.Kind, tls.IssuerRef.Name)
+	}
+
+	if len(tls.CABundle) == 0 {
+		tls.CABundle = sr.Data[caCertKey]
+	}
+	return sr, tls.CABundle, nil
+}
+
+// getOperatorTLS returns the operator generated TLS secret and CA bundle.
+// The server certificate is re-issued within the renewal window before it expires.
+// The CA is rotated in two stages, so that the clients trust the new CA before
+// the server certificate signed by it is served:
+//	- twice the renewal window before the CA expires, a new CA is added to the CA bundle
+//	- the renewal window before the CA expires, the new CA replaces the old one and
+//	  the server certificate is re-issued. The old CA is trusted until it expires.
+//
+// The CA is stored in a separate secret, so that it is not mounted to the vault pods.
+func (v *vaultSrv) getOperatorTLS() (*core.Secret, []byte, error) {
+	now := time.Now()
+	renewBefore := tlsRenewBefore(v.vs)
+	tlsSecretName := v.vs.TLSSecretName()
+
+	tlsSr, err := v.kubeClient.CoreV1().Secrets(v.vs.Namespace).Get(tlsSecretName, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		tlsSr = nil
+	} else if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get secret %s/%s", v.vs.Namespace, tlsSecretName)
+	}
+
+	caSr, err := v.kubeClient.CoreV1().Secrets(v.vs.Namespace).Get(v.vs.CASecretName(), metav1.GetOptions{})
+	if err != nil && !kerr.IsNotFound(err) {
+		return nil, nil, errors.Wrapf(err, "failed to get secret %s/%s", v.vs.Namespace, v.vs.CASecretName())
+	}
+	caExists := err == nil
+
+	data := map[string][]byte{}
+	if caExists {
+		for k, val := range caSr.Data {
+			data[k] = val
+		}
+	} else if tlsSr != nil {
+		// the CA key of TLS secrets generated by previous versions of the operator is not available,
+		// so trust the existing CA bundle until a new CA can be staged
+		data[caCertKey] = v.vs.Spec.TLS.CABundle
+	} else {
+		crt, key, err := newCA()
+		if err != nil {
+			return nil, nil, err
+		}
+		data[caCertKey], data[caKeyKey] = crt, key
+	}
+
+	caCrts, _ := cert.ParseCertsPEM(data[caCertKey])
+	canSign := len(caCrts) > 0 && len(data[caKeyKey]) > 0
+
+	// stage a new CA
+	if len(data[nextCACertKey]) == 0 && (!canSign || now.After(caCrts[0].NotAfter.Add(-2*renewBefore))) {
+		crt, key, err := newCA()
+		if err != nil {
+			return nil, nil, err
+		}
+		data[nextCACertKey], data[nextCAKeyKey] = crt, key
+		glog.Infof("staged new CA for VaultServer %s/%s", v.vs.Namespace, v.vs.Name)
+	}
+
+	var srvCrt *x509.Certificate
+	if tlsSr != nil {
+		if crts, err := cert.ParseCertsPEM(tlsSr.Data[core.TLSCertKey]); err == nil {
+			srvCrt = crts[0]
+		}
+	}
+	reissue := srvCrt == nil ||
+		now.After(srvCrt.NotAfter.Add(-renewBefore)) ||
+		(canSign && srvCrt.CheckSignatureFrom(caCrts[0]) != nil)
+
+	// replace the CA by the staged one
+	if len(data[nextCACertKey]) > 0 && ((!canSign && reissue) || (canSign && now.After(caCrts[0].NotAfter.Add(-renewBefore)))) {
+		data[previousCACertKey] = data[caCertKey]
+		data[caCertKey], data[caKeyKey] = data[nextCACertKey], data[nextCAKeyKey]
+		delete(data, nextCACertKey)
+		delete(data, nextCAKeyKey)
+		reissue = true
+		glog.Infof("rotated CA for VaultServer %s/%s", v.vs.Namespace, v.vs.Name)
+	}
+
+	// stop trusting the old CA after it has expired
+	if len(data[previousCACertKey]) > 0 {
+		prevCrts, _ := cert.ParseCertsPEM(data[previousCACertKey])
+		expired := true
+		for _, c := range prevCrts {
+			if now.Before(c.NotAfter) {
+				expired = false
+			}
+		}
+		if expired {
+			delete(data, previousCACertKey)
+		}
+	}
+
+	if !caExists || !equalData(caSr.Data, data) {
+		err = v.ensureCASecret(data)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if reissue {
+		store, err := certstore.NewCertStore(afero.NewMemMapFs(), filepath.Join("", "pki"))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "certificate store create error")
+		}
+		err = store.SetCA(data[caCertKey], data[caKeyKey])
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "ca certificate load error")
+		}
+		crt, key, err := store.NewServerCertPairBytes(serverAltNames(v.vs))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "vault server create crt/key pair error")
+		}
+
+		tlsSr = &core.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tlsSecretName,
+				Namespace: v.vs.Namespace,
+				Labels:    v.vs.OffshootLabels(),
+			},
+			Data: map[string][]byte{
+				core.TLSCertKey:       crt,
+				core.TLSPrivateKeyKey: key,
+			},
+		}
+		glog.Infof("issued server certificate for VaultServer %s/%s", v.vs.Namespace, v.vs.Name)
+	}
+
+	v.vs.Spec.TLS.CABundle = bytes.Join([][]byte{data[caCertKey], data[nextCACertKey], data[previousCACertKey]}, nil)
+	return tlsSr, v.vs.Spec.TLS.CABundle, nil
+}
+
+// ensureCASecret creates/patches the secret containing the operator generated CA
+func (v *vaultSrv) ensureCASecret(data map[string][]byte) error {
+	meta := metav1.ObjectMeta{
+		Name:      v.vs.CASecretName(),
+		Namespace: v.vs.Namespace,
+	}
+	_, _, err := core_util.CreateOrPatchSecret(v.kubeClient, meta, func(in *core.Secret) *core.Secret {
+		in.Labels = core_util.UpsertMap(in.Labels, v.vs.OffshootLabels())
+		in.Data = data
+		util.EnsureOwnerRefToObject(in, util.AsOwner(v.vs))
+		return in
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create secret %s/%s", meta.Namespace, meta.Name)
+	}
+	return nil
+}
+
+// newCA returns the PEM encoded certificate and key of a new CA
+func newCA() ([]byte, []byte, error) {
+	store, err := certstore.NewCertStore(afero.NewMemMapFs(), filepath.Join("", "pki"))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "certificate store create error")
+	}
+
+	err = store.NewCA()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "ca certificate create error")
+	}
+	return store.CACertBytes(), store.CAKeyBytes(), nil
+}
+
+func equalData(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if !bytes.Equal(v, b[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+// getTLSStatus returns the expiry of the server certificate and the CA bundle.
+// For the operator generated certificates, it also returns the time of the next rotation.
+func getTLSStatus(vs *api.VaultServer, sr *core.Secret, caBundle []byte) *api.TLSStatus {
+	status := &api.TLSStatus{}
+
+	var srvCrt *x509.Certificate
+	if crts, err := cert.ParseCertsPEM(sr.Data[core.TLSCertKey]); err == nil {
+		srvCrt = crts[0]
+		status.ServerCertificate = certificateStatus(srvCrt)
+	}
+
+	caCrts, _ := cert.ParseCertsPEM(caBundle)
+	for _, c := range caCrts {
+		status.CACertificates = append(status.CACertificates, *certificateStatus(c))
+	}
+
+	if !isOperatorManagedTLS(vs) || srvCrt == nil {
+		return status
+	}
+
+	// the steps of getOperatorTLS, relative to the CA signing the server certificate
+	renewBefore := tlsRenewBefore(vs)
+	renewal := srvCrt.NotAfter.Add(-renewBefore)
+	for _, c := range caCrts {
+		if srvCrt.CheckSignatureFrom(c) != nil {
+			continue
+		}
+		staged := false
+		for _, o := range caCrts {
+			if o.NotAfter.After(c.NotAfter) {
+				staged = true
+			} else if o.NotAfter.Before(c.NotAfter) && o.NotAfter.Before(renewal) {
+				renewal = o.NotAfter
+			}
+		}
+		next := c.NotAfter.Add(-renewBefore)
+		if !staged {
+			next = c.NotAfter.Add(-2 * renewBefore)
+		}
+		if next.Before(renewal) {
+			renewal = next
+		}
+		break
+	}
+	status.RenewalTime = &metav1.Time{Time: renewal}
+	return status
+}
+
+func certificateStatus(c *x509.Certificate) *api.CertificateStatus {
+	return &api.CertificateStatus{
+		CommonName:   c.Subject.CommonName,
+		SerialNumber: c.SerialNumber.String(),
+		NotAfter:     metav1.NewTime(c.NotAfter),
+	}
+}
+
+// ensureCertificate creates/updates the cert-manager Certificate issuing the vault server TLS secret
+func (c *VaultController) ensureCertificate(vs *api.VaultServer) error {
+	tls := vs.Spec.TLS
+	secretName := tls.TLSSecret
+	if secretName == "" {
+		secretName = vs.TLSSecretName()
+	}
+
+	altNames := serverAltNames(vs)
+	var dnsNames, ipAddresses []interface{}
+	for _, n := range altNames.DNSNames {
+		dnsNames = append(dnsNames, n)
+	}
+	for _, ip := range altNames.IPs {
+		ipAddresses = append(ipAddresses, ip.String())
+	}
+	issuerRef := map[string]interface{}{
+		"name": tls.IssuerRef.Name,
+		"kind": tls.IssuerRef.Kind,
+	}
+	if tls.IssuerRef.APIGroup != nil {
+		issuerRef["group"] = *tls.IssuerRef.APIGroup
+	}
+	spec := map[string]interface{}{
+		"secretName":  secretName,
+		"commonName":  fmt.Sprintf("%s.%s.svc", vs.Name, vs.Namespace),
+		"dnsNames":    dnsNames,
+		"ipAddresses": ipAddresses,
+		"issuerRef":   issuerRef,
+	}
+	if tls.RenewBefore != nil {
+		spec["renewBefore"] = tls.RenewBefore.Duration.String()
+	}
+
+	gvr, err := getCertificateGVR(c.kubeClient.Discovery())
+	if err != nil {
+		return err
+	}
+	client := c.dynamicClient.Resource(gvr).Namespace(vs.Namespace)
+	obj, err := client.Get(secretName, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		obj = &unstructured.Unstructured{}
+		obj.SetAPIVersion(gvr.GroupVersion().String())
+		obj.SetKind("Certificate")
+		obj.SetName(secretName)
+		obj.SetNamespace(vs.Namespace)
+		obj.SetLabels(vs.OffshootLabels())
+		util.EnsureOwnerRefToObject(obj, util.AsOwner(vs))
+		obj.Object["spec"] = spec
+		_, err = client.Create(obj, metav1.CreateOptions{})
+	} else if err == nil {
+		util.EnsureOwnerRefToObject(obj, util.AsOwner(vs))
+		obj.Object["spec"] = spec
+		_, err = client.Update(obj, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to create certificate %s/%s", vs.Namespace, secretName)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"testing"
+	"time"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
+
+	"github.com/stretchr/testify/assert"
+	"gomodules.xyz/cert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+func TestGetOperatorTLS(t *testing.T) {
+	const year = 365 * 24 * time.Hour
+
+	testData := []struct {
+		name          string
+		renewBefore   time.Duration
+		expectReissue bool
+		expectCAs     int
+		expectRotated bool
+	}{
+		{
+			name:          "certificates are valid",
+			renewBefore:   defaultTLSRenewBefore,
+			expectReissue: false,
+			expectCAs:     1,
+		},
+		{
+			name:          "server certificate is within renewal window",
+			renewBefore:   year + 24*time.Hour,
+			expectReissue: true,
+			expectCAs:     1,
+		},
+		{
+			name:          "new CA is staged",
+			renewBefore:   6 * year,
+			expectReissue: true,
+			expectCAs:     2,
+		},
+		{
+			name:          "CA is rotated",
+			renewBefore:   11 * year,
+			expectReissue: true,
+			expectCAs:     2,
+			expectRotated: true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.name, func(t *testing.T) {
+			v := vaultSrv{
+				kubeClient: kfake.NewSimpleClientset(),
+				vs: &api.VaultServer{
+					ObjectMeta: getVaultObjectMeta(1),
+				},
+			}
+
+			// initial certificates
+			sr, _, err := v.GetServerTLS()
+			if !assert.Nil(t, err) {
+				return
+			}
+			_, _, err = core_util.CreateOrPatchSecret(v.kubeClient, sr.ObjectMeta, func(in *core.Secret) *core.Secret {
+				in.Data = sr.Data
+				return in
+			})
+			if !assert.Nil(t, err) {
+				return
+			}
+			caSr, err := v.kubeClient.CoreV1().Secrets(v.vs.Namespace).Get(v.vs.CASecretName(), metav1.GetOptions{})
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			// the tls assets are read once per reconciliation
+			sr1, _, err := v.GetServerTLS()
+			if assert.Nil(t, err) {
+				assert.Equal(t, sr, sr1)
+			}
+
+			// next reconciliation
+			v = vaultSrv{
+				kubeClient: v.kubeClient,
+				vs:         v.vs,
+			}
+			v.vs.Spec.TLS.RenewBefore = &metav1.Duration{Duration: test.renewBefore}
+			sr2, caBundle, err := v.GetServerTLS()
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			assert.Equal(t, test.expectReissue, string(sr.Data[core.TLSCertKey]) != string(sr2.Data[core.TLSCertKey]))
+
+			cas, err := cert.ParseCertsPEM(caBundle)
+			if assert.Nil(t, err) && assert.Len(t, cas, test.expectCAs) {
+				srvCrts, err := cert.ParseCertsPEM(sr2.Data[core.TLSCertKey])
+				if assert.Nil(t, err) {
+					// server certificate is signed by the first CA of the bundle
+					assert.Nil(t, srvCrts[0].CheckSignatureFrom(cas[0]))
+				}
+				assert.Equal(t, test.expectRotated, string(caSr.Data[caCertKey]) != string(cert.EncodeCertPEM(cas[0])))
+			}
+
+			status := getTLSStatus(v.vs, sr2, caBundle)
+			if assert.NotNil(t, status.ServerCertificate) && assert.NotNil(t, status.RenewalTime) {
+				assert.Len(t, status.CACertificates, test.expectCAs)
+				assert.False(t, status.RenewalTime.After(status.ServerCertificate.NotAfter.Time))
+			}
+		})
+	}
+}
+
+func TestGetCertificateGVR(t *testing.T) {
+	kc := kfake.NewSimpleClientset()
+	_, err := getCertificateGVR(kc.Discovery())
+	assert.NotNil(t, err, "cert-manager is not installed")
+
+	kc.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "cert-manager.io/v1",
+			APIResources: []metav1.APIResource{{Name: "certificates", Kind: "Certificate", Namespaced: true}},
+		},
+	}
+	gvr, err := getCertificateGVR(kc.Discovery())
+	if assert.Nil(t, err) {
+		assert.Equal(t, schema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}, gvr)
+	}
+}
@@ -172,6 +172,7 @@ func (c *VaultController) reconcileVault(vs *api.VaultServer, v Vault) error {
 
 	status.Conditions = []api.VaultServerCondition{}
 	status.ObservedGeneration = vs.Generation
+	status.TLS = vs.Status.TLS
 	err = c.updatedVaultServerStatus(&status, vs)
 	if err != nil {
 		return errors.Wrap(err, "failed to update status")
@@ -195,20 +196,35 @@ func (c *VaultController) reconcileVault(vs *api.VaultServer, v Vault) error {
 }
 
 func (c *VaultController) CreateVaultTLSSecret(vs *api.VaultServer, v Vault) error {
+	if isIssuedTLS(vs) {
+		err := c.ensureCertificate(vs)
+		if err != nil {
+			return err
+		}
+	}
+
 	sr, ca, err := v.GetServerTLS()
 	if err != nil {
 		return err
 	}
 
-	err = ensureSecret(c.kubeClient, vs, sr)
-	if err != nil {
-		return err
+	// secret issued by cert-manager is owned by the Certificate
+	if !isIssuedTLS(vs) {
+		err = ensureSecret(c.kubeClient, vs, sr)
+		if err != nil {
+			return err
+		}
 	}
+	vs.Status.TLS = getTLSStatus(vs, sr, ca)
 
 	_, _, err = patchutil.CreateOrPatchVaultServer(c.extClient.KubevaultV1alpha1(), vs.ObjectMeta, func(in *api.VaultServer) *api.VaultServer {
-		in.Spec.TLS = &api.TLSPolicy{
-			TLSSecret: sr.Name,
-			CABundle:  ca,
+		if in.Spec.TLS == nil {
+			in.Spec.TLS = &api.TLSPolicy{}
+		}
+		in.Spec.TLS.TLSSecret = sr.Name
+		// CA bundle of the issued secret is read from the secret, so that the issuer can rotate it
+		if !isIssuedTLS(in) {
+			in.Spec.TLS.CABundle = ca
 		}
 		return in
 	})
@@ -226,6 +242,7 @@ func (c *VaultController) CreateVaultConfig(vs *api.VaultServer, v Vault) error
 // - create service account for vault pod
 // - create deployment
 // - create service
+// - create active, standby and headless services
 // - create rbac role, rolebinding and cluster rolebinding
 func (c *VaultController) DeployVault(vs *api.VaultServer, v Vault) error {
 	saList := v.GetServiceAccounts()
@@ -242,6 +259,13 @@ func (c *VaultController) DeployVault(vs *api.VaultServer, v Vault) error {
 		return err
 	}
 
+	for _, svc := range v.GetRoleServices() {
+		err = ensureService(c.kubeClient, vs, &svc)
+		if err != nil {
+			return err
+		}
+	}
+
 	rList, rBList := v.GetRBACRolesAndRoleBindings()
 	err = ensureRoleAndRoleBinding(c.kubeClient, vs, rList, rBList)
 	if err != nil {
@@ -263,6 +287,14 @@ func (c *VaultController) DeployVault(vs *api.VaultServer, v Vault) error {
 		return err
 	}
 
+	// roll out the vault pods when the config or any of the referenced secrets change
+	configHash, err := getConfigHash(c.kubeClient, vs.Namespace, podT)
+	if err != nil {
+		return err
+	}
+	podT.Annotations = core_util.UpsertMap(map[string]string{}, podT.Annotations)
+	podT.Annotations[VaultConfigHashAnnotation] = configHash
+
 	d := v.GetDeployment(podT)
 	err = ensureDeployment(c.kubeClient, vs, d)
 	if err != nil {
@@ -381,6 +413,7 @@ func ensureService(kc kubernetes.Interface, vs *api.VaultServer, svc *core.Servi
 		in.Spec.ExternalIPs = svc.Spec.ExternalIPs
 		in.Spec.LoadBalancerSourceRanges = svc.Spec.LoadBalancerSourceRanges
 		in.Spec.ExternalTrafficPolicy = svc.Spec.ExternalTrafficPolicy
+		in.Spec.PublishNotReadyAddresses = svc.Spec.PublishNotReadyAddresses
 		if svc.Spec.HealthCheckNodePort > 0 {
 			in.Spec.HealthCheckNodePort = svc.Spec.HealthCheckNodePort
 		}
@@ -67,6 +67,9 @@ func (v *vaultFake) Apply(pt *core.PodTemplateSpec) error {
 func (v *vaultFake) GetService() *core.Service {
 	return v.svc
 }
+func (v *vaultFake) GetRoleServices() []core.Service {
+	return nil
+}
 func (v *vaultFake) GetDeployment(pt *core.PodTemplateSpec) *appsv1.Deployment {
 	return v.dp
 }
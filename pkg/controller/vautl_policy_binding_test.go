@@ -45,6 +45,14 @@ func (f *fakePBind) Delete(n string) error {
 	return nil
 }
 
+func (f *fakePBind) SharedPolicies() []string {
+	return nil
+}
+
+func (f *fakePBind) SharedSubjects() *policyapi.SharedSubjectRef {
+	return nil
+}
+
 func simpleVaultPolicyBinding() *policyapi.VaultPolicyBinding {
 	return &policyapi.VaultPolicyBinding{
 		ObjectMeta: metav1.ObjectMeta{
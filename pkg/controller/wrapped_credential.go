@@ -0,0 +1,246 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/credential"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+const (
+	// interval to look up the wrapping token of a wrapped credential
+	wrappingLookupInterval = time.Minute
+
+	WrappingTokenMissing          = "WrappingTokenMissing"
+	WrappingTokenReplaced         = "WrappingTokenReplaced"
+	UnwrappedByUnexpectedSubjects = "UnwrappedByUnexpectedSubjects"
+	UnwrapNotVerified             = "UnwrapNotVerified"
+)
+
+// getCredential gets the credential from vault.
+// If the delivery is wrapped, the credential is response-wrapped.
+func getCredential(cm credential.CredentialManager, delivery api.CredentialDelivery, wrapTTL string) (*vaultapi.Secret, error) {
+	if delivery.IsWrapped() {
+		return cm.GetWrappedCredential(api.WrapTTLOrDefault(wrapTTL))
+	}
+	return cm.GetCredential()
+}
+
+// revokeCredential revokes the credential, i.e. when it can not be delivered.
+// For a wrapped credential, the wrapping token is revoked along with the lease.
+func revokeCredential(cm credential.CredentialManager, credSecret *vaultapi.Secret) error {
+	if credSecret.WrapInfo != nil {
+		if err := cm.RevokeWrappingToken(credSecret.WrapInfo.Accessor); err != nil {
+			return err
+		}
+	}
+	if len(credSecret.LeaseID) == 0 {
+		return nil
+	}
+	return cm.RevokeLease(credSecret.LeaseID)
+}
+
+// finalizeWrappedCredential revokes the wrapping token,
+// if it is neither unwrapped nor expired yet
+func finalizeWrappedCredential(cm credential.CredentialManager, ws *api.WrappingStatus) error {
+	if ws == nil || !ws.IsPending() || ws.Accessor == "" {
+		return nil
+	}
+	return cm.RevokeWrappingToken(ws.Accessor)
+}
+
+func newWrappingStatus(credSecret *vaultapi.Secret) *api.WrappingStatus {
+	w := credSecret.WrapInfo
+	return &api.WrappingStatus{
+		Accessor:     w.Accessor,
+		CreationPath: w.CreationPath,
+		CreationTime: metav1.NewTime(w.CreationTime),
+		TTL: metav1.Duration{
+			Duration: time.Second * time.Duration(w.TTL),
+		},
+	}
+}
+
+// syncWrappingStatus looks up the wrapping token stored in the secret and updates
+// the wrapping status. It returns the reason and message of an alert, if the wrapping
+// token is not used as intended.
+//
+// Vault reports an unwrapped and a revoked wrapping token the same way. So, the token
+// is considered to be revoked, if the lease of the credential is revoked too.
+// Vault does not report who unwrapped the token either. So, the token is considered to
+// be unwrapped by an intended subject, only if a pod of a service account subject which
+// uses the secret is running. As it can't be verified for the User and Group subjects,
+// a separate alert is raised for them.
+func (c *VaultController) syncWrappingStatus(cm credential.CredentialManager, ns, secretName string, subjects []rbac.Subject, ws *api.WrappingStatus, lease *api.Lease) (string, string, error) {
+	if !ws.IsPending() {
+		return "", "", nil
+	}
+
+	secret, err := c.kubeClient.CoreV1().Secrets(ns).Get(secretName, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return WrappingTokenMissing, fmt.Sprintf("secret %s/%s is not found", ns, secretName), nil
+	} else if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get secret %s/%s", ns, secretName)
+	}
+	token, ok := secret.Data[credential.WrappingTokenKey]
+	if !ok || len(token) == 0 {
+		return WrappingTokenMissing, fmt.Sprintf("wrapping token is missing in secret %s/%s", ns, secretName), nil
+	}
+
+	info, err := cm.LookupWrappingToken(string(token))
+	if err != nil {
+		return "", "", err
+	}
+	if info != nil {
+		if !isWrappingTokenOf(info, ws) {
+			return WrappingTokenReplaced, fmt.Sprintf("wrapping token in secret %s/%s is not the one issued by the operator", ns, secretName), nil
+		}
+		return "", "", nil
+	}
+
+	now := time.Now()
+	if !now.Before(ws.ExpirationTime()) {
+		ws.Expired = true
+		return "", "", nil
+	}
+	if lease != nil && len(lease.ID) > 0 {
+		revoked, err := cm.IsLeaseExpired(lease.ID)
+		if err != nil {
+			return "", "", err
+		}
+		if revoked {
+			ws.Revoked = true
+			return "", "", nil
+		}
+	}
+	ws.Unwrapped = true
+	ws.UnwrapTime = &metav1.Time{Time: now}
+
+	ok, err = c.hasRunningSubjectPod(ns, secretName, subjects)
+	if err != nil {
+		return "", "", err
+	}
+	if ok {
+		return "", "", nil
+	}
+	for _, s := range subjects {
+		if s.Kind != rbac.ServiceAccountKind {
+			return UnwrapNotVerified, fmt.Sprintf("wrapping token was unwrapped while no pod using secret %s/%s was running, it can't be verified for %s subjects", ns, secretName, s.Kind), nil
+		}
+	}
+	return UnwrappedByUnexpectedSubjects, fmt.Sprintf("wrapping token was unwrapped while no pod using secret %s/%s was running", ns, secretName), nil
+}
+
+// isWrappingTokenOf checks whether the looked up wrapping token is the one
+// the operator has issued, using its creation path and time
+func isWrappingTokenOf(info *vaultapi.Secret, ws *api.WrappingStatus) bool {
+	if path, ok := info.Data["creation_path"].(string); ok && path != ws.CreationPath {
+		return false
+	}
+	if s, ok := info.Data["creation_time"].(string); ok {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil || !t.Truncate(time.Second).Equal(ws.CreationTime.Truncate(time.Second)) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasRunningSubjectPod checks whether any running pod of the service account subjects uses the secret
+func (c *VaultController) hasRunningSubjectPod(ns, secretName string, subjects []rbac.Subject) (bool, error) {
+	for _, s := range subjects {
+		if s.Kind != rbac.ServiceAccountKind {
+			continue
+		}
+		// the secret can only be mounted by the pods of its own namespace
+		if s.Namespace != "" && s.Namespace != ns {
+			continue
+		}
+
+		pods, err := c.kubeClient.CoreV1().Pods(ns).List(metav1.ListOptions{
+			FieldSelector: fields.SelectorFromSet(fields.Set{
+				"spec.serviceAccountName": s.Name,
+				"status.phase":            string(core.PodRunning),
+			}).String(),
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to list pods of service account %s/%s", ns, s.Name)
+		}
+		for _, p := range pods.Items {
+			if p.Spec.ServiceAccountName == s.Name && p.Status.Phase == core.PodRunning && podUsesSecret(&p, secretName) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// podUsesSecret checks whether the secret is mounted as a volume or used in the env of the pod
+func podUsesSecret(pod *core.Pod, secretName string) bool {
+	for _, v := range pod.Spec.Volumes {
+		if v.Secret != nil && v.Secret.SecretName == secretName {
+			return true
+		}
+		if v.Projected != nil {
+			for _, src := range v.Projected.Sources {
+				if src.Secret != nil && src.Secret.Name == secretName {
+					return true
+				}
+			}
+		}
+	}
+
+	containers := append([]core.Container{}, pod.Spec.InitContainers...)
+	containers = append(containers, pod.Spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.EnvFrom {
+			if env.SecretRef != nil && env.SecretRef.Name == secretName {
+				return true
+			}
+		}
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == secretName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requeueWrappingLookup requeues the access request to look up the wrapping token again,
+// until it is unwrapped or expired
+func requeueWrappingLookup(q *queue.Worker, meta metav1.ObjectMeta, ws *api.WrappingStatus) {
+	if q == nil || ws == nil || !ws.IsPending() {
+		return
+	}
+	d := wrappingLookupInterval
+	if left := time.Until(ws.ExpirationTime()); left >= 0 && left < d {
+		d = left + time.Second
+	}
+	q.GetQueue().AddAfter(fmt.Sprintf("%s/%s", meta.Namespace, meta.Name), d)
+}
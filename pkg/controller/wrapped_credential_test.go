@@ -0,0 +1,202 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestHasRunningSubjectPod(t *testing.T) {
+	pod := &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: "demo",
+		},
+		Spec: core.PodSpec{
+			ServiceAccountName: "app",
+			Volumes: []core.Volume{
+				{
+					Name: "cred",
+					VolumeSource: core.VolumeSource{
+						Secret: &core.SecretVolumeSource{
+							SecretName: "pg-cred",
+						},
+					},
+				},
+			},
+		},
+		Status: core.PodStatus{
+			Phase: core.PodRunning,
+		},
+	}
+	envPod := &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "worker",
+			Namespace: "demo",
+		},
+		Spec: core.PodSpec{
+			ServiceAccountName: "worker",
+			Containers: []core.Container{
+				{
+					Name: "worker",
+					EnvFrom: []core.EnvFromSource{
+						{
+							SecretRef: &core.SecretEnvSource{
+								LocalObjectReference: core.LocalObjectReference{Name: "pg-cred"},
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: core.PodStatus{
+			Phase: core.PodRunning,
+		},
+	}
+
+	cases := []struct {
+		testName   string
+		secretName string
+		subjects   []rbac.Subject
+		expected   bool
+	}{
+		{
+			testName:   "pod of service account mounting the secret is running",
+			secretName: "pg-cred",
+			subjects: []rbac.Subject{
+				{
+					Kind: rbac.ServiceAccountKind,
+					Name: "app",
+				},
+			},
+			expected: true,
+		},
+		{
+			testName:   "pod of service account using the secret in env is running",
+			secretName: "pg-cred",
+			subjects: []rbac.Subject{
+				{
+					Kind:      rbac.ServiceAccountKind,
+					Name:      "worker",
+					Namespace: "demo",
+				},
+			},
+			expected: true,
+		},
+		{
+			testName:   "pod of service account doesn't use the secret",
+			secretName: "other-cred",
+			subjects: []rbac.Subject{
+				{
+					Kind: rbac.ServiceAccountKind,
+					Name: "app",
+				},
+			},
+			expected: false,
+		},
+		{
+			testName:   "service account is in another namespace",
+			secretName: "pg-cred",
+			subjects: []rbac.Subject{
+				{
+					Kind:      rbac.ServiceAccountKind,
+					Name:      "app",
+					Namespace: "other",
+				},
+			},
+			expected: false,
+		},
+		{
+			testName:   "no service account subject",
+			secretName: "pg-cred",
+			subjects: []rbac.Subject{
+				{
+					Kind: rbac.UserKind,
+					Name: "nahid",
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			ctrl := &VaultController{
+				kubeClient: kfake.NewSimpleClientset(pod, envPod),
+			}
+			ok, err := ctrl.hasRunningSubjectPod("demo", c.secretName, c.subjects)
+			if assert.Nil(t, err) {
+				assert.Equal(t, c.expected, ok)
+			}
+		})
+	}
+}
+
+func TestIsWrappingTokenOf(t *testing.T) {
+	created := time.Date(2019, 10, 1, 10, 0, 0, 500, time.UTC)
+	ws := &api.WrappingStatus{
+		CreationPath: "database/creds/test",
+		CreationTime: metav1.NewTime(created),
+	}
+
+	cases := []struct {
+		testName string
+		data     map[string]interface{}
+		expected bool
+	}{
+		{
+			testName: "wrapping token is issued by the operator",
+			data: map[string]interface{}{
+				"creation_path": "database/creds/test",
+				"creation_time": "2019-10-01T10:00:00Z",
+			},
+			expected: true,
+		},
+		{
+			testName: "wrapping token is created at another time",
+			data: map[string]interface{}{
+				"creation_path": "database/creds/test",
+				"creation_time": "2019-10-01T10:05:00Z",
+			},
+			expected: false,
+		},
+		{
+			testName: "wrapping token is created in another path",
+			data: map[string]interface{}{
+				"creation_path": "database/creds/test",
+				"creation_time": "2019-10-01T10:00:00Z",
+			},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			assert.Equal(t, c.expected, isWrappingTokenOf(&vaultapi.Secret{Data: c.data}, ws))
+		})
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package credentialprovider
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	cs "kubevault.dev/operator/client/clientset/versioned"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	authentication "k8s.io/api/authentication/v1"
+	rbac "k8s.io/api/rbac/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// AWSCredentialsPath is the path of the endpoint, which serves the
+	// credential of the AWSAccessKeyRequest <namespace>/<name> at
+	// /aws/credentials/<namespace>/<name>
+	AWSCredentialsPath = "/aws/credentials/"
+
+	// DefaultAudience is the default audience of the projected service
+	// account tokens, which the pods are authenticated by
+	DefaultAudience = "vault-operator"
+)
+
+// AWSCredentialsHandler serves the credential of an approved AWSAccessKeyRequest
+// to the pods, whose service account is a subject of the request. AWS SDKs use
+// it as the container credential provider, if the pod sets
+//
+//	AWS_CONTAINER_CREDENTIALS_FULL_URI=https://<operator>/aws/credentials/<namespace>/<name>
+//	AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE=<path of the projected service account token>
+//
+// The service account token must be projected with the audience of the handler.
+//
+// The endpoint is served with the serving certificate of the operator, which is signed
+// by the self-signed CA generated by the installer or the chart. So, the pods must trust
+// that CA, i.e. read it from the caBundle of the APIService v1alpha1.validators.kubevault.com,
+// mount it from a ConfigMap and set AWS_CA_BUNDLE to its path, or add it to the trust store
+// of the image. The chart generates a new CA on every upgrade, so the ConfigMap must be updated too.
+type AWSCredentialsHandler struct {
+	KubeClient kubernetes.Interface
+	ExtClient  cs.Interface
+
+	// Audience of the projected service account tokens
+	Audience string
+}
+
+var _ http.Handler = &AWSCredentialsHandler{}
+
+// containerCredentials is the response expected by the container credential provider of the AWS SDKs
+type containerCredentials struct {
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string `json:"SecretAccessKey"`
+	Token           string `json:"Token,omitempty"`
+	// RFC3339 timestamp, when the credential expires
+	Expiration string `json:"Expiration,omitempty"`
+}
+
+func (h *AWSCredentialsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, kerr.NewMethodNotSupported(api.Resource(api.ResourceAWSAccessKeyRequests), r.Method))
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, AWSCredentialsPath), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		writeError(w, kerr.NewBadRequest("path must be "+AWSCredentialsPath+"<namespace>/<name>"))
+		return
+	}
+
+	saNamespace, saName, err := h.authenticate(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	creds, err := h.getCredentials(parts[0], parts[1], saNamespace, saName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(creds); err != nil {
+		glog.Errorf("failed to write aws credentials: %v", err)
+	}
+}
+
+// authenticate reviews the service account token of the request.
+// It returns the namespace and the name of the service account.
+func (h *AWSCredentialsHandler) authenticate(r *http.Request) (string, string, error) {
+	// AWS SDKs send the token as it is in the Authorization header
+	token := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if token == "" {
+		return "", "", kerr.NewUnauthorized("authorization token is missing")
+	}
+
+	review, err := h.KubeClient.AuthenticationV1().TokenReviews().Create(&authentication.TokenReview{
+		Spec: authentication.TokenReviewSpec{
+			Token:     token,
+			Audiences: []string{h.audience()},
+		},
+	})
+	if err != nil {
+		return "", "", kerr.NewInternalError(err)
+	}
+	if !review.Status.Authenticated {
+		return "", "", kerr.NewUnauthorized("authorization token is not valid: " + review.Status.Error)
+	}
+
+	ns, name, err := serviceaccount.SplitUsername(review.Status.User.Username)
+	if err != nil {
+		return "", "", kerr.NewUnauthorized("authorization token is not a service account token")
+	}
+	return ns, name, nil
+}
+
+// getCredentials returns the credential of the AWSAccessKeyRequest,
+// if the service account is a subject of the approved request
+func (h *AWSCredentialsHandler) getCredentials(namespace, name, saNamespace, saName string) (*containerCredentials, error) {
+	awsAKReq, err := h.ExtClient.EngineV1alpha1().AWSAccessKeyRequests(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSubject(awsAKReq.Spec.Subjects, namespace, saNamespace, saName) {
+		return nil, kerr.NewForbidden(api.Resource(api.ResourceAWSAccessKeyRequests), name,
+			errors.Errorf("service account %s/%s is not a subject of the request", saNamespace, saName))
+	}
+	var conditions []api.RequestConditionType
+	for _, c := range awsAKReq.Status.Conditions {
+		conditions = append(conditions, c.Type)
+	}
+	if !api.IsRequestApproved(conditions) {
+		return nil, kerr.NewForbidden(api.Resource(api.ResourceAWSAccessKeyRequests), name,
+			errors.New("request is not approved"))
+	}
+	if awsAKReq.Status.Wrapping != nil {
+		return nil, kerr.NewBadRequest("credential of the request is response-wrapped")
+	}
+	if awsAKReq.Status.Secret == nil {
+		return nil, kerr.NewServiceUnavailable("credential of the request is not issued yet")
+	}
+
+	secret, err := h.KubeClient.CoreV1().Secrets(namespace).Get(awsAKReq.Status.Secret.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	creds := &containerCredentials{
+		AccessKeyID:     string(secret.Data[api.AWSCredentialAccessKeyKey]),
+		SecretAccessKey: string(secret.Data[api.AWSCredentialSecretKeyKey]),
+		Token:           string(secret.Data[api.AWSCredentialSecurityTokenKey]),
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return nil, kerr.NewServiceUnavailable("credential of the request is not issued yet")
+	}
+	if awsAKReq.Status.ExpiresAt != nil {
+		creds.Expiration = awsAKReq.Status.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return creds, nil
+}
+
+func (h *AWSCredentialsHandler) audience() string {
+	if h.Audience == "" {
+		return DefaultAudience
+	}
+	return h.Audience
+}
+
+// isSubject returns whether the service account is a subject of the request.
+// The namespace of the subject defaults to the namespace of the request.
+func isSubject(subjects []rbac.Subject, namespace, saNamespace, saName string) bool {
+	for _, s := range subjects {
+		if s.Kind != rbac.ServiceAccountKind || s.Name != saName {
+			continue
+		}
+		ns := s.Namespace
+		if ns == "" {
+			ns = namespace
+		}
+		if ns == saNamespace {
+			return true
+		}
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	if status, ok := err.(kerr.APIStatus); ok {
+		code = int(status.Status().Code)
+	}
+	http.Error(w, err.Error(), code)
+}
@@ -52,6 +52,8 @@ const (
 	EventReasonStatsServiceDeleteSuccessful           = "StatsServiceDeleteSuccessful"
 	EventReasonStatsServiceReconcileFailed            = "StatsServiceReconcileFailed"
 	EventReasonStatsServiceReconcileSuccessful        = "StatsServiceReconcileSuccessful"
+	EventReasonUnsealKeysPublished                    = "UnsealKeysPublished"
+	EventReasonVaultTLSReloaded                       = "VaultTLSReloaded"
 )
 
 func NewEventRecorder(client kubernetes.Interface, component string) record.EventRecorder {
@@ -23,6 +23,7 @@ import (
 	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
 	vsadmission "kubevault.dev/operator/pkg/admission"
 	"kubevault.dev/operator/pkg/controller"
+	"kubevault.dev/operator/pkg/credentialprovider"
 	"kubevault.dev/operator/pkg/eventer"
 
 	admission "k8s.io/api/admission/v1beta1"
@@ -125,16 +126,23 @@ func (c completedConfig) New() (*VaultServer, error) {
 	if c.ExtraConfig.EnableValidatingWebhook {
 		admissionHooks = append(admissionHooks,
 			&vsadmission.VaultServerValidator{},
+			&vsadmission.VaultPolicyValidator{PolicyDenylist: c.ExtraConfig.PolicyDenylist},
+			&vsadmission.PolicyIdentifierValidator{},
 			&vsadmission.DatabaseAccessRequestValidator{},
 			&vsadmission.AWSAccessKeyRequestValidator{},
 			&vsadmission.GCPAccessKeyRequestValidator{},
 			&vsadmission.AzureAccessKeyRequestValidator{},
+			&vsadmission.AzureRoleValidator{},
+			&vsadmission.AppRoleSecretIDRequestValidator{},
+			&vsadmission.VaultTokenRequestValidator{},
 		)
 	}
 	if c.ExtraConfig.EnableMutatingWebhook {
 		admissionHooks = append(admissionHooks,
 			&vsadmission.PolicyBindingMutator{},
 			&vsadmission.VaultServerMutator{},
+			&vsadmission.PodCredentialInjector{},
+			&vsadmission.VaultAgentInjector{},
 		)
 	}
 
@@ -143,6 +151,13 @@ func (c completedConfig) New() (*VaultServer, error) {
 		Controller:       ctrl,
 	}
 
+	// serves the aws credentials to the AWS SDKs of the pods
+	s.GenericAPIServer.Handler.NonGoRestfulMux.HandlePrefix(credentialprovider.AWSCredentialsPath, &credentialprovider.AWSCredentialsHandler{
+		KubeClient: c.ExtraConfig.KubeClient,
+		ExtClient:  c.ExtraConfig.ExtClient,
+		Audience:   c.ExtraConfig.AWSCredentialsAudience,
+	})
+
 	for _, versionMap := range admissionHooksByGroupThenVersion(admissionHooks...) {
 		// TODO we're going to need a later k8s.io/apiserver so that we can get discovery to list a different group version for
 		// our endpoint which we'll use to back some custom storage which will consume the AdmissionReview type and give back the correct response
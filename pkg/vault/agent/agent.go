@@ -0,0 +1,424 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"kubevault.dev/operator/apis"
+	config "kubevault.dev/operator/apis/config/v1alpha1"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	core_util "kmodules.xyz/client-go/core/v1"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+const (
+	// directory where the secret files are rendered by default
+	DefaultMountPath = "/vault/secrets"
+
+	InitContainerName = "vault-agent-init"
+	ContainerName     = "vault-agent"
+
+	// in-memory volume shared between the vault agent and the containers of the pod
+	secretsVolumeName = "vault-secrets"
+	// in-memory volume that holds the config, ca cert and token of the vault agent
+	homeVolumeName = "vault-agent-home"
+	homeDir        = "/home/vault"
+
+	serviceAccountTokenDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+	envConfig = "VAULT_CONFIG"
+	envCACert = "VAULT_CACERT_PEM"
+
+	// image of the vault agent, if neither the agent nor the vault image
+	// of the VaultServerVersion supports the template stanza
+	DefaultImage = "vault:1.3.1"
+)
+
+// the template stanza of the vault agent is supported since vault 1.3.0
+const (
+	minImageMajor = 1
+	minImageMinor = 3
+)
+
+// Options of the vault agent, read from the annotations of the pod
+type Options struct {
+	// name and namespace of the VaultServer
+	VaultServerName      string
+	VaultServerNamespace string
+
+	// vault role of the kubernetes auth method
+	Role string
+
+	// secrets rendered into files, sorted by the file names
+	Secrets []Secret
+
+	// directory where the secret files are rendered
+	MountPath string
+
+	// Specifies whether only the init container is injected
+	PrePopulateOnly bool
+}
+
+// Secret is rendered into a file by the vault agent
+type Secret struct {
+	// name of the file
+	Name string
+	// path of the secret in vault
+	Path string
+	// template of the file
+	Template string
+}
+
+// Server contains the info of the vault server, that the vault agent logs in to
+type Server struct {
+	Address               string
+	CABundle              []byte
+	InsecureSkipTLSVerify bool
+	// path where the kubernetes auth method is enabled
+	AuthPath string
+	// image of the vault agent
+	Image string
+}
+
+// ResolveImage returns the image of the vault agent. The agent image is used, if set.
+// Otherwise, the vault image is used, if it supports the template stanza, or DefaultImage.
+// It fails, if the agent image is older than vault 1.3.0.
+func ResolveImage(agentImage, vaultImage string) (string, error) {
+	if agentImage != "" {
+		if !supportsTemplates(agentImage) {
+			return "", errors.Errorf("vault agent image %s is older than vault %d.%d.0, which doesn't support templates", agentImage, minImageMajor, minImageMinor)
+		}
+		return agentImage, nil
+	}
+	if vaultImage != "" && supportsTemplates(vaultImage) {
+		return vaultImage, nil
+	}
+	return DefaultImage, nil
+}
+
+// supportsTemplates checks whether the vault version of the image tag supports the template stanza.
+// Images without a version tag, i.e. latest or a digest, are assumed to support it.
+func supportsTemplates(image string) bool {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i+1:], "/") {
+		return true
+	}
+	parts := strings.SplitN(strings.TrimPrefix(image[i+1:], "v"), ".", 3)
+	if len(parts) < 2 {
+		return true
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return true
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return true
+	}
+	return major > minImageMajor || (major == minImageMajor && minor >= minImageMinor)
+}
+
+// Config of the vault agent
+type Config struct {
+	ExitAfterAuth bool        `json:"exit_after_auth"`
+	PidFile       string      `json:"pid_file"`
+	Vault         VaultConfig `json:"vault"`
+	AutoAuth      AutoAuth    `json:"auto_auth"`
+	Templates     []Template  `json:"template,omitempty"`
+}
+
+type VaultConfig struct {
+	Address       string `json:"address"`
+	CACert        string `json:"ca_cert,omitempty"`
+	TLSSkipVerify bool   `json:"tls_skip_verify,omitempty"`
+}
+
+type AutoAuth struct {
+	Method Method `json:"method"`
+	Sinks  []Sink `json:"sink"`
+}
+
+type Method struct {
+	Type      string                 `json:"type"`
+	MountPath string                 `json:"mount_path"`
+	Config    map[string]interface{} `json:"config"`
+}
+
+type Sink struct {
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config"`
+}
+
+type Template struct {
+	Destination string `json:"destination"`
+	Contents    string `json:"contents"`
+}
+
+// OptionsFromAnnotations reads the options of the vault agent from the annotations of the pod
+func OptionsFromAnnotations(ns string, annotations map[string]string) (*Options, error) {
+	opts := &Options{
+		VaultServerNamespace: ns,
+		Role:                 annotations[apis.AgentRoleKey],
+		MountPath:            annotations[apis.AgentInjectMountPathKey],
+	}
+
+	vs := annotations[apis.AgentVaultServerKey]
+	if vs == "" {
+		return nil, errors.Errorf("annotation %s is missing", apis.AgentVaultServerKey)
+	}
+	if parts := strings.SplitN(vs, "/", 2); len(parts) == 2 {
+		opts.VaultServerNamespace, opts.VaultServerName = parts[0], parts[1]
+	} else {
+		opts.VaultServerName = vs
+	}
+
+	if opts.Role == "" {
+		return nil, errors.Errorf("annotation %s is missing", apis.AgentRoleKey)
+	}
+	if opts.MountPath == "" {
+		opts.MountPath = DefaultMountPath
+	}
+
+	if v, ok := annotations[apis.AgentPrePopulateOnlyKey]; ok {
+		var err error
+		if opts.PrePopulateOnly, err = strconv.ParseBool(v); err != nil {
+			return nil, errors.Wrapf(err, "invalid value of annotation %s", apis.AgentPrePopulateOnlyKey)
+		}
+	}
+
+	for k, v := range annotations {
+		if !strings.HasPrefix(k, apis.AgentInjectSecretKeyPrefix) {
+			continue
+		}
+		name := strings.TrimPrefix(k, apis.AgentInjectSecretKeyPrefix)
+		if name == "" || strings.Contains(name, "/") {
+			return nil, errors.Errorf("invalid secret file name in annotation %s", k)
+		}
+		tpl := annotations[apis.AgentInjectTemplateKeyPrefix+name]
+		if tpl == "" {
+			tpl = defaultTemplate(v)
+		}
+		opts.Secrets = append(opts.Secrets, Secret{
+			Name:     name,
+			Path:     v,
+			Template: tpl,
+		})
+	}
+	if len(opts.Secrets) == 0 {
+		return nil, errors.Errorf("no annotation with prefix %s is found", apis.AgentInjectSecretKeyPrefix)
+	}
+	sort.Slice(opts.Secrets, func(i, j int) bool {
+		return opts.Secrets[i].Name < opts.Secrets[j].Name
+	})
+
+	for k := range annotations {
+		if name := strings.TrimPrefix(k, apis.AgentInjectTemplateKeyPrefix); name != k {
+			if _, ok := annotations[apis.AgentInjectSecretKeyPrefix+name]; !ok {
+				return nil, errors.Errorf("annotation %s has no matching secret annotation", k)
+			}
+		}
+	}
+	return opts, nil
+}
+
+// defaultTemplate renders each key of the secret data as <key>: <value>
+func defaultTemplate(path string) string {
+	return fmt.Sprintf(`{{ with secret %q }}{{ range $k, $v := .Data }}{{ $k }}: {{ $v }}
+{{ end }}{{ end }}`, path)
+}
+
+// ServerFromAppBinding returns the vault server info from the AppBinding of the VaultServer
+func ServerFromAppBinding(app *appcat.AppBinding) (*Server, error) {
+	addr, err := app.URL()
+	if err != nil {
+		return nil, err
+	}
+	if app.Spec.Parameters == nil {
+		return nil, errors.Errorf("parameters of AppBinding %s/%s are missing", app.Namespace, app.Name)
+	}
+
+	var cf config.VaultServerConfiguration
+	err = json.Unmarshal(app.Spec.Parameters.Raw, &cf)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal parameters")
+	}
+
+	return &Server{
+		Address:               addr,
+		CABundle:              app.Spec.ClientConfig.CABundle,
+		InsecureSkipTLSVerify: app.Spec.ClientConfig.InsecureSkipTLSVerify,
+		AuthPath:              cf.Path,
+	}, nil
+}
+
+// NewConfig returns the config of the vault agent.
+// The vault agent of the init container exits after rendering the secret files once.
+func NewConfig(opts *Options, srv *Server, exitAfterAuth bool) *Config {
+	cfg := &Config{
+		ExitAfterAuth: exitAfterAuth,
+		PidFile:       filepath.Join(homeDir, ".pid"),
+		Vault: VaultConfig{
+			Address:       srv.Address,
+			TLSSkipVerify: srv.InsecureSkipTLSVerify,
+		},
+		AutoAuth: AutoAuth{
+			Method: Method{
+				Type:      "kubernetes",
+				MountPath: filepath.Join("auth", srv.AuthPath),
+				Config: map[string]interface{}{
+					"role": opts.Role,
+				},
+			},
+			Sinks: []Sink{
+				{
+					Type: "file",
+					Config: map[string]interface{}{
+						"path": filepath.Join(homeDir, ".vault-token"),
+					},
+				},
+			},
+		},
+	}
+	if len(srv.CABundle) > 0 && !srv.InsecureSkipTLSVerify {
+		cfg.Vault.CACert = filepath.Join(homeDir, "ca.crt")
+	}
+	for _, s := range opts.Secrets {
+		cfg.Templates = append(cfg.Templates, Template{
+			Destination: filepath.Join(opts.MountPath, s.Name),
+			Contents:    s.Template,
+		})
+	}
+	return cfg
+}
+
+// Inject adds the vault agent init container and, unless pre-populate only, the vault agent
+// sidecar into the pod. The secret files are rendered into an in-memory volume, that is
+// mounted into the containers of the pod.
+func Inject(pod *core.Pod, opts *Options, srv *Server) error {
+	// the service account token is already mounted into the containers of the pod
+	var tokenMount *core.VolumeMount
+	for _, c := range pod.Spec.Containers {
+		for _, m := range c.VolumeMounts {
+			if m.MountPath == serviceAccountTokenDir {
+				m := m
+				tokenMount = &m
+			}
+		}
+	}
+	if tokenMount == nil {
+		return errors.New("service account token is not mounted into the pod")
+	}
+
+	secretsMount := core.VolumeMount{
+		Name:      secretsVolumeName,
+		MountPath: opts.MountPath,
+	}
+	for i := range pod.Spec.InitContainers {
+		pod.Spec.InitContainers[i].VolumeMounts = core_util.UpsertVolumeMount(pod.Spec.InitContainers[i].VolumeMounts, secretsMount)
+	}
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].VolumeMounts = core_util.UpsertVolumeMount(pod.Spec.Containers[i].VolumeMounts, secretsMount)
+	}
+
+	pod.Spec.Volumes = core_util.UpsertVolume(pod.Spec.Volumes,
+		core.Volume{
+			Name: secretsVolumeName,
+			VolumeSource: core.VolumeSource{
+				EmptyDir: &core.EmptyDirVolumeSource{
+					Medium: core.StorageMediumMemory,
+				},
+			},
+		},
+		core.Volume{
+			Name: homeVolumeName,
+			VolumeSource: core.VolumeSource{
+				EmptyDir: &core.EmptyDirVolumeSource{
+					Medium: core.StorageMediumMemory,
+				},
+			},
+		},
+	)
+
+	initContainer, err := newContainer(InitContainerName, opts, srv, *tokenMount, true)
+	if err != nil {
+		return err
+	}
+	pod.Spec.InitContainers = append([]core.Container{*initContainer}, pod.Spec.InitContainers...)
+
+	if !opts.PrePopulateOnly {
+		container, err := newContainer(ContainerName, opts, srv, *tokenMount, false)
+		if err != nil {
+			return err
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, *container)
+	}
+
+	pod.Annotations = core_util.UpsertMap(pod.Annotations, map[string]string{
+		apis.AgentInjectStatusKey: apis.AgentInjectStatusInjected,
+	})
+	return nil
+}
+
+func newContainer(name string, opts *Options, srv *Server, tokenMount core.VolumeMount, exitAfterAuth bool) (*core.Container, error) {
+	cfg, err := json.Marshal(NewConfig(opts, srv, exitAfterAuth))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal vault agent config")
+	}
+
+	cmd := fmt.Sprintf(`echo "$%s" > %s && echo "$%s" > %s && exec vault agent -config=%s`,
+		envConfig, filepath.Join(homeDir, "config.json"),
+		envCACert, filepath.Join(homeDir, "ca.crt"),
+		filepath.Join(homeDir, "config.json"))
+
+	return &core.Container{
+		Name:    name,
+		Image:   srv.Image,
+		Command: []string{"/bin/sh", "-ec"},
+		Args:    []string{cmd},
+		Env: []core.EnvVar{
+			{
+				Name:  envConfig,
+				Value: string(cfg),
+			},
+			{
+				Name:  envCACert,
+				Value: string(srv.CABundle),
+			},
+		},
+		VolumeMounts: []core.VolumeMount{
+			{
+				Name:      secretsVolumeName,
+				MountPath: opts.MountPath,
+			},
+			{
+				Name:      homeVolumeName,
+				MountPath: homeDir,
+			},
+			tokenMount,
+		},
+	}, nil
+}
@@ -0,0 +1,295 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agent
+
+import (
+	"encoding/json"
+	"testing"
+
+	"kubevault.dev/operator/apis"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+func TestOptionsFromAnnotations(t *testing.T) {
+	cases := []struct {
+		testName    string
+		annotations map[string]string
+		expected    *Options
+		expectErr   bool
+	}{
+		{
+			testName: "secrets with and without template",
+			annotations: map[string]string{
+				apis.AgentVaultServerKey:                   "vault",
+				apis.AgentRoleKey:                          "app",
+				apis.AgentInjectSecretKeyPrefix + "db":     "database/creds/app",
+				apis.AgentInjectSecretKeyPrefix + "config": "secret/app",
+				apis.AgentInjectTemplateKeyPrefix + "db":   `{{ with secret "database/creds/app" }}{{ .Data.username }}{{ end }}`,
+			},
+			expected: &Options{
+				VaultServerName:      "vault",
+				VaultServerNamespace: "demo",
+				Role:                 "app",
+				MountPath:            DefaultMountPath,
+				Secrets: []Secret{
+					{
+						Name:     "config",
+						Path:     "secret/app",
+						Template: defaultTemplate("secret/app"),
+					},
+					{
+						Name:     "db",
+						Path:     "database/creds/app",
+						Template: `{{ with secret "database/creds/app" }}{{ .Data.username }}{{ end }}`,
+					},
+				},
+			},
+		},
+		{
+			testName: "vault server of another namespace, pre-populate only",
+			annotations: map[string]string{
+				apis.AgentVaultServerKey:               "vault-ns/vault",
+				apis.AgentRoleKey:                      "app",
+				apis.AgentInjectMountPathKey:           "/etc/secrets",
+				apis.AgentPrePopulateOnlyKey:           "true",
+				apis.AgentInjectSecretKeyPrefix + "kv": "secret/app",
+			},
+			expected: &Options{
+				VaultServerName:      "vault",
+				VaultServerNamespace: "vault-ns",
+				Role:                 "app",
+				MountPath:            "/etc/secrets",
+				PrePopulateOnly:      true,
+				Secrets: []Secret{
+					{
+						Name:     "kv",
+						Path:     "secret/app",
+						Template: defaultTemplate("secret/app"),
+					},
+				},
+			},
+		},
+		{
+			testName: "role is missing",
+			annotations: map[string]string{
+				apis.AgentVaultServerKey:               "vault",
+				apis.AgentInjectSecretKeyPrefix + "kv": "secret/app",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "vault server is missing",
+			annotations: map[string]string{
+				apis.AgentRoleKey:                      "app",
+				apis.AgentInjectSecretKeyPrefix + "kv": "secret/app",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "no secret",
+			annotations: map[string]string{
+				apis.AgentVaultServerKey: "vault",
+				apis.AgentRoleKey:        "app",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "template without secret",
+			annotations: map[string]string{
+				apis.AgentVaultServerKey:                 "vault",
+				apis.AgentRoleKey:                        "app",
+				apis.AgentInjectSecretKeyPrefix + "kv":   "secret/app",
+				apis.AgentInjectTemplateKeyPrefix + "db": "{{ . }}",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			opts, err := OptionsFromAnnotations("demo", c.annotations)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else if assert.Nil(t, err) {
+				assert.Equal(t, c.expected, opts)
+			}
+		})
+	}
+}
+
+func TestServerFromAppBinding(t *testing.T) {
+	app := &appcat.AppBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault",
+			Namespace: "demo",
+		},
+		Spec: appcat.AppBindingSpec{
+			ClientConfig: appcat.ClientConfig{
+				Service: &appcat.ServiceReference{
+					Name:   "vault",
+					Scheme: "https",
+					Port:   8200,
+				},
+				CABundle: []byte("ca"),
+			},
+			Parameters: &runtime.RawExtension{
+				Raw: []byte(`{"apiVersion":"config.kubevault.com/v1alpha1","kind":"VaultServerConfiguration","path":"k8s"}`),
+			},
+		},
+	}
+
+	srv, err := ServerFromAppBinding(app)
+	if assert.Nil(t, err) {
+		assert.Equal(t, &Server{
+			Address:  "https://vault.demo.svc:8200",
+			CABundle: []byte("ca"),
+			AuthPath: "k8s",
+		}, srv)
+	}
+}
+
+func TestResolveImage(t *testing.T) {
+	cases := []struct {
+		testName    string
+		agentImage  string
+		vaultImage  string
+		expectImage string
+		expectErr   bool
+	}{
+		{
+			testName:    "agent image",
+			agentImage:  "vault:1.3.1",
+			vaultImage:  "vault:1.2.2",
+			expectImage: "vault:1.3.1",
+		},
+		{
+			testName:   "agent image older than 1.3.0",
+			agentImage: "vault:1.2.3",
+			expectErr:  true,
+		},
+		{
+			testName:    "agent image from a registry with port",
+			agentImage:  "registry.local:5000/vault",
+			expectImage: "registry.local:5000/vault",
+		},
+		{
+			testName:    "agent image with digest",
+			agentImage:  "vault@sha256:abcd",
+			expectImage: "vault@sha256:abcd",
+		},
+		{
+			testName:    "vault image supports templates",
+			vaultImage:  "vault:1.4.0",
+			expectImage: "vault:1.4.0",
+		},
+		{
+			testName:    "vault image older than 1.3.0",
+			vaultImage:  "vault:1.2.2",
+			expectImage: DefaultImage,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			image, err := ResolveImage(c.agentImage, c.vaultImage)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else if assert.Nil(t, err) {
+				assert.Equal(t, c.expectImage, image)
+			}
+		})
+	}
+}
+
+func TestInject(t *testing.T) {
+	tokenMount := core.VolumeMount{
+		Name:      "default-token",
+		MountPath: serviceAccountTokenDir,
+		ReadOnly:  true,
+	}
+	newPod := func() *core.Pod {
+		return &core.Pod{
+			Spec: core.PodSpec{
+				Containers: []core.Container{
+					{
+						Name:         "app",
+						VolumeMounts: []core.VolumeMount{tokenMount},
+					},
+				},
+			},
+		}
+	}
+	opts := &Options{
+		Role:      "app",
+		MountPath: DefaultMountPath,
+		Secrets: []Secret{
+			{
+				Name:     "kv",
+				Path:     "secret/app",
+				Template: defaultTemplate("secret/app"),
+			},
+		},
+	}
+	srv := &Server{
+		Address:  "https://vault.demo.svc:8200",
+		CABundle: []byte("ca"),
+		AuthPath: "kubernetes",
+		Image:    "vault:1.3.1",
+	}
+
+	pod := newPod()
+	if assert.Nil(t, Inject(pod, opts, srv)) {
+		assert.Equal(t, apis.AgentInjectStatusInjected, pod.Annotations[apis.AgentInjectStatusKey])
+		assert.Len(t, pod.Spec.Volumes, 2)
+		if assert.Len(t, pod.Spec.InitContainers, 1) {
+			init := pod.Spec.InitContainers[0]
+			assert.Equal(t, InitContainerName, init.Name)
+			assert.Equal(t, "vault:1.3.1", init.Image)
+			assert.Contains(t, init.VolumeMounts, tokenMount)
+
+			var cfg Config
+			if assert.Nil(t, json.Unmarshal([]byte(init.Env[0].Value), &cfg)) {
+				assert.True(t, cfg.ExitAfterAuth)
+				assert.Equal(t, "auth/kubernetes", cfg.AutoAuth.Method.MountPath)
+				assert.Equal(t, "app", cfg.AutoAuth.Method.Config["role"])
+				assert.Equal(t, "/home/vault/ca.crt", cfg.Vault.CACert)
+				assert.Equal(t, []Template{{Destination: "/vault/secrets/kv", Contents: defaultTemplate("secret/app")}}, cfg.Templates)
+			}
+		}
+		if assert.Len(t, pod.Spec.Containers, 2) {
+			assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, core.VolumeMount{Name: secretsVolumeName, MountPath: DefaultMountPath})
+			assert.Equal(t, ContainerName, pod.Spec.Containers[1].Name)
+		}
+	}
+
+	pod = newPod()
+	prePopulateOnly := *opts
+	prePopulateOnly.PrePopulateOnly = true
+	if assert.Nil(t, Inject(pod, &prePopulateOnly, srv)) {
+		assert.Len(t, pod.Spec.InitContainers, 1)
+		assert.Len(t, pod.Spec.Containers, 1)
+	}
+
+	pod = newPod()
+	pod.Spec.Containers[0].VolumeMounts = nil
+	assert.NotNil(t, Inject(pod, opts, srv), "service account token is not mounted")
+}
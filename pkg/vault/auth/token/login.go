@@ -26,11 +26,16 @@ type auth struct {
 	token string
 }
 
+// New creates token auth from the AppBinding secret. The secrets issued
+// by VaultTokenRequest (type kubevault.com/token) can be used directly.
 func New(secret *core.Secret) (*auth, error) {
 	token, ok := secret.Data[apis.TokenAuthTokenKey]
 	if !ok {
 		return nil, errors.New("token is missing")
 	}
+	if len(token) == 0 {
+		return nil, errors.New("token is empty")
+	}
 	return &auth{
 		token: string(token),
 	}, nil
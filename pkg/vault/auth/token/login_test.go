@@ -0,0 +1,76 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package token
+
+import (
+	"testing"
+
+	"kubevault.dev/operator/apis"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+)
+
+func TestAuth_Login(t *testing.T) {
+	cases := []struct {
+		testName  string
+		secret    *core.Secret
+		expectErr bool
+	}{
+		{
+			testName: "token is provided, login successful",
+			secret: &core.Secret{
+				Type: apis.SecretTypeTokenAuth,
+				Data: map[string][]byte{
+					apis.TokenAuthTokenKey: []byte("s.1234"),
+					"accessor":             []byte("8609694a-cdbc-db9b-d345-e782dbb562ed"),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			testName: "token is missing, login failed",
+			secret: &core.Secret{
+				Data: map[string][]byte{},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "token is empty, login failed",
+			secret: &core.Secret{
+				Data: map[string][]byte{
+					apis.TokenAuthTokenKey: {},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			a, err := New(c.secret)
+			if c.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			if assert.Nil(t, err) {
+				token, err := a.Login()
+				assert.Nil(t, err)
+				assert.Equal(t, string(c.secret.Data[apis.TokenAuthTokenKey]), token)
+			}
+		})
+	}
+}
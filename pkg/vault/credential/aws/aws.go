@@ -16,6 +16,11 @@ limitations under the License.
 package aws
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	crd "kubevault.dev/operator/client/clientset/versioned"
 	"kubevault.dev/operator/pkg/vault"
@@ -62,7 +67,7 @@ func NewAWSCredentialManager(kClient kubernetes.Interface, appClient appcat_cs.A
 		AWSAccessReq: awsAKReq,
 		KubeClient:   kClient,
 		VaultClient:  v,
-		SecretGetter: aws.NewSecretGetter(v, awsPath, role.RoleName(), awsAKReq.Spec.UseSTS),
+		SecretGetter: aws.NewSecretGetter(v, awsPath, role.RoleName(), awsAKReq.Spec),
 	}, nil
 }
 
@@ -74,6 +79,8 @@ func GetVaultRefAndRole(cr crd.Interface, ref api.RoleRef) (*api.AWSRole, error)
 	return r, nil
 }
 
+// ParseCredential returns the credential along with the shared credentials file
+// and the credential_process output of the credential
 func (d *AWSCredManager) ParseCredential(credSecret *vaultapi.Secret) (map[string][]byte, error) {
 	data := map[string][]byte{}
 	for key, val := range credSecret.Data {
@@ -85,9 +92,66 @@ func (d *AWSCredManager) ParseCredential(credSecret *vaultapi.Secret) (map[strin
 			return nil, errors.Errorf("failed to convert interface{} to string for key %s", key)
 		}
 	}
+
+	creds := CredentialProcessOutput{
+		Version:         1,
+		AccessKeyID:     string(data[api.AWSCredentialAccessKeyKey]),
+		SecretAccessKey: string(data[api.AWSCredentialSecretKeyKey]),
+		SessionToken:    string(data[api.AWSCredentialSecurityTokenKey]),
+	}
+	if expiresAt := STSExpiration(credSecret); expiresAt != nil {
+		creds.Expiration = expiresAt.UTC().Format(time.RFC3339)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return data, nil
+	}
+
+	profile := api.AWSDefaultProfileName
+	if d.AWSAccessReq != nil {
+		profile = d.AWSAccessReq.ProfileName()
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "[%s]\n", profile)
+	fmt.Fprintf(&buf, "aws_access_key_id = %s\n", creds.AccessKeyID)
+	fmt.Fprintf(&buf, "aws_secret_access_key = %s\n", creds.SecretAccessKey)
+	if creds.SessionToken != "" {
+		fmt.Fprintf(&buf, "aws_session_token = %s\n", creds.SessionToken)
+	}
+	data[api.AWSCredentialsFileKey] = buf.Bytes()
+
+	out, err := json.Marshal(creds)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal credential_process output")
+	}
+	data[api.AWSCredentialProcessKey] = out
 	return data, nil
 }
 
+// CredentialProcessOutput is the output of a credential_process, which is sourced by the AWS SDKs.
+// More info: https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-sourcing-external.html
+type CredentialProcessOutput struct {
+	Version         int    `json:"Version"`
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string `json:"SecretAccessKey"`
+	SessionToken    string `json:"SessionToken,omitempty"`
+	// RFC3339 timestamp, when the credential expires. Omitted, if the credential does not expire.
+	Expiration string `json:"Expiration,omitempty"`
+}
+
+// STSExpiration returns the time when the STS credential expires.
+// It returns nil, if the credential is not an STS credential, which
+// has a non-renewable lease.
+func STSExpiration(credSecret *vaultapi.Secret) *metav1.Time {
+	if credSecret == nil || credSecret.WrapInfo != nil || credSecret.Renewable || credSecret.LeaseDuration <= 0 {
+		return nil
+	}
+	if v, ok := credSecret.Data[api.AWSCredentialSecurityTokenKey].(string); !ok || v == "" {
+		return nil
+	}
+	t := metav1.NewTime(time.Now().Add(time.Duration(credSecret.LeaseDuration) * time.Second))
+	return &t
+}
+
 func (d *AWSCredManager) GetOwnerReference() metav1.OwnerReference {
 	trueVar := true
 	return metav1.OwnerReference{
@@ -17,10 +17,16 @@ package credential
 
 import (
 	"encoding/json"
+	"strings"
+	"time"
 
+	"kubevault.dev/operator/pkg/vault/credential/template"
+	"kubevault.dev/operator/pkg/vault/lease"
 	"kubevault.dev/operator/pkg/vault/util"
 
+	"github.com/golang/glog"
 	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/helper/parseutil"
 	"github.com/pkg/errors"
 	core "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
@@ -30,21 +36,56 @@ import (
 	rbac_util "kmodules.xyz/client-go/rbac/v1"
 )
 
+const (
+	// Key of the response-wrapping token in the secret of a wrapped credential
+	WrappingTokenKey = "wrapping_token"
+
+	// Error returned by vault, if the wrapping token is already unwrapped, expired or revoked
+	errInvalidWrappingToken = "wrapping token is not valid or does not exist"
+
+	// The lease of a wrapped credential is issued within this window of the creation time of the wrapping token
+	wrappedLeaseIssueWindow = 2 * time.Second
+)
+
 type CredManager struct {
 	vaultClient  *vaultapi.Client
 	kubeClient   kubernetes.Interface
 	secretEngine SecretEngine
+
+	// Map of the keys of the secret to the templates of their values
+	secretTemplate map[string]string
+	// Connection info of the database, which the templates are rendered over
+	connection template.Connection
 }
 
 // Creates a kubernetes secret containing database credential
 func (c *CredManager) CreateSecret(name string, namespace string, credSecret *vaultapi.Secret) error {
 	data := map[string][]byte{}
-	if credSecret != nil {
+	if credSecret != nil && credSecret.WrapInfo != nil {
+		// only the wrapping token is stored for a wrapped credential
+		if credSecret.WrapInfo.Token == "" {
+			return errors.New("wrapping token is empty")
+		}
+		data[WrappingTokenKey] = []byte(credSecret.WrapInfo.Token)
+	} else if credSecret != nil {
 		var err error
 		data, err = c.secretEngine.ParseCredential(credSecret)
 		if err != nil {
 			return errors.Wrap(err, "failed to parse credential secret")
 		}
+
+		if len(c.secretTemplate) > 0 {
+			rendered, err := c.renderSecretTemplate(credSecret)
+			if err != nil {
+				return err
+			}
+			if data == nil {
+				data = map[string][]byte{}
+			}
+			for k, v := range rendered {
+				data[k] = v
+			}
+		}
 	}
 
 	obj := metav1.ObjectMeta{
@@ -62,6 +103,19 @@ func (c *CredManager) CreateSecret(name string, namespace string, credSecret *va
 	return nil
 }
 
+// renderSecretTemplate renders the secret template over the credential
+// and the connection info of the database
+func (c *CredManager) renderSecretTemplate(credSecret *vaultapi.Secret) (map[string][]byte, error) {
+	cred, err := template.NewCredential(credSecret.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse credential secret")
+	}
+	return template.Render(c.secretTemplate, template.Data{
+		Credential: cred,
+		Connection: c.connection,
+	})
+}
+
 // Creates kubernetes role
 func (c *CredManager) CreateRole(name string, namespace string, secretName string) error {
 	obj := metav1.ObjectMeta{
@@ -171,3 +225,122 @@ func (c *CredManager) RevokeLease(leaseID string) error {
 func (c *CredManager) GetCredential() (*vaultapi.Secret, error) {
 	return c.secretEngine.GetSecret()
 }
+
+// https://www.vaultproject.io/docs/concepts/response-wrapping.html
+//
+// Gets the credential from vault, response-wrapped by the request issuing it,
+// so that the operator never sees the credential.
+// The wrapped response doesn't contain the lease of the credential. So, the lease
+// is found among the leases of the creation path by its issue time, which is
+// the creation time of the wrapping token. If it can't be found, the lease is
+// left empty and the credential expires with its TTL.
+func (c *CredManager) GetWrappedCredential(wrapTTL string) (*vaultapi.Secret, error) {
+	c.vaultClient.SetWrappingLookupFunc(func(operation, path string) string {
+		return wrapTTL
+	})
+	credSecret, err := c.secretEngine.GetSecret()
+	c.vaultClient.SetWrappingLookupFunc(nil)
+	if err != nil {
+		return nil, err
+	}
+	if credSecret == nil || credSecret.WrapInfo == nil || credSecret.WrapInfo.Token == "" {
+		return nil, errors.New("credential is not response-wrapped")
+	}
+
+	l, err := c.findWrappedLease(credSecret.WrapInfo)
+	if err != nil {
+		glog.Warningf("failed to find the lease of the wrapped credential of %s: %v", credSecret.WrapInfo.CreationPath, err)
+	} else if l != nil {
+		credSecret.LeaseID = l.LeaseID
+		credSecret.LeaseDuration = l.LeaseDuration
+		credSecret.Renewable = l.Renewable
+	}
+	return credSecret, nil
+}
+
+// findWrappedLease looks up the leases of the creation path of the wrapping token and
+// returns the one issued along with the wrapping token. It returns nil, if no lease or
+// more than one lease is issued at the creation time of the wrapping token.
+func (c *CredManager) findWrappedLease(w *vaultapi.SecretWrapInfo) (*vaultapi.Secret, error) {
+	ids, err := lease.ListLeases(c.vaultClient, w.CreationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *vaultapi.Secret
+	for _, id := range ids {
+		l, err := c.vaultClient.Logical().Write("sys/leases/lookup", map[string]interface{}{
+			"lease_id": id,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to look up lease %s", id)
+		}
+		if l == nil || l.Data == nil {
+			continue
+		}
+		s, _ := l.Data["issue_time"].(string)
+		issued, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			continue
+		}
+		if d := issued.Sub(w.CreationTime); d < -wrappedLeaseIssueWindow || d > wrappedLeaseIssueWindow {
+			continue
+		}
+		if found != nil {
+			return nil, errors.Errorf("more than one lease of %s is issued at %s", w.CreationPath, w.CreationTime)
+		}
+		found = &vaultapi.Secret{LeaseID: id}
+		if ttl, err := parseutil.ParseDurationSecond(l.Data["ttl"]); err == nil {
+			found.LeaseDuration = int(ttl / time.Second)
+		}
+		found.Renewable, _ = l.Data["renewable"].(bool)
+	}
+	return found, nil
+}
+
+// https://www.vaultproject.io/api/system/wrapping-lookup.html
+//
+// Looks up the wrapping token. It returns nil, if the
+// wrapping token is already unwrapped, expired or revoked.
+func (c *CredManager) LookupWrappingToken(token string) (*vaultapi.Secret, error) {
+	secret, err := c.vaultClient.Logical().Write("sys/wrapping/lookup", map[string]interface{}{
+		"token": token,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), errInvalidWrappingToken) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to lookup wrapping token")
+	}
+	if secret == nil {
+		return nil, nil
+	}
+	return secret, nil
+}
+
+// https://www.vaultproject.io/api/auth/token/index.html#revoke-a-token-accessor
+//
+// Revokes the wrapping token, so that the wrapped
+// credential can not be unwrapped anymore
+func (c *CredManager) RevokeWrappingToken(accessor string) error {
+	req := c.vaultClient.NewRequest("POST", "/v1/auth/token/revoke-accessor")
+	err := req.SetJSONBody(map[string]string{
+		"accessor": accessor,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	resp, err := c.vaultClient.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		// token is already unwrapped or expired
+		if resp != nil && resp.StatusCode == 400 {
+			return nil
+		}
+		return errors.Wrap(err, "failed to revoke wrapping token")
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	crd "kubevault.dev/operator/client/clientset/versioned"
 	"kubevault.dev/operator/pkg/vault"
+	"kubevault.dev/operator/pkg/vault/credential/template"
 	databaserole "kubevault.dev/operator/pkg/vault/role/database"
 	"kubevault.dev/operator/pkg/vault/secret"
 	"kubevault.dev/operator/pkg/vault/secret/engines/database"
@@ -38,6 +39,9 @@ type DBCredManager struct {
 	DBAccessReq *api.DatabaseAccessRequest
 	KubeClient  kubernetes.Interface
 	VaultClient *vaultapi.Client
+
+	// Connection info of the database, used to render the secret template
+	Connection template.Connection
 }
 
 func NewDatabaseCredentialManager(kClient kubernetes.Interface, appClient appcat_cs.AppcatalogV1alpha1Interface, cr crd.Interface, dbAR *api.DatabaseAccessRequest) (*DBCredManager, error) {
@@ -51,14 +55,63 @@ func NewDatabaseCredentialManager(kClient kubernetes.Interface, appClient appcat
 		return nil, errors.Wrap(err, "failed to create vault client")
 	}
 
+	var conn template.Connection
+	if len(dbAR.Spec.SecretTemplate) > 0 {
+		conn, err = GetDatabaseConnection(cr, appClient, dbAR.Spec.RoleRef)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get database connection info")
+		}
+	}
+
 	return &DBCredManager{
 		DBAccessReq:  dbAR,
 		KubeClient:   kClient,
 		VaultClient:  v,
 		SecretGetter: database.NewSecretGetter(v, dbPath, roleName),
+		Connection:   conn,
 	}, nil
 }
 
+// GetDatabaseConnection returns the connection info of the database AppBinding referenced by the role.
+// The connection info is empty, if the role does not reference any database.
+func GetDatabaseConnection(cr crd.Interface, appClient appcat_cs.AppcatalogV1alpha1Interface, ref api.RoleRef) (template.Connection, error) {
+	var dbRef *appcat.AppReference
+	switch ref.Kind {
+	case api.ResourceKindMongoDBRole:
+		r, err := cr.EngineV1alpha1().MongoDBRoles(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return template.Connection{}, errors.Wrapf(err, "MongoDBRole %s/%s", ref.Namespace, ref.Name)
+		}
+		dbRef = r.Spec.DatabaseRef
+
+	case api.ResourceKindMySQLRole:
+		r, err := cr.EngineV1alpha1().MySQLRoles(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return template.Connection{}, errors.Wrapf(err, "MySQLRole %s/%s", ref.Namespace, ref.Name)
+		}
+		dbRef = r.Spec.DatabaseRef
+
+	case api.ResourceKindPostgresRole:
+		r, err := cr.EngineV1alpha1().PostgresRoles(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return template.Connection{}, errors.Wrapf(err, "PostgresRole %s/%s", ref.Namespace, ref.Name)
+		}
+		dbRef = r.Spec.DatabaseRef
+
+	default:
+		return template.Connection{}, errors.Errorf("unknown or unsupported role kind '%s'", ref.Kind)
+	}
+
+	if dbRef == nil {
+		return template.Connection{}, nil
+	}
+	app, err := appClient.AppBindings(dbRef.Namespace).Get(dbRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return template.Connection{}, errors.Wrapf(err, "failed to get AppBinding %s/%s", dbRef.Namespace, dbRef.Name)
+	}
+	return template.NewConnection(app)
+}
+
 func GetVaultRefAndRole(cr crd.Interface, ref api.RoleRef) (*appcat.AppReference, string, string, error) {
 	switch ref.Kind {
 	case api.ResourceKindMongoDBRole:
@@ -39,9 +39,11 @@ func NewCredentialManagerForDatabase(kubeClient kubernetes.Interface,
 		return nil, errors.Wrap(err, "failed to get database credential manager")
 	}
 	return &CredManager{
-		kubeClient:   kubeClient,
-		secretEngine: dbCM,
-		vaultClient:  dbCM.VaultClient,
+		kubeClient:     kubeClient,
+		secretEngine:   dbCM,
+		vaultClient:    dbCM.VaultClient,
+		secretTemplate: dbAR.Spec.SecretTemplate,
+		connection:     dbCM.Connection,
 	}, nil
 }
 
@@ -54,9 +56,10 @@ func NewCredentialManagerForAWS(kubeClient kubernetes.Interface,
 		return nil, errors.Wrap(err, "failed to get aws credential manager")
 	}
 	return &CredManager{
-		kubeClient:   kubeClient,
-		secretEngine: awsCM,
-		vaultClient:  awsCM.VaultClient,
+		kubeClient:     kubeClient,
+		secretEngine:   awsCM,
+		vaultClient:    awsCM.VaultClient,
+		secretTemplate: awsAKReq.Spec.SecretTemplate,
 	}, nil
 }
 
@@ -69,9 +72,10 @@ func NewCredentialManagerForGCP(kubeClient kubernetes.Interface,
 		return nil, errors.Wrap(err, "failed to get gcp credential manager")
 	}
 	return &CredManager{
-		kubeClient:   kubeClient,
-		secretEngine: gcpCM,
-		vaultClient:  gcpCM.VaultClient,
+		kubeClient:     kubeClient,
+		secretEngine:   gcpCM,
+		vaultClient:    gcpCM.VaultClient,
+		secretTemplate: gcpAKReq.Spec.SecretTemplate,
 	}, nil
 }
 
@@ -84,8 +88,9 @@ func NewCredentialManagerForAzure(kubeClient kubernetes.Interface,
 		return nil, errors.Wrap(err, "failed to get azure credential manager")
 	}
 	return &CredManager{
-		kubeClient:   kubeClient,
-		secretEngine: azureCM,
-		vaultClient:  azureCM.VaultClient,
+		kubeClient:     kubeClient,
+		secretEngine:   azureCM,
+		vaultClient:    azureCM.VaultClient,
+		secretTemplate: azureAKReq.Spec.SecretTemplate,
 	}, nil
 }
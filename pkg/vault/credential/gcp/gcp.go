@@ -62,7 +62,7 @@ func NewGCPCredentialManager(kClient kubernetes.Interface, appClient appcat_cs.A
 	}
 
 	return &GCPCredManager{
-		SecretGetter:    gcpengines.NewSecretGetter(vClient, gcpPath, role.RoleName(), role.Spec.SecretType, gcpAKReq.Spec),
+		SecretGetter:    gcpengines.NewSecretGetter(vClient, gcpPath, role.RoleName(), role.RoleType(), role.Spec.SecretType, gcpAKReq.Spec),
 		GCPAccessKeyReq: gcpAKReq,
 		KubeClient:      kClient,
 		VaultClient:     vClient,
@@ -27,6 +27,15 @@ type CredentialManager interface {
 	// Gets credential from vault
 	GetCredential() (*vaultapi.Secret, error)
 
+	// Gets credential from vault and response-wraps it, the lease of the credential is kept
+	GetWrappedCredential(wrapTTL string) (*vaultapi.Secret, error)
+
+	// Looks up the wrapping token of a wrapped credential
+	LookupWrappingToken(token string) (*vaultapi.Secret, error)
+
+	// Revokes the wrapping token of a wrapped credential
+	RevokeWrappingToken(accessor string) error
+
 	// Creates a kubernetes secret containing postgres credential
 	CreateSecret(name string, namespace string, credential *vaultapi.Secret) error
 
@@ -0,0 +1,169 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package template
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
+)
+
+// Names of the built-in templates, which can be used in any secret template,
+// i.e. {{ template "postgres-uri" . }}.
+// The username and password are escaped as the userinfo of the postgres uri
+// and as query parameters of the jdbc url. They are used as is in the mysql dsn,
+// as the mysql driver doesn't unescape them.
+const (
+	PresetPostgresURI    = "postgres-uri"
+	PresetMySQLDSN       = "mysql-dsn"
+	PresetJDBCURL        = "jdbc-url"
+	PresetAWSCredentials = "aws-credentials"
+	PresetGCPADC         = "gcp-adc"
+)
+
+var presets = map[string]string{
+	PresetPostgresURI: `postgresql://{{ userinfo .Credential.username .Credential.password }}@{{ .Connection.Host }}:{{ .Connection.Port }}/{{ .Connection.Database }}`,
+
+	PresetMySQLDSN: `{{ .Credential.username }}:{{ .Credential.password }}@tcp({{ .Connection.Host }}:{{ .Connection.Port }})/{{ .Connection.Database }}`,
+
+	PresetJDBCURL: `jdbc:{{ if eq .Connection.Scheme "postgres" }}postgresql{{ else }}{{ .Connection.Scheme }}{{ end }}://{{ .Connection.Host }}:{{ .Connection.Port }}/{{ .Connection.Database }}?user={{ urlencode .Credential.username }}&password={{ urlencode .Credential.password }}`,
+
+	PresetAWSCredentials: `[default]
+aws_access_key_id = {{ .Credential.access_key }}
+aws_secret_access_key = {{ .Credential.secret_key }}
+{{- if .Credential.security_token }}
+aws_session_token = {{ .Credential.security_token }}
+{{- end }}
+`,
+
+	PresetGCPADC: `{{ b64dec .Credential.private_key_data }}`,
+}
+
+var funcs = texttemplate.FuncMap{
+	"b64enc": func(s string) string {
+		return base64.StdEncoding.EncodeToString([]byte(s))
+	},
+	"b64dec": func(s string) (string, error) {
+		data, err := base64.StdEncoding.DecodeString(s)
+		return string(data), err
+	},
+	"urlencode": url.QueryEscape,
+	"userinfo": func(username, password string) string {
+		return url.UserPassword(username, password).String()
+	},
+}
+
+// Connection contains the connection info of the database, which the credential is issued for
+type Connection struct {
+	Scheme   string
+	Host     string
+	Port     int32
+	Database string
+	// PEM encoded CA certificate of the database
+	CACert string
+}
+
+// Data is the data, the secret templates are rendered over
+type Data struct {
+	// Credential issued by vault, i.e. username, password
+	Credential map[string]string
+	// Connection info of the database, if any
+	Connection Connection
+}
+
+// NewConnection returns the connection info of the database from its AppBinding
+func NewConnection(app *appcat.AppBinding) (Connection, error) {
+	conn := Connection{
+		CACert: string(app.Spec.ClientConfig.CABundle),
+	}
+
+	var err error
+	if conn.Host, err = app.Hostname(); err != nil {
+		return conn, err
+	}
+	if conn.Port, err = app.Port(); err != nil {
+		return conn, err
+	}
+
+	rawurl, err := app.URL()
+	if err != nil {
+		return conn, err
+	}
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return conn, errors.Wrap(err, "failed to parse connection url")
+	}
+	conn.Scheme = u.Scheme
+	conn.Database = strings.Trim(u.Path, "/")
+	return conn, nil
+}
+
+// NewCredential converts the data of the vault secret into strings
+func NewCredential(data map[string]interface{}) (map[string]string, error) {
+	cred := make(map[string]string, len(data))
+	for k, v := range data {
+		switch u := v.(type) {
+		case nil:
+			cred[k] = ""
+		case string:
+			cred[k] = u
+		case json.Number:
+			cred[k] = u.String()
+		case bool:
+			cred[k] = fmt.Sprint(u)
+		default:
+			return nil, errors.Errorf("failed to convert %T to string for key %s", v, k)
+		}
+	}
+	return cred, nil
+}
+
+// Render renders the templates over the data.
+// It returns the rendered value of each key of the templates.
+func Render(templates map[string]string, data Data) (map[string][]byte, error) {
+	base := texttemplate.New("").Funcs(funcs).Option("missingkey=zero")
+	for name, text := range presets {
+		if _, err := base.New(name).Parse(text); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse preset %s", name)
+		}
+	}
+
+	out := make(map[string][]byte, len(templates))
+	for key, text := range templates {
+		t, err := base.Clone()
+		if err != nil {
+			return nil, err
+		}
+		t, err = t.New(key).Parse(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse secret template for key %s", key)
+		}
+
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return nil, errors.Wrapf(err, "failed to render secret template for key %s", key)
+		}
+		out[key] = buf.Bytes()
+	}
+	return out, nil
+}
@@ -390,14 +390,10 @@ func (seClient *SecretEngine) CreateAzureConfig() error {
 
 		if val, ok := sr.Data[api.AzureSubscriptionID]; ok && len(val) > 0 {
 			payload["subscription_id"] = string(val)
-		} else {
-			return errors.New("azure secret engine configuration failed: subscription id missing")
 		}
 
 		if val, ok := sr.Data[api.AzureTenantID]; ok && len(val) > 0 {
 			payload["tenant_id"] = string(val)
-		} else {
-			return errors.New("azure secret engine configuration failed: tenant id missing")
 		}
 
 		if val, ok := sr.Data[api.AzureClientID]; ok && len(val) > 0 {
@@ -409,6 +405,28 @@ func (seClient *SecretEngine) CreateAzureConfig() error {
 		}
 	}
 
+	// the ids of the configuration take precedence over the ones of the secret
+	if config.SubscriptionID != "" {
+		payload["subscription_id"] = config.SubscriptionID
+	}
+	if config.TenantID != "" {
+		payload["tenant_id"] = config.TenantID
+	}
+	if config.ClientID != "" {
+		payload["client_id"] = config.ClientID
+	}
+
+	if _, ok := payload["subscription_id"]; !ok {
+		return errors.New("azure secret engine configuration failed: subscription id missing")
+	}
+	if _, ok := payload["tenant_id"]; !ok {
+		return errors.New("azure secret engine configuration failed: tenant id missing")
+	}
+
+	if config.RootPasswordTTL != "" {
+		payload["root_password_ttl"] = config.RootPasswordTTL
+	}
+
 	if config.Environment != "" {
 		payload["environment"] = config.Environment
 	}
@@ -505,6 +505,87 @@ func TestSecretEngine_CreateAzureConfig(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "AzureConfig: Successful operation: IDs in configuration without secret",
+			path: "azure",
+			secretEngine: &api.SecretEngine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test12321",
+					Namespace: "demo",
+				},
+				Spec: api.SecretEngineSpec{
+					SecretEngineConfiguration: api.SecretEngineConfiguration{
+						Azure: &api.AzureConfiguration{
+							SubscriptionID:  "1232-2132-123-132",
+							TenantID:        "acdenfi-fkjdsk-dsfjds-fsdjf",
+							ClientID:        "aeeaf-dsfde-dfsd-asdf",
+							RootPasswordTTL: "4380h",
+						},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unused",
+					Namespace: "demo",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AzureConfig: Successful operation: Tenant ID in configuration, others in secret",
+			path: "azure",
+			secretEngine: &api.SecretEngine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test12321",
+					Namespace: "demo",
+				},
+				Spec: api.SecretEngineSpec{
+					SecretEngineConfiguration: api.SecretEngineConfiguration{
+						Azure: &api.AzureConfiguration{
+							CredentialSecret: "azure-cred",
+							TenantID:         "acdenfi-fkjdsk-dsfjds-fsdjf",
+						},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "azure-cred",
+					Namespace: "demo",
+				},
+				Data: map[string][]byte{
+					"subscription-id": []byte("1232-2132-123-132"),
+					"client-id":       []byte("aeeaf-dsfde-dfsd-asdf"),
+					"client-secret":   []byte("******"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AzureConfig: Unsuccessful operation: Missing tenant-id without secret",
+			path: "azure",
+			secretEngine: &api.SecretEngine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test12321",
+					Namespace: "demo",
+				},
+				Spec: api.SecretEngineSpec{
+					SecretEngineConfiguration: api.SecretEngineConfiguration{
+						Azure: &api.AzureConfiguration{
+							SubscriptionID: "1232-2132-123-132",
+						},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "unused",
+					Namespace: "demo",
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "AzureConfig: Unsuccessful operation: Missing secret",
 			path: "azure",
@@ -16,8 +16,12 @@ limitations under the License.
 package engine
 
 import (
+	"fmt"
+	"time"
+
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	"kubevault.dev/operator/pkg/vault"
+	"kubevault.dev/operator/pkg/vault/lease"
 	"kubevault.dev/operator/pkg/vault/role/aws"
 	"kubevault.dev/operator/pkg/vault/role/azure"
 	"kubevault.dev/operator/pkg/vault/role/database"
@@ -26,10 +30,14 @@ import (
 	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/kubernetes"
+	meta_util "kmodules.xyz/client-go/meta"
 	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
 	appcat_cs "kmodules.xyz/custom-resources/client/clientset/versioned/typed/appcatalog/v1alpha1"
 )
 
+// systemTTL resets the ttl of the mount to the system default on tune
+const systemTTL = "system"
+
 type SecretEngine struct {
 	appClient    appcat_cs.AppcatalogV1alpha1Interface
 	secretEngine *api.SecretEngine
@@ -118,15 +126,233 @@ func (seClient *SecretEngine) EnableSecretEngine() error {
 		return errors.New("failed to enable secret engine: unknown secret engine type")
 	}
 
-	err = seClient.vaultClient.Sys().Mount(seClient.path, &vaultapi.MountInput{
-		Type: engineType,
-	})
+	req := seClient.vaultClient.NewRequest("POST", fmt.Sprintf("/v1/sys/mounts/%s", seClient.path))
+	err = req.SetJSONBody(newMountInput(engineType, engSpec))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	resp, err := seClient.vaultClient.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// https://www.vaultproject.io/api/system/remount.html
+//
+// It moves the secret engine from the old path to the current path.
+// The configuration and roles in vault are moved along with it, but vault
+// revokes the leases of the old path. The role objects still referring to
+// the old path are not updated, but the controller marks the access requests
+// holding those leases with the LeaseRevoked condition.
+func (seClient *SecretEngine) RemountSecretEngine(from string) error {
+	if from == "" || from == seClient.path {
+		return nil
+	}
+
+	mnt, err := seClient.vaultClient.Sys().ListMounts()
+	if err != nil {
+		return errors.Wrap(err, "failed to list mounted secrets engines")
+	}
+	if _, ok := mnt[from+"/"]; !ok {
+		// already moved or removed
+		return nil
+	}
+	if _, ok := mnt[seClient.path+"/"]; ok {
+		return errors.Errorf("failed to move secret engine from %s: path %s is already in use", from, seClient.path)
+	}
+
+	err = seClient.vaultClient.Sys().Remount(from, seClient.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to move secret engine from %s to %s", from, seClient.path)
+	}
+	return nil
+}
+
+// https://www.vaultproject.io/api/system/mounts.html#tune-mount-configuration
+//
+// It tunes the mount configuration of the secret engine.
+// Seal wrap and local can not be tuned once the secret engine is enabled.
+func (seClient *SecretEngine) TuneSecretEngine() error {
+	mnt, err := seClient.GetSecretEngineMount()
 	if err != nil {
 		return err
 	}
+
+	req := seClient.vaultClient.NewRequest("POST", fmt.Sprintf("/v1/sys/mounts/%s/tune", seClient.path))
+	err = req.SetJSONBody(newMountTuneInput(seClient.secretEngine.Spec, mnt.Options))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	resp, err := seClient.vaultClient.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to tune secret engine %s", seClient.path)
+	}
 	return nil
 }
 
+// It returns the mount info of the secret engine
+func (seClient *SecretEngine) GetSecretEngineMount() (*api.SecretEngineMountStatus, error) {
+	req := seClient.vaultClient.NewRequest("GET", "/v1/sys/mounts")
+	resp, err := seClient.vaultClient.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list mounted secrets engines")
+	}
+
+	secret, err := vaultapi.ParseSecret(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse response body")
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, errors.New("data from server response is empty")
+	}
+
+	data, ok := secret.Data[seClient.path+"/"]
+	if !ok {
+		return nil, errors.Errorf("secret engine %s is not enabled", seClient.path)
+	}
+	var mnt mountOutput
+	err = meta_util.Decode(data, &mnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode mount info")
+	}
+
+	return &api.SecretEngineMountStatus{
+		Type:                     mnt.Type,
+		Accessor:                 mnt.Accessor,
+		Description:              mnt.Description,
+		DefaultLeaseTTL:          formatTTL(mnt.Config.DefaultLeaseTTL),
+		MaxLeaseTTL:              formatTTL(mnt.Config.MaxLeaseTTL),
+		SealWrap:                 mnt.SealWrap,
+		Local:                    mnt.Local,
+		AuditNonHMACRequestKeys:  mnt.Config.AuditNonHMACRequestKeys,
+		AuditNonHMACResponseKeys: mnt.Config.AuditNonHMACResponseKeys,
+		Options:                  mnt.Options,
+		PluginVersion:            mnt.PluginVersion,
+		RunningPluginVersion:     mnt.RunningPluginVersion,
+	}, nil
+}
+
+type mountInput struct {
+	Type          string            `json:"type"`
+	Description   string            `json:"description,omitempty"`
+	Config        mountConfigInput  `json:"config"`
+	Local         bool              `json:"local,omitempty"`
+	SealWrap      bool              `json:"seal_wrap,omitempty"`
+	Options       map[string]string `json:"options,omitempty"`
+	PluginVersion string            `json:"plugin_version,omitempty"`
+}
+
+type mountConfigInput struct {
+	DefaultLeaseTTL          string   `json:"default_lease_ttl,omitempty"`
+	MaxLeaseTTL              string   `json:"max_lease_ttl,omitempty"`
+	AuditNonHMACRequestKeys  []string `json:"audit_non_hmac_request_keys,omitempty"`
+	AuditNonHMACResponseKeys []string `json:"audit_non_hmac_response_keys,omitempty"`
+}
+
+// mountTuneInput is sent in full, so that the cleared fields are reset in vault
+type mountTuneInput struct {
+	Description              string            `json:"description"`
+	DefaultLeaseTTL          string            `json:"default_lease_ttl"`
+	MaxLeaseTTL              string            `json:"max_lease_ttl"`
+	AuditNonHMACRequestKeys  []string          `json:"audit_non_hmac_request_keys"`
+	AuditNonHMACResponseKeys []string          `json:"audit_non_hmac_response_keys"`
+	Options                  map[string]string `json:"options"`
+	PluginVersion            string            `json:"plugin_version,omitempty"`
+}
+
+type mountOutput struct {
+	Type                 string            `mapstructure:"type"`
+	Description          string            `mapstructure:"description"`
+	Accessor             string            `mapstructure:"accessor"`
+	Config               mountConfigOutput `mapstructure:"config"`
+	Options              map[string]string `mapstructure:"options"`
+	Local                bool              `mapstructure:"local"`
+	SealWrap             bool              `mapstructure:"seal_wrap"`
+	PluginVersion        string            `mapstructure:"plugin_version"`
+	RunningPluginVersion string            `mapstructure:"running_plugin_version"`
+}
+
+type mountConfigOutput struct {
+	DefaultLeaseTTL          int      `mapstructure:"default_lease_ttl"`
+	MaxLeaseTTL              int      `mapstructure:"max_lease_ttl"`
+	AuditNonHMACRequestKeys  []string `mapstructure:"audit_non_hmac_request_keys"`
+	AuditNonHMACResponseKeys []string `mapstructure:"audit_non_hmac_response_keys"`
+}
+
+func newMountInput(engineType string, engSpec api.SecretEngineSpec) mountInput {
+	return mountInput{
+		Type:        engineType,
+		Description: engSpec.Description,
+		Config: mountConfigInput{
+			DefaultLeaseTTL:          engSpec.DefaultLeaseTTL,
+			MaxLeaseTTL:              engSpec.MaxLeaseTTL,
+			AuditNonHMACRequestKeys:  engSpec.AuditNonHMACRequestKeys,
+			AuditNonHMACResponseKeys: engSpec.AuditNonHMACResponseKeys,
+		},
+		Local:         engSpec.Local,
+		SealWrap:      engSpec.SealWrap,
+		Options:       engSpec.Options,
+		PluginVersion: engSpec.PluginVersion,
+	}
+}
+
+// newMountTuneInput returns the tune input of the spec. The cleared fields are reset, i.e.
+// the ttls are set to the system default and the removed options are sent with empty values,
+// as vault merges the options with the existing ones. The plugin version is only sent, if set.
+func newMountTuneInput(engSpec api.SecretEngineSpec, existingOptions map[string]string) mountTuneInput {
+	in := mountTuneInput{
+		Description:              engSpec.Description,
+		DefaultLeaseTTL:          engSpec.DefaultLeaseTTL,
+		MaxLeaseTTL:              engSpec.MaxLeaseTTL,
+		AuditNonHMACRequestKeys:  engSpec.AuditNonHMACRequestKeys,
+		AuditNonHMACResponseKeys: engSpec.AuditNonHMACResponseKeys,
+		Options:                  map[string]string{},
+		PluginVersion:            engSpec.PluginVersion,
+	}
+	if in.DefaultLeaseTTL == "" {
+		in.DefaultLeaseTTL = systemTTL
+	}
+	if in.MaxLeaseTTL == "" {
+		in.MaxLeaseTTL = systemTTL
+	}
+	if in.AuditNonHMACRequestKeys == nil {
+		in.AuditNonHMACRequestKeys = []string{}
+	}
+	if in.AuditNonHMACResponseKeys == nil {
+		in.AuditNonHMACResponseKeys = []string{}
+	}
+	for k := range existingOptions {
+		// vault doesn't allow to remove the version of a kv mount
+		if k != "version" {
+			in.Options[k] = ""
+		}
+	}
+	for k, v := range engSpec.Options {
+		in.Options[k] = v
+	}
+	return in
+}
+
+// formatTTL formats the ttl in seconds, zero means the system default
+func formatTTL(seconds int) string {
+	if seconds == 0 {
+		return ""
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
+
 func (seClient *SecretEngine) DisableSecretEngine() error {
 	enabled, err := seClient.IsSecretEngineEnabled()
 	if err != nil {
@@ -138,3 +364,21 @@ func (seClient *SecretEngine) DisableSecretEngine() error {
 	err = seClient.vaultClient.Sys().Unmount(seClient.path)
 	return err
 }
+
+// HasLeases checks whether any lease issued by the secret engine exists
+func (seClient *SecretEngine) HasLeases() (bool, error) {
+	ok, err := lease.HasLeases(seClient.vaultClient, seClient.path)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to lookup leases of secret engine %s", seClient.path)
+	}
+	return ok, nil
+}
+
+// RevokeLeases revokes all leases issued by the secret engine
+func (seClient *SecretEngine) RevokeLeases() error {
+	err := lease.RevokePrefix(seClient.vaultClient, seClient.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to revoke leases of secret engine %s", seClient.path)
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 
@@ -191,6 +192,28 @@ func NewFakeVaultMountServer() *httptest.Server {
 		w.WriteHeader(http.StatusBadRequest)
 	}).Methods(http.MethodPost)
 
+	router.HandleFunc("/v1/sys/mounts/{path}/tune", func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]interface{}
+		utilruntime.Must(json.NewDecoder(r.Body).Decode(&data))
+		if ttl, ok := data["default_lease_ttl"]; ok && ttl != "system" {
+			if _, err := time.ParseDuration(ttl.(string)); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/sys/remount", func(w http.ResponseWriter, r *http.Request) {
+		var data map[string]interface{}
+		utilruntime.Must(json.NewDecoder(r.Body).Decode(&data))
+		if data["from"] == "secret" && data["to"] == "kv" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+	}).Methods(http.MethodPost)
+
 	return httptest.NewServer(router)
 }
 
@@ -306,3 +329,174 @@ func TestSecretEngine_EnableSecretEngine(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretEngine_RemountSecretEngine(t *testing.T) {
+	srv := NewFakeVaultMountServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		from    string
+		path    string
+		wantErr bool
+	}{
+		{
+			name:    "remount secret engine: successful",
+			from:    "secret",
+			path:    "kv",
+			wantErr: false,
+		},
+		{
+			name:    "old path is not mounted: nothing to do",
+			from:    "old-kv",
+			path:    "kv",
+			wantErr: false,
+		},
+		{
+			name:    "new path is already in use: unsuccessful",
+			from:    "secret",
+			path:    "identity",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc, err := vaultClient(srv.URL)
+			assert.Nil(t, err, "failed to create vault client")
+
+			seClient := &SecretEngine{
+				secretEngine: &api.SecretEngine{},
+				vaultClient:  vc,
+				path:         tt.path,
+			}
+			if err := seClient.RemountSecretEngine(tt.from); (err != nil) != tt.wantErr {
+				t.Errorf("RemountSecretEngine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSecretEngine_TuneSecretEngine(t *testing.T) {
+	srv := NewFakeVaultMountServer()
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		spec    api.SecretEngineSpec
+		wantErr bool
+	}{
+		{
+			name: "tune secret engine: successful",
+			spec: api.SecretEngineSpec{
+				Description:     "kv secret engine",
+				DefaultLeaseTTL: "1h",
+				MaxLeaseTTL:     "24h",
+			},
+			wantErr: false,
+		},
+		{
+			name:    "tune secret engine: ttls are reset",
+			spec:    api.SecretEngineSpec{},
+			wantErr: false,
+		},
+		{
+			name: "tune secret engine: invalid ttl",
+			spec: api.SecretEngineSpec{
+				DefaultLeaseTTL: "one hour",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vc, err := vaultClient(srv.URL)
+			assert.Nil(t, err, "failed to create vault client")
+
+			seClient := &SecretEngine{
+				secretEngine: &api.SecretEngine{
+					Spec: tt.spec,
+				},
+				vaultClient: vc,
+				path:        "secret",
+			}
+			if err := seClient.TuneSecretEngine(); (err != nil) != tt.wantErr {
+				t.Errorf("TuneSecretEngine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewMountTuneInput(t *testing.T) {
+	tests := []struct {
+		name            string
+		spec            api.SecretEngineSpec
+		existingOptions map[string]string
+		want            mountTuneInput
+	}{
+		{
+			name: "fields are set",
+			spec: api.SecretEngineSpec{
+				Description:             "kv",
+				DefaultLeaseTTL:         "1h",
+				MaxLeaseTTL:             "24h",
+				AuditNonHMACRequestKeys: []string{"username"},
+				Options:                 map[string]string{"version": "2"},
+				PluginVersion:           "v1.0.0",
+			},
+			existingOptions: map[string]string{"version": "2"},
+			want: mountTuneInput{
+				Description:              "kv",
+				DefaultLeaseTTL:          "1h",
+				MaxLeaseTTL:              "24h",
+				AuditNonHMACRequestKeys:  []string{"username"},
+				AuditNonHMACResponseKeys: []string{},
+				Options:                  map[string]string{"version": "2"},
+				PluginVersion:            "v1.0.0",
+			},
+		},
+		{
+			name:            "cleared fields are reset",
+			spec:            api.SecretEngineSpec{},
+			existingOptions: map[string]string{"version": "2", "foo": "bar"},
+			want: mountTuneInput{
+				DefaultLeaseTTL:          "system",
+				MaxLeaseTTL:              "system",
+				AuditNonHMACRequestKeys:  []string{},
+				AuditNonHMACResponseKeys: []string{},
+				Options:                  map[string]string{"foo": ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newMountTuneInput(tt.spec, tt.existingOptions))
+		})
+	}
+}
+
+func TestSecretEngine_GetSecretEngineMount(t *testing.T) {
+	srv := NewFakeVaultMountServer()
+	defer srv.Close()
+
+	vc, err := vaultClient(srv.URL)
+	if !assert.Nil(t, err, "failed to create vault client") {
+		return
+	}
+
+	seClient := &SecretEngine{
+		secretEngine: &api.SecretEngine{},
+		vaultClient:  vc,
+		path:         "secret",
+	}
+	mnt, err := seClient.GetSecretEngineMount()
+	if assert.Nil(t, err) {
+		assert.Equal(t, "kv", mnt.Type)
+		assert.Equal(t, "kv_b2d89045", mnt.Accessor)
+		assert.Equal(t, map[string]string{"version": "2"}, mnt.Options)
+		assert.Equal(t, "", mnt.DefaultLeaseTTL)
+	}
+
+	seClient.path = "gcp"
+	_, err = seClient.GetSecretEngineMount()
+	assert.NotNil(t, err, "gcp secret engine is not enabled")
+}
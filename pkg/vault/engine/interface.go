@@ -15,10 +15,17 @@ limitations under the License.
 */
 package engine
 
+import (
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+)
+
 type EngineInterface interface {
 	CreatePolicy() error
 	UpdateAuthRole() error
 	IsSecretEngineEnabled() (bool, error)
 	EnableSecretEngine() error
+	RemountSecretEngine(from string) error
+	TuneSecretEngine() error
+	GetSecretEngineMount() (*api.SecretEngineMountStatus, error)
 	CreateConfig() error
 }
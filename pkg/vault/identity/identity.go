@@ -0,0 +1,379 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package identity
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+)
+
+const (
+	kindEntity = "entity"
+	kindGroup  = "group"
+
+	// OwnerMetadataKey is the metadata key of the entities and groups created by the operator.
+	// Its value identifies the custom resource the entity or group belongs to.
+	OwnerMetadataKey = "kubevault.com/owner"
+)
+
+type Identity interface {
+	// create or update the entity, returns the id of the entity
+	EnsureEntity(e Entity) (string, error)
+	// delete the entity along with its aliases, if it is owned by the owner
+	DeleteEntity(name, owner string) error
+	// create the entity alias if it doesn't exist, returns the id of the alias
+	EnsureEntityAlias(a Alias) (string, error)
+	DeleteEntityAlias(id string) error
+	// create or update the group, returns the id of the group
+	EnsureGroup(g Group) (string, error)
+	// delete the group along with its alias, if it is owned by the owner
+	DeleteGroup(name, owner string) error
+	// create the group alias if it doesn't exist, returns the id of the alias
+	EnsureGroupAlias(a Alias) (string, error)
+	DeleteGroupAlias(id string) error
+	// returns the accessor of the auth method enabled in the path
+	MountAccessor(path string) (string, error)
+}
+
+// Entity is an entity of the vault identity store
+type Entity struct {
+	Name string
+	// Owner is stored in the metadata, an existing entity of another owner is never updated
+	Owner    string
+	Policies []string
+	Metadata map[string]string
+	Disabled bool
+}
+
+// Group is a group of the vault identity store
+type Group struct {
+	Name string
+	// Owner is stored in the metadata, an existing group of another owner is never updated
+	Owner           string
+	Type            string
+	Policies        []string
+	Metadata        map[string]string
+	MemberEntityIDs []string
+	MemberGroupIDs  []string
+}
+
+// Alias maps a user or a group of an auth method to an entity or a group
+type Alias struct {
+	Name          string
+	MountAccessor string
+	// CanonicalID is the id of the entity or group the alias belongs to
+	CanonicalID string
+}
+
+type vIdentity struct {
+	client *vaultapi.Client
+}
+
+// NewIdentity returns an identity client for the vault
+func NewIdentity(vc *vaultapi.Client) Identity {
+	return &vIdentity{
+		client: vc,
+	}
+}
+
+// EnsureEntity creates or updates the entity
+// it's safe to call multiple times.
+// https://www.vaultproject.io/api/secret/identity/entity.html#create-update-entity-by-name
+func (v *vIdentity) EnsureEntity(e Entity) (string, error) {
+	if e.Name == "" {
+		return "", errors.New("entity name is empty")
+	}
+	return v.ensure(kindEntity, e.Name, e.Owner, entityPayload(e))
+}
+
+// DeleteEntity deletes the entity, unless it belongs to another owner.
+// It's safe to call multiple times.
+// https://www.vaultproject.io/api/secret/identity/entity.html#delete-entity-by-name
+func (v *vIdentity) DeleteEntity(name, owner string) error {
+	return v.delete(kindEntity, name, owner)
+}
+
+// EnsureEntityAlias creates the entity alias if it doesn't exist.
+// https://www.vaultproject.io/api/secret/identity/entity-alias.html#create-an-entity-alias
+func (v *vIdentity) EnsureEntityAlias(a Alias) (string, error) {
+	return v.ensureAlias(kindEntity, a)
+}
+
+// DeleteEntityAlias deletes the entity alias
+// https://www.vaultproject.io/api/secret/identity/entity-alias.html#delete-entity-alias-by-id
+func (v *vIdentity) DeleteEntityAlias(id string) error {
+	_, err := v.request("DELETE", fmt.Sprintf("/v1/identity/entity-alias/id/%s", id), nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete entity alias %s", id)
+	}
+	return nil
+}
+
+// EnsureGroup creates or updates the group
+// it's safe to call multiple times.
+// https://www.vaultproject.io/api/secret/identity/group.html#create-update-group-by-name
+func (v *vIdentity) EnsureGroup(g Group) (string, error) {
+	if g.Name == "" {
+		return "", errors.New("group name is empty")
+	}
+	return v.ensure(kindGroup, g.Name, g.Owner, groupPayload(g))
+}
+
+// DeleteGroup deletes the group, unless it belongs to another owner.
+// It's safe to call multiple times.
+// https://www.vaultproject.io/api/secret/identity/group.html#delete-group-by-name
+func (v *vIdentity) DeleteGroup(name, owner string) error {
+	return v.delete(kindGroup, name, owner)
+}
+
+// EnsureGroupAlias creates the group alias if it doesn't exist.
+// https://www.vaultproject.io/api/secret/identity/group-alias.html#create-a-group-alias
+func (v *vIdentity) EnsureGroupAlias(a Alias) (string, error) {
+	return v.ensureAlias(kindGroup, a)
+}
+
+// DeleteGroupAlias deletes the group alias
+// https://www.vaultproject.io/api/secret/identity/group-alias.html#delete-group-alias-by-id
+func (v *vIdentity) DeleteGroupAlias(id string) error {
+	_, err := v.request("DELETE", fmt.Sprintf("/v1/identity/group-alias/id/%s", id), nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete group alias %s", id)
+	}
+	return nil
+}
+
+// MountAccessor returns the accessor of the auth method enabled in the path
+func (v *vIdentity) MountAccessor(path string) (string, error) {
+	// in auth list path will always be appended with '/'
+	authList, err := v.client.Sys().ListAuth()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to list auth methods")
+	}
+	au, ok := authList[filepath.Clean(path)+"/"]
+	if !ok || au == nil {
+		return "", errors.Errorf("auth method is not enabled in path %s", path)
+	}
+	return au.Accessor, nil
+}
+
+// ensure creates or updates the entity or group by name and returns its id.
+// An existing entity or group is only updated, if it was created for the same owner.
+// Vault doesn't return the id when the entity or group is updated, so it is read afterwards.
+func (v *vIdentity) ensure(kind, name, owner string, payload map[string]interface{}) (string, error) {
+	if owner == "" {
+		return "", errors.Errorf("owner of %s %s is empty", kind, name)
+	}
+	path := fmt.Sprintf("/v1/identity/%s/name/%s", kind, name)
+	secret, err := v.read(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read %s %s", kind, name)
+	}
+	if secret != nil && ownerOf(secret) != owner {
+		return "", errors.Errorf("%s %s already exists and is not created for %s", kind, name, owner)
+	}
+
+	if _, err := v.request("POST", path, payload); err != nil {
+		return "", errors.Wrapf(err, "failed to create or update %s %s", kind, name)
+	}
+
+	secret, err = v.read(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read %s %s", kind, name)
+	}
+	id := dataString(secret, "id")
+	if id == "" {
+		return "", errors.Errorf("id of %s %s is not found", kind, name)
+	}
+	return id, nil
+}
+
+// ensureAlias creates the alias, unless it is already tied to the entity or group.
+// It returns error, if the alias is tied to another entity or group.
+func (v *vIdentity) ensureAlias(kind string, a Alias) (string, error) {
+	if a.Name == "" || a.MountAccessor == "" || a.CanonicalID == "" {
+		return "", errors.New("alias name, mount accessor and canonical id must be non empty")
+	}
+
+	// https://www.vaultproject.io/api/secret/identity/lookup.html
+	secret, err := v.request("POST", fmt.Sprintf("/v1/identity/lookup/%s", kind), map[string]interface{}{
+		"alias_name":           a.Name,
+		"alias_mount_accessor": a.MountAccessor,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to lookup %s by alias %s", kind, a.Name)
+	}
+	if secret != nil && secret.Data != nil {
+		ownerID := dataString(secret, "id")
+		if ownerID != a.CanonicalID {
+			return "", errors.Errorf("alias %s is already tied to %s %s", a.Name, kind, ownerID)
+		}
+		if id := aliasID(kind, secret, a); id != "" {
+			return id, nil
+		}
+	}
+
+	secret, err = v.request("POST", fmt.Sprintf("/v1/identity/%s-alias", kind), map[string]interface{}{
+		"name":           a.Name,
+		"mount_accessor": a.MountAccessor,
+		"canonical_id":   a.CanonicalID,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create %s alias %s", kind, a.Name)
+	}
+	id := dataString(secret, "id")
+	if id == "" {
+		return "", errors.Errorf("id of %s alias %s is not found", kind, a.Name)
+	}
+	return id, nil
+}
+
+// delete deletes the entity or group by name, if it was created for the owner.
+// The entity or group of another owner is left as it is.
+func (v *vIdentity) delete(kind, name, owner string) error {
+	path := fmt.Sprintf("/v1/identity/%s/name/%s", kind, name)
+	secret, err := v.read(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s %s", kind, name)
+	}
+	if secret == nil || ownerOf(secret) != owner {
+		return nil
+	}
+	if _, err := v.request("DELETE", path, nil); err != nil {
+		return errors.Wrapf(err, "failed to delete %s %s", kind, name)
+	}
+	return nil
+}
+
+// read returns the entity or group, it returns nil if it doesn't exist.
+func (v *vIdentity) read(path string) (*vaultapi.Secret, error) {
+	resp, err := v.client.RawRequest(v.client.NewRequest("GET", path))
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vaultapi.ParseSecret(resp.Body)
+}
+
+// request sends the request to vault and parses the response.
+// It returns nil, if the response has no content.
+func (v *vIdentity) request(method, path string, payload map[string]interface{}) (*vaultapi.Secret, error) {
+	req := v.client.NewRequest(method, path)
+	if payload != nil {
+		if err := req.SetJSONBody(payload); err != nil {
+			return nil, errors.Wrap(err, "failed to load payload")
+		}
+	}
+
+	resp, err := v.client.RawRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+	return vaultapi.ParseSecret(resp.Body)
+}
+
+func entityPayload(e Entity) map[string]interface{} {
+	return map[string]interface{}{
+		"policies": stringsOrEmpty(e.Policies),
+		"metadata": ownedMetadata(e.Metadata, e.Owner),
+		"disabled": e.Disabled,
+	}
+}
+
+func groupPayload(g Group) map[string]interface{} {
+	payload := map[string]interface{}{
+		"type":     g.Type,
+		"policies": stringsOrEmpty(g.Policies),
+		"metadata": ownedMetadata(g.Metadata, g.Owner),
+	}
+	// members can't be set for the external groups
+	if g.Type != "external" {
+		payload["member_entity_ids"] = stringsOrEmpty(g.MemberEntityIDs)
+		payload["member_group_ids"] = stringsOrEmpty(g.MemberGroupIDs)
+	}
+	return payload
+}
+
+// aliasID returns the id of the alias from the lookup response of the entity or group
+func aliasID(kind string, secret *vaultapi.Secret, a Alias) string {
+	var aliases []interface{}
+	if kind == kindEntity {
+		aliases, _ = secret.Data["aliases"].([]interface{})
+	} else {
+		aliases = []interface{}{secret.Data["alias"]}
+	}
+	for _, it := range aliases {
+		alias, ok := it.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if alias["name"] == a.Name && alias["mount_accessor"] == a.MountAccessor {
+			id, _ := alias["id"].(string)
+			return id
+		}
+	}
+	return ""
+}
+
+// ownerOf returns the owner from the metadata of the entity or group
+func ownerOf(secret *vaultapi.Secret) string {
+	if secret == nil || secret.Data == nil {
+		return ""
+	}
+	metadata, _ := secret.Data["metadata"].(map[string]interface{})
+	owner, _ := metadata[OwnerMetadataKey].(string)
+	return owner
+}
+
+func dataString(secret *vaultapi.Secret, key string) string {
+	if secret == nil || secret.Data == nil {
+		return ""
+	}
+	s, _ := secret.Data[key].(string)
+	return s
+}
+
+// vault keeps the previous value, if the field is omitted.
+// So empty values are sent to clear them.
+func stringsOrEmpty(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+	return in
+}
+
+// ownedMetadata returns the metadata along with the owner
+func ownedMetadata(in map[string]string, owner string) map[string]string {
+	out := map[string]string{}
+	for k, v := range in {
+		out[k] = v
+	}
+	out[OwnerMetadataKey] = owner
+	return out
+}
@@ -0,0 +1,330 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package identity
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+func setupVaultServer() *httptest.Server {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/v1/identity/entity/name/{name}", func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+		switch r.Method {
+		case http.MethodPost:
+			defer r.Body.Close()
+			var data map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if _, ok := data["policies"].([]interface{}); !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			// vault doesn't return the entity on update
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			switch name {
+			case "demo":
+				_, err := w.Write([]byte(`{"data":{"id":"entity-1","name":"demo","metadata":{"kubevault.com/owner":"vaultidentityentity/demo/demo"}}}`))
+				utilruntime.Must(err)
+			case "foreign":
+				_, err := w.Write([]byte(`{"data":{"id":"entity-2","name":"foreign","metadata":{"team":"ops"}}}`))
+				utilruntime.Must(err)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodDelete:
+			if name != "demo" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}).Methods(http.MethodPost, http.MethodGet, http.MethodDelete)
+
+	router.HandleFunc("/v1/identity/lookup/entity", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var data map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch data["alias_name"] {
+		case "existing":
+			_, err := w.Write([]byte(`{"data":{"id":"entity-1","aliases":[{"id":"alias-1","name":"existing","mount_accessor":"auth_kubernetes_1"}]}}`))
+			utilruntime.Must(err)
+		case "other":
+			_, err := w.Write([]byte(`{"data":{"id":"entity-2","aliases":[{"id":"alias-2","name":"other","mount_accessor":"auth_kubernetes_1"}]}}`))
+			utilruntime.Must(err)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/identity/entity-alias", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"id":"alias-3","canonical_id":"entity-1"}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/identity/lookup/group", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"id":"group-1","alias":{"id":"alias-4","name":"devs","mount_accessor":"auth_ldap_1"}}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodPost)
+
+	return httptest.NewServer(router)
+}
+
+func newDemoIdentity(t *testing.T, addr string) Identity {
+	cfg := vaultapi.DefaultConfig()
+	cfg.Address = addr
+	cl, err := vaultapi.NewClient(cfg)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	return NewIdentity(cl)
+}
+
+func TestIdentity_EnsureEntity(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	testData := []struct {
+		testName    string
+		entity      Entity
+		expectedID  string
+		expectedErr bool
+	}{
+		{
+			testName: "entity is created",
+			entity: Entity{
+				Name:     "demo",
+				Owner:    "vaultidentityentity/demo/demo",
+				Policies: []string{"read"},
+			},
+			expectedID: "entity-1",
+		},
+		{
+			testName: "entity is not found after update",
+			entity: Entity{
+				Name:  "unknown",
+				Owner: "vaultidentityentity/demo/unknown",
+			},
+			expectedErr: true,
+		},
+		{
+			testName: "entity is not created by the operator",
+			entity: Entity{
+				Name:  "foreign",
+				Owner: "vaultidentityentity/demo/foreign",
+			},
+			expectedErr: true,
+		},
+		{
+			testName: "entity is created for another owner",
+			entity: Entity{
+				Name:  "demo",
+				Owner: "vaultidentityentity/other/demo",
+			},
+			expectedErr: true,
+		},
+		{
+			testName:    "entity name is empty",
+			entity:      Entity{},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			id, err := newDemoIdentity(t, srv.URL).EnsureEntity(test.entity)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestIdentity_DeleteEntity(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	testData := []struct {
+		testName string
+		name     string
+		owner    string
+	}{
+		{
+			testName: "entity is deleted",
+			name:     "demo",
+			owner:    "vaultidentityentity/demo/demo",
+		},
+		{
+			testName: "entity of another owner is skipped",
+			name:     "foreign",
+			owner:    "vaultidentityentity/demo/foreign",
+		},
+		{
+			testName: "entity doesn't exist",
+			name:     "unknown",
+			owner:    "vaultidentityentity/demo/unknown",
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Nil(t, newDemoIdentity(t, srv.URL).DeleteEntity(test.name, test.owner))
+		})
+	}
+}
+
+func TestIdentity_EnsureAlias(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	testData := []struct {
+		testName    string
+		group       bool
+		alias       Alias
+		expectedID  string
+		expectedErr bool
+	}{
+		{
+			testName: "alias already exists",
+			alias: Alias{
+				Name:          "existing",
+				MountAccessor: "auth_kubernetes_1",
+				CanonicalID:   "entity-1",
+			},
+			expectedID: "alias-1",
+		},
+		{
+			testName: "alias is tied to another entity",
+			alias: Alias{
+				Name:          "other",
+				MountAccessor: "auth_kubernetes_1",
+				CanonicalID:   "entity-1",
+			},
+			expectedErr: true,
+		},
+		{
+			testName: "alias is created",
+			alias: Alias{
+				Name:          "new",
+				MountAccessor: "auth_kubernetes_1",
+				CanonicalID:   "entity-1",
+			},
+			expectedID: "alias-3",
+		},
+		{
+			testName: "mount accessor is empty",
+			alias: Alias{
+				Name:        "new",
+				CanonicalID: "entity-1",
+			},
+			expectedErr: true,
+		},
+		{
+			testName: "group alias already exists",
+			group:    true,
+			alias: Alias{
+				Name:          "devs",
+				MountAccessor: "auth_ldap_1",
+				CanonicalID:   "group-1",
+			},
+			expectedID: "alias-4",
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			var (
+				id  string
+				err error
+			)
+			if test.group {
+				id, err = newDemoIdentity(t, srv.URL).EnsureGroupAlias(test.alias)
+			} else {
+				id, err = newDemoIdentity(t, srv.URL).EnsureEntityAlias(test.alias)
+			}
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestGroupPayload(t *testing.T) {
+	testData := []struct {
+		testName string
+		group    Group
+		expected map[string]interface{}
+	}{
+		{
+			testName: "internal group",
+			group: Group{
+				Name:            "demo",
+				Owner:           "vaultidentitygroup/demo/demo",
+				Type:            "internal",
+				Policies:        []string{"read"},
+				MemberEntityIDs: []string{"entity-1"},
+			},
+			expected: map[string]interface{}{
+				"type":              "internal",
+				"policies":          []string{"read"},
+				"metadata":          map[string]string{OwnerMetadataKey: "vaultidentitygroup/demo/demo"},
+				"member_entity_ids": []string{"entity-1"},
+				"member_group_ids":  []string{},
+			},
+		},
+		{
+			testName: "external group",
+			group: Group{
+				Name:     "demo",
+				Owner:    "vaultidentitygroup/demo/demo",
+				Type:     "external",
+				Metadata: map[string]string{"team": "ops"},
+			},
+			expected: map[string]interface{}{
+				"type":     "external",
+				"policies": []string{},
+				"metadata": map[string]string{"team": "ops", OwnerMetadataKey: "vaultidentitygroup/demo/demo"},
+			},
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			assert.Equal(t, test.expected, groupPayload(test.group))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package lease
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+)
+
+// https://www.vaultproject.io/api/system/leases.html#list-leases
+//
+// HasLeases checks whether any lease exists under the prefix
+func HasLeases(vc *vaultapi.Client, prefix string) (bool, error) {
+	req := vc.NewRequest("LIST", fmt.Sprintf("/v1/sys/leases/lookup/%s", strings.Trim(prefix, "/")))
+	resp, err := vc.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list leases of %s", prefix)
+	}
+
+	secret, err := vaultapi.ParseSecret(resp.Body)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to parse response body")
+	}
+	if secret == nil || secret.Data == nil {
+		return false, nil
+	}
+	keys, ok := secret.Data["keys"].([]interface{})
+	return ok && len(keys) > 0, nil
+}
+
+// https://www.vaultproject.io/api/system/leases.html#list-leases
+//
+// ListLeases returns the ids of the leases directly under the prefix
+func ListLeases(vc *vaultapi.Client, prefix string) ([]string, error) {
+	prefix = strings.Trim(prefix, "/")
+	req := vc.NewRequest("LIST", fmt.Sprintf("/v1/sys/leases/lookup/%s", prefix))
+	resp, err := vc.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list leases of %s", prefix)
+	}
+
+	secret, err := vaultapi.ParseSecret(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse response body")
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, nil
+	}
+	keys, _ := secret.Data["keys"].([]interface{})
+	var ids []string
+	for _, k := range keys {
+		// the keys ending with "/" are the prefixes of other leases
+		if key, ok := k.(string); ok && !strings.HasSuffix(key, "/") {
+			ids = append(ids, prefix+"/"+key)
+		}
+	}
+	return ids, nil
+}
+
+// https://www.vaultproject.io/api/system/leases.html#revoke-prefix
+//
+// RevokePrefix revokes all leases under the prefix
+func RevokePrefix(vc *vaultapi.Client, prefix string) error {
+	req := vc.NewRequest("PUT", fmt.Sprintf("/v1/sys/leases/revoke-prefix/%s", strings.Trim(prefix, "/")))
+	resp, err := vc.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to revoke leases of %s", prefix)
+	}
+	return nil
+}
+
+// https://www.vaultproject.io/api/system/leases.html#revoke-force
+//
+// RevokeForce revokes all leases under the prefix, ignoring the errors
+// of the secret engine while revoking them
+func RevokeForce(vc *vaultapi.Client, prefix string) error {
+	req := vc.NewRequest("PUT", fmt.Sprintf("/v1/sys/leases/revoke-force/%s", strings.Trim(prefix, "/")))
+	resp, err := vc.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to force revoke leases of %s", prefix)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package lease
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+func NewFakeVaultServer() *httptest.Server {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/v1/sys/leases/lookup/database/creds/{role}", func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["role"] == "with-leases" {
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"data":{"keys":["abcd1234","nested/"]}}`))
+			utilruntime.Must(err)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, err := w.Write([]byte(`{"errors":[]}`))
+		utilruntime.Must(err)
+	}).Methods("LIST")
+
+	router.HandleFunc("/v1/sys/leases/revoke-prefix/database/creds/{role}", func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["role"] == "error" {
+			w.WriteHeader(http.StatusForbidden)
+			_, err := w.Write([]byte(`{"errors":["permission denied"]}`))
+			utilruntime.Must(err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPut)
+
+	router.HandleFunc("/v1/sys/leases/revoke-force/database/creds/{role}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPut)
+
+	return httptest.NewServer(router)
+}
+
+func newVaultClient(t *testing.T, addr string) *vaultapi.Client {
+	cfg := vaultapi.DefaultConfig()
+	cfg.Address = addr
+	vc, err := vaultapi.NewClient(cfg)
+	if !assert.Nil(t, err, "failed to create vault client") {
+		t.FailNow()
+	}
+	return vc
+}
+
+func TestHasLeases(t *testing.T) {
+	srv := NewFakeVaultServer()
+	defer srv.Close()
+
+	vc := newVaultClient(t, srv.URL)
+
+	cases := []struct {
+		testName string
+		prefix   string
+		expected bool
+	}{
+		{
+			testName: "leases exist",
+			prefix:   "database/creds/with-leases",
+			expected: true,
+		},
+		{
+			testName: "no lease exists",
+			prefix:   "database/creds/without-leases",
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			ok, err := HasLeases(vc, c.prefix)
+			if assert.Nil(t, err) {
+				assert.Equal(t, c.expected, ok)
+			}
+		})
+	}
+}
+
+func TestListLeases(t *testing.T) {
+	srv := NewFakeVaultServer()
+	defer srv.Close()
+
+	vc := newVaultClient(t, srv.URL)
+
+	ids, err := ListLeases(vc, "database/creds/with-leases/")
+	if assert.Nil(t, err) {
+		assert.Equal(t, []string{"database/creds/with-leases/abcd1234"}, ids)
+	}
+
+	ids, err = ListLeases(vc, "database/creds/without-leases")
+	if assert.Nil(t, err) {
+		assert.Empty(t, ids)
+	}
+}
+
+func TestRevokePrefix(t *testing.T) {
+	srv := NewFakeVaultServer()
+	defer srv.Close()
+
+	vc := newVaultClient(t, srv.URL)
+
+	assert.Nil(t, RevokePrefix(vc, "database/creds/test"))
+	assert.NotNil(t, RevokePrefix(vc, "database/creds/error"))
+}
+
+func TestRevokeForce(t *testing.T) {
+	srv := NewFakeVaultServer()
+	defer srv.Close()
+
+	vc := newVaultClient(t, srv.URL)
+
+	assert.Nil(t, RevokeForce(vc, "database/creds/test"))
+	assert.NotNil(t, RevokeForce(vc, "aws/creds/test"))
+}
@@ -0,0 +1,144 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"github.com/pkg/errors"
+)
+
+var hclIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_\-]*$`)
+
+// AggregatePolicies returns a policy in hcl format containing the union of the rules of the policies.
+// The policies can be in either hcl or json format.
+// Vault merges the rules of the same path, so the policies are concatenated as is.
+func AggregatePolicies(docs ...string) (string, error) {
+	var parts []string
+	for _, doc := range docs {
+		doc = strings.TrimSpace(doc)
+		if doc == "" {
+			continue
+		}
+		if strings.HasPrefix(doc, "{") {
+			var err error
+			doc, err = jsonPolicyToHCL(doc)
+			if err != nil {
+				return "", err
+			}
+		}
+		if _, err := hcl.Parse(doc); err != nil {
+			return "", errors.Wrap(err, "failed to parse policy")
+		}
+		parts = append(parts, strings.TrimSpace(doc))
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
+
+// jsonPolicyToHCL converts a policy in json format to hcl format
+func jsonPolicyToHCL(doc string) (string, error) {
+	d := json.NewDecoder(strings.NewReader(doc))
+	d.UseNumber()
+	var root map[string]interface{}
+	if err := d.Decode(&root); err != nil {
+		return "", errors.Wrap(err, "failed to parse policy")
+	}
+
+	var buf bytes.Buffer
+	for _, k := range sortedKeys(root) {
+		switch v := root[k].(type) {
+		case map[string]interface{}:
+			writeHCLBlocks(&buf, k, v)
+		case []interface{}:
+			// e.g. {"path": [{"secret/*": {...}}, {"kv/*": {...}}]}
+			for _, it := range v {
+				m, ok := it.(map[string]interface{})
+				if !ok {
+					return "", errors.Errorf("failed to parse policy: %s must be a list of objects", k)
+				}
+				writeHCLBlocks(&buf, k, m)
+			}
+		default:
+			writeHCLAttribute(&buf, k, v, "")
+		}
+	}
+	return buf.String(), nil
+}
+
+// writeHCLBlocks writes the blocks labeled by the keys of the object, e.g. path "secret/*" {...}
+func writeHCLBlocks(buf *bytes.Buffer, key string, obj map[string]interface{}) {
+	for _, label := range sortedKeys(obj) {
+		buf.WriteString(key + " " + strconv.Quote(label) + " {\n")
+		if body, ok := obj[label].(map[string]interface{}); ok {
+			for _, k := range sortedKeys(body) {
+				writeHCLAttribute(buf, k, body[k], "  ")
+			}
+		}
+		buf.WriteString("}\n")
+	}
+}
+
+func writeHCLAttribute(buf *bytes.Buffer, key string, val interface{}, indent string) {
+	if val == nil {
+		return
+	}
+	if !hclIdentifier.MatchString(key) {
+		key = strconv.Quote(key)
+	}
+	buf.WriteString(indent + key + " = " + hclValue(val, indent) + "\n")
+}
+
+func hclValue(val interface{}, indent string) string {
+	switch v := val.(type) {
+	case map[string]interface{}:
+		var buf bytes.Buffer
+		buf.WriteString("{\n")
+		for _, k := range sortedKeys(v) {
+			writeHCLAttribute(&buf, k, v[k], indent+"  ")
+		}
+		buf.WriteString(indent + "}")
+		return buf.String()
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, it := range v {
+			items = append(items, hclValue(it, indent))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case string:
+		return strconv.Quote(v)
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return `""`
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
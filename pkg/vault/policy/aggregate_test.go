@@ -0,0 +1,106 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregatePolicies(t *testing.T) {
+	cases := []struct {
+		testName  string
+		docs      []string
+		expected  string
+		expectErr bool
+	}{
+		{
+			testName: "aggregate hcl policies, expect no error",
+			docs: []string{
+				`path "secret/*" { capabilities = ["read"] }`,
+				"",
+				`path "kv/*" { capabilities = ["list"] }`,
+			},
+			expected: `path "secret/*" { capabilities = ["read"] }
+
+path "kv/*" { capabilities = ["list"] }`,
+		},
+		{
+			testName: "aggregate hcl and json policies, expect no error",
+			docs: []string{
+				`path "secret/*" { capabilities = ["read"] }`,
+				`{"path":{"kv/*":{"capabilities":["read","list"],"allowed_parameters":{"*":[],"ttl":["1h",3600]}},"sys/*":{"policy":"deny"}}}`,
+			},
+			expected: `path "secret/*" { capabilities = ["read"] }
+
+path "kv/*" {
+  allowed_parameters = {
+    "*" = []
+    ttl = ["1h", 3600]
+  }
+  capabilities = ["read", "list"]
+}
+path "sys/*" {
+  policy = "deny"
+}`,
+		},
+		{
+			testName: "json policy with list of paths, expect no error",
+			docs: []string{
+				`{"path":[{"kv/*":{"capabilities":["read"]}},{"kv/*":{"capabilities":["list"]}}]}`,
+			},
+			expected: `path "kv/*" {
+  capabilities = ["read"]
+}
+path "kv/*" {
+  capabilities = ["list"]
+}`,
+		},
+		{
+			testName: "no policy, expect no error",
+			docs:     []string{"", " "},
+			expected: "",
+		},
+		{
+			testName: "malformed hcl policy, expect error",
+			docs: []string{
+				`path "secret/*" { capabilities = ["read"]`,
+			},
+			expectErr: true,
+		},
+		{
+			testName: "malformed json policy, expect error",
+			docs: []string{
+				`{"path":{"kv/*":{"capabilities":["read"]}}`,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			doc, err := AggregatePolicies(c.docs...)
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				if assert.Nil(t, err) {
+					assert.Equal(t, c.expected, doc)
+				}
+			}
+		})
+	}
+}
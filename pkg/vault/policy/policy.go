@@ -43,7 +43,12 @@ func NewPolicyClientForVault(kc kubernetes.Interface, appc appcat_cs.AppcatalogV
 		Namespace: p.Namespace,
 		Name:      p.Spec.VaultRef.Name,
 	}
-	vc, err := vault.NewClient(kc, appc, vAppRef)
+	return NewPolicyClient(kc, appc, vAppRef)
+}
+
+// NewPolicyClient returns a policy client for the vault referred by the AppBinding
+func NewPolicyClient(kc kubernetes.Interface, appc appcat_cs.AppcatalogV1alpha1Interface, ref *appcat.AppReference) (Policy, error) {
+	vc, err := vault.NewClient(kc, appc, ref)
 	if err != nil {
 		return nil, err
 	}
@@ -16,14 +16,13 @@ limitations under the License.
 package policybinding
 
 import (
-	"fmt"
-
 	api "kubevault.dev/operator/apis/policy/v1alpha1"
 	cs "kubevault.dev/operator/client/clientset/versioned"
 	"kubevault.dev/operator/pkg/vault"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
@@ -35,6 +34,10 @@ type PolicyBinding interface {
 	Ensure(name string) error
 	// delete policy binding
 	Delete(name string) error
+	// policies granted to the subjects shared with other bindings
+	SharedPolicies() []string
+	// subjects shared with other bindings
+	SharedSubjects() *api.SharedSubjectRef
 }
 
 func NewPolicyBindingClient(c cs.Interface, appc appcat_cs.AppcatalogV1alpha1Interface, kc kubernetes.Interface, pBind *api.VaultPolicyBinding) (PolicyBinding, error) {
@@ -44,7 +47,13 @@ func NewPolicyBindingClient(c cs.Interface, appc appcat_cs.AppcatalogV1alpha1Int
 	if len(pBind.Spec.Policies) == 0 {
 		return nil, errors.New(".spec.policies must be non empty")
 	}
-	pb := &pBinding{}
+	subject := pBind.Spec.SubjectRef.DeepCopy()
+	subject.SetDefaults()
+	pb := &pBinding{
+		subject:        *subject,
+		sharedPolicies: pBind.Status.SharedPolicies,
+		sharedSubjects: pBind.Status.SharedSubjects,
+	}
 	if pBind.Spec.Kubernetes != nil {
 		pb.saNames = pBind.Spec.Kubernetes.ServiceAccountNames
 		pb.saNamespaces = pBind.Spec.Kubernetes.ServiceAccountNamespaces
@@ -52,32 +61,35 @@ func NewPolicyBindingClient(c cs.Interface, appc appcat_cs.AppcatalogV1alpha1Int
 		pb.maxTTL = pBind.Spec.Kubernetes.MaxTTL
 		pb.period = pBind.Spec.Kubernetes.Period
 		pb.path = pBind.Spec.Kubernetes.Path
-		pb.setKubernetesDefaults()
-	}
-
-	// check whether VaultPolicy exists
-	for _, pIdentifier := range pBind.Spec.Policies {
-		var policyName string
-		if pIdentifier.Ref != "" {
-			// pIdentifier.Ref species the policy crd name
-			policy, err := c.PolicyV1alpha1().VaultPolicies(pBind.Namespace).Get(pIdentifier.Ref, metav1.GetOptions{})
+		if ref := pBind.Spec.Kubernetes.ClusterRef; ref != "" {
+			// role is created in the kubernetes auth method of the remote cluster
+			kac, err := c.KubevaultV1alpha1().KubernetesAuthClusters(pBind.Namespace).Get(ref, metav1.GetOptions{})
 			if err != nil {
-				return nil, errors.Wrap(err, "for .spec.policies")
+				return nil, errors.Wrap(err, "for .spec.subjectRef.kubernetes.clusterRef")
 			}
-			policyName = policy.PolicyName()
-		} else {
-			// pIdentifier.Name specifies the vault policy name
-			// If anyone wants to access a policy crd through this field
-			// they need to follow the naming format: k8s.<cluster_name>.<namespace_name>.<policy_name>
-			policyName = pIdentifier.Name
+			if kac.Spec.VaultRef.Name != pBind.Spec.VaultRef.Name {
+				return nil, errors.Errorf("for .spec.subjectRef.kubernetes.clusterRef: KubernetesAuthCluster %s refers to a different vault", ref)
+			}
+			pb.path = kac.AuthPath()
 		}
-
-		pb.policies = append(pb.policies, policyName)
+		pb.setKubernetesDefaults()
 	}
+
+	// check whether VaultPolicy exists
 	var err error
+	pb.policies, err = PolicyNames(c, pBind.Namespace, pBind.Spec.Policies)
+	if err != nil {
+		return nil, errors.Wrap(err, "for .spec.policies")
+	}
 	if pBind.Spec.VaultRef.Name == "" {
 		return nil, errors.New("spec.vaultRef must not be empty")
 	}
+	if pb.hasSharedSubjects() || pb.sharedSubjects != nil {
+		pb.otherGrants, err = sharedGrants(c, pBind)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	vAppRef := &appcat.AppReference{
 		Namespace: pBind.Namespace,
@@ -90,6 +102,56 @@ func NewPolicyBindingClient(c cs.Interface, appc appcat_cs.AppcatalogV1alpha1Int
 	return pb, nil
 }
 
+// sharedGrants returns the policies granted to the shared subjects by the other bindings, indexed by the
+// vault path of the subjects. The bindings of all namespaces are considered, as the vault servers
+// can't be told apart by their vaultRef.
+func sharedGrants(c cs.Interface, pBind *api.VaultPolicyBinding) (map[string][]string, error) {
+	list, err := c.PolicyV1alpha1().VaultPolicyBindings(core.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list VaultPolicyBindings")
+	}
+	grants := map[string][]string{}
+	for _, b := range list.Items {
+		if (b.Namespace == pBind.Namespace && b.Name == pBind.Name) || b.DeletionTimestamp != nil {
+			continue
+		}
+		for _, r := range sharedRoles(b.Status.SharedSubjects) {
+			grants[r.readPath] = append(grants[r.readPath], b.Status.SharedPolicies...)
+		}
+	}
+	return grants, nil
+}
+
+// PolicyNames returns the vault policy names of the policy identifiers
+func PolicyNames(c cs.Interface, namespace string, ids []api.PolicyIdentifier) ([]string, error) {
+	var names []string
+	for _, pIdentifier := range ids {
+		var policyName string
+		if pIdentifier.Ref != "" {
+			// pIdentifier.Ref species the policy crd name
+			policy, err := c.PolicyV1alpha1().VaultPolicies(namespace).Get(pIdentifier.Ref, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			policyName = policy.PolicyName()
+		} else if pIdentifier.ClusterRef != "" {
+			// pIdentifier.ClusterRef species the cluster policy crd name
+			policy, err := c.PolicyV1alpha1().ClusterVaultPolicies().Get(pIdentifier.ClusterRef, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			policyName = policy.PolicyName()
+		} else {
+			// pIdentifier.Name specifies the vault policy name
+			// If anyone wants to access a policy crd through this field
+			// they need to follow the naming format: k8s.<cluster_name>.<namespace_name>.<policy_name>
+			policyName = pIdentifier.Name
+		}
+		names = append(names, policyName)
+	}
+	return names, nil
+}
+
 type pBinding struct {
 	vClient      *vaultapi.Client
 	policies     []string
@@ -98,7 +160,16 @@ type pBinding struct {
 	ttl          string
 	maxTTL       string
 	period       string
-	path         string
+	// path where kubernetes auth is enabled, empty if the kubernetes subject is not specified
+	path string
+	// subject contains the subjects of the other auth methods
+	subject api.SubjectRef
+	// policies previously granted to the shared subjects by the binding
+	sharedPolicies []string
+	// shared subjects previously granted the policies by the binding
+	sharedSubjects *api.SharedSubjectRef
+	// policies granted to the shared subjects by the other bindings, indexed by the read path of the subjects
+	otherGrants map[string][]string
 }
 
 func (p *pBinding) setKubernetesDefaults() {
@@ -110,37 +181,106 @@ func (p *pBinding) setKubernetesDefaults() {
 // create or update policy binding
 // it's safe to call it multiple times
 func (p *pBinding) Ensure(name string) error {
-	path := fmt.Sprintf("/v1/auth/%s/role/%s", p.path, name)
-	req := p.vClient.NewRequest("POST", path)
-	payload := map[string]interface{}{
-		"bound_service_account_names":      p.saNames,
-		"bound_service_account_namespaces": p.saNamespaces,
-		"policies":                         p.policies,
-		"ttl":                              p.ttl,
-		"max_ttl":                          p.maxTTL,
-		"period":                           p.period,
-	}
-
-	err := req.SetJSONBody(payload)
+	roles, err := p.roles(name)
 	if err != nil {
 		return err
 	}
 
-	_, err = p.vClient.RawRequest(req)
-	if err != nil {
-		return err
+	for _, r := range roles {
+		payload := r.payload
+		if r.readPath != "" {
+			payload, err = p.sharedRolePayload(r, p.policies, p.sharedPolicies)
+			if err != nil {
+				return err
+			}
+		}
+
+		req := p.vClient.NewRequest("POST", r.path)
+		err := req.SetJSONBody(payload)
+		if err != nil {
+			return err
+		}
+
+		_, err = p.vClient.RawRequest(req)
+		if err != nil {
+			return err
+		}
 	}
-	return nil
+	return p.revokeSharedRoles(p.staleSharedRoles(roles), p.sharedPolicies)
 }
 
 // delete policy binding
 // it's safe to call it, even if 'name' doesn't exist in vault
 func (p *pBinding) Delete(name string) error {
-	path := fmt.Sprintf("/v1/auth/%s/role/%s", p.path, name)
-	req := p.vClient.NewRequest("DELETE", path)
-	_, err := p.vClient.RawRequest(req)
+	roles, err := p.roles(name)
 	if err != nil {
 		return err
 	}
+
+	var shared []authRole
+	for _, r := range roles {
+		if r.readPath != "" {
+			// the shared roles are never deleted, only the policies of the binding are revoked
+			shared = append(shared, r)
+			continue
+		}
+
+		req := p.vClient.NewRequest("DELETE", r.path)
+		_, err = p.vClient.RawRequest(req)
+		if err != nil {
+			return err
+		}
+	}
+	// the policies of the binding may be granted by a failed reconcile, so they are revoked too
+	err = p.revokeSharedRoles(shared, append(append([]string{}, p.sharedPolicies...), p.policies...))
+	if err != nil {
+		return err
+	}
+	return p.revokeSharedRoles(p.staleSharedRoles(roles), p.sharedPolicies)
+}
+
+// revokeSharedRoles revokes the policies from the shared roles,
+// except the ones still granted by the other bindings
+func (p *pBinding) revokeSharedRoles(roles []authRole, policies []string) error {
+	for _, r := range roles {
+		payload, err := p.sharedRolePayload(r, nil, policies)
+		if err != nil {
+			return err
+		}
+		if payload == nil {
+			continue
+		}
+
+		req := p.vClient.NewRequest("POST", r.path)
+		err = req.SetJSONBody(payload)
+		if err != nil {
+			return err
+		}
+		_, err = p.vClient.RawRequest(req)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+// SharedPolicies returns the policies granted to the subjects shared with other bindings.
+// They are stored in the status, so that the policies removed from the binding are revoked.
+func (p *pBinding) SharedPolicies() []string {
+	if !p.hasSharedSubjects() {
+		return nil
+	}
+	return p.policies
+}
+
+// SharedSubjects returns the subjects shared with other bindings.
+// They are stored in the status, so that the policies are revoked from the subjects removed from the binding.
+func (p *pBinding) SharedSubjects() *api.SharedSubjectRef {
+	if !p.hasSharedSubjects() {
+		return nil
+	}
+	return &api.SharedSubjectRef{
+		UserPass: p.subject.UserPass,
+		LDAP:     p.subject.LDAP,
+	}
+}
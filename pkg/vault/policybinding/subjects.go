@@ -0,0 +1,475 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package policybinding
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+)
+
+// authRole is a role, user or group of an auth method which is bound to the policies
+type authRole struct {
+	// path of the role in vault
+	path string
+	// payload to create or update the role
+	payload map[string]interface{}
+	// if set, the role is not created by the binding and may be shared with
+	// other bindings. Its current policies are read from this path and only the
+	// policies of the binding are added or removed. It is never deleted.
+	readPath string
+	// key of the policies of the shared role
+	policiesKey string
+	// keys of the shared role, which must be written back along with the policies
+	keepKeys []string
+}
+
+// roles returns the roles of all the subjects of the policy binding
+func (p *pBinding) roles(name string) ([]authRole, error) {
+	var roles []authRole
+	if p.path != "" {
+		roles = append(roles, authRole{
+			path: fmt.Sprintf("/v1/auth/%s/role/%s", p.path, name),
+			payload: map[string]interface{}{
+				"bound_service_account_names":      p.saNames,
+				"bound_service_account_namespaces": p.saNamespaces,
+				"policies":                         p.policies,
+				"ttl":                              p.ttl,
+				"max_ttl":                          p.maxTTL,
+				"period":                           p.period,
+			},
+		})
+	}
+
+	s := p.subject
+	if s.AppRole != nil {
+		payload, err := appRolePayload(s.AppRole, p.policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "for subjectRef.appRole")
+		}
+		roles = append(roles, authRole{
+			path:    fmt.Sprintf("/v1/auth/%s/role/%s", s.AppRole.Path, name),
+			payload: payload,
+		})
+	}
+	if s.JWT != nil {
+		payload, err := jwtPayload(s.JWT, "jwt", p.policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "for subjectRef.jwt")
+		}
+		roles = append(roles, authRole{
+			path:    fmt.Sprintf("/v1/auth/%s/role/%s", s.JWT.Path, name),
+			payload: payload,
+		})
+	}
+	if s.OIDC != nil {
+		payload, err := jwtPayload(s.OIDC, "oidc", p.policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "for subjectRef.oidc")
+		}
+		roles = append(roles, authRole{
+			path:    fmt.Sprintf("/v1/auth/%s/role/%s", s.OIDC.Path, name),
+			payload: payload,
+		})
+	}
+	if s.AWS != nil {
+		payload, err := awsPayload(s.AWS, p.policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "for subjectRef.aws")
+		}
+		roles = append(roles, authRole{
+			path:    fmt.Sprintf("/v1/auth/%s/role/%s", s.AWS.Path, name),
+			payload: payload,
+		})
+	}
+	if s.GCP != nil {
+		payload, err := gcpPayload(s.GCP, p.policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "for subjectRef.gcp")
+		}
+		roles = append(roles, authRole{
+			path:    fmt.Sprintf("/v1/auth/%s/role/%s", s.GCP.Path, name),
+			payload: payload,
+		})
+	}
+	if s.Azure != nil {
+		payload, err := azurePayload(s.Azure, p.policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "for subjectRef.azure")
+		}
+		roles = append(roles, authRole{
+			path:    fmt.Sprintf("/v1/auth/%s/role/%s", s.Azure.Path, name),
+			payload: payload,
+		})
+	}
+	if s.Cert != nil {
+		payload, err := certPayload(s.Cert, p.policies)
+		if err != nil {
+			return nil, errors.Wrap(err, "for subjectRef.cert")
+		}
+		roles = append(roles, authRole{
+			path:    fmt.Sprintf("/v1/auth/%s/certs/%s", s.Cert.Path, name),
+			payload: payload,
+		})
+	}
+	if s.UserPass != nil && len(s.UserPass.Usernames) == 0 {
+		return nil, errors.New("for subjectRef.userpass: usernames must be non empty")
+	}
+	if s.LDAP != nil && len(s.LDAP.Groups) == 0 && len(s.LDAP.Users) == 0 {
+		return nil, errors.New("for subjectRef.ldap: one of groups or users must be non empty")
+	}
+	roles = append(roles, sharedRoles(&api.SharedSubjectRef{UserPass: s.UserPass, LDAP: s.LDAP})...)
+
+	if len(roles) == 0 {
+		return nil, errors.New("subjectRef must specify at least one subject")
+	}
+	return roles, nil
+}
+
+// sharedRoles returns the roles of the userpass and ldap subjects,
+// which are shared with the other bindings, so only their policies are updated
+func sharedRoles(s *api.SharedSubjectRef) []authRole {
+	if s == nil {
+		return nil
+	}
+	var roles []authRole
+	if s.UserPass != nil {
+		// the users are managed outside of the binding
+		for _, u := range s.UserPass.Usernames {
+			roles = append(roles, authRole{
+				path:        fmt.Sprintf("/v1/auth/%s/users/%s/policies", s.UserPass.Path, u),
+				readPath:    fmt.Sprintf("/v1/auth/%s/users/%s", s.UserPass.Path, u),
+				policiesKey: "token_policies",
+			})
+		}
+	}
+	if s.LDAP != nil {
+		for _, g := range s.LDAP.Groups {
+			roles = append(roles, authRole{
+				path:        fmt.Sprintf("/v1/auth/%s/groups/%s", s.LDAP.Path, g),
+				readPath:    fmt.Sprintf("/v1/auth/%s/groups/%s", s.LDAP.Path, g),
+				policiesKey: "policies",
+			})
+		}
+		for _, u := range s.LDAP.Users {
+			roles = append(roles, authRole{
+				path:        fmt.Sprintf("/v1/auth/%s/users/%s", s.LDAP.Path, u),
+				readPath:    fmt.Sprintf("/v1/auth/%s/users/%s", s.LDAP.Path, u),
+				policiesKey: "policies",
+				keepKeys:    []string{"groups"},
+			})
+		}
+	}
+	return roles
+}
+
+// staleSharedRoles returns the shared roles, which were granted the policies
+// by the binding, but are removed from the binding
+func (p *pBinding) staleSharedRoles(roles []authRole) []authRole {
+	current := map[string]bool{}
+	for _, r := range roles {
+		if r.readPath != "" {
+			current[r.readPath] = true
+		}
+	}
+	var stale []authRole
+	for _, r := range sharedRoles(p.sharedSubjects) {
+		if !current[r.readPath] {
+			stale = append(stale, r)
+		}
+	}
+	return stale
+}
+
+// hasSharedSubjects checks whether the binding grants policies to the
+// userpass or ldap subjects, which are shared with the other bindings
+func (p *pBinding) hasSharedSubjects() bool {
+	return p.subject.UserPass != nil || p.subject.LDAP != nil
+}
+
+// sharedRolePayload returns the payload of the shared role, which grants and
+// revokes the policies of the binding and keeps the policies granted by others.
+// The policies still granted to the role by the other bindings are never revoked.
+// It returns nil, if the role doesn't exist and there is nothing to grant.
+func (p *pBinding) sharedRolePayload(r authRole, grant, revoke []string) (map[string]interface{}, error) {
+	data, err := p.readRole(r.readPath)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		if len(grant) == 0 {
+			return nil, nil
+		}
+		data = map[string]interface{}{}
+	}
+
+	keep := map[string]bool{}
+	for _, name := range p.otherGrants[r.readPath] {
+		keep[name] = true
+	}
+	revoked := map[string]bool{}
+	for _, name := range revoke {
+		if !keep[name] {
+			revoked[name] = true
+		}
+	}
+
+	policies := []string{}
+	exists := map[string]bool{}
+	for _, name := range toStrings(data[r.policiesKey]) {
+		if !revoked[name] && !exists[name] {
+			policies = append(policies, name)
+			exists[name] = true
+		}
+	}
+	for _, name := range grant {
+		if !exists[name] {
+			policies = append(policies, name)
+			exists[name] = true
+		}
+	}
+
+	payload := map[string]interface{}{
+		r.policiesKey: policies,
+	}
+	for _, k := range r.keepKeys {
+		if v, ok := data[k]; ok {
+			payload[k] = v
+		}
+	}
+	return payload, nil
+}
+
+// readRole reads the data of the role, it returns nil if the role doesn't exist
+func (p *pBinding) readRole(path string) (map[string]interface{}, error) {
+	req := p.vClient.NewRequest("GET", path)
+	resp, err := p.vClient.RawRequest(req)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+
+	secret, err := vaultapi.ParseSecret(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse response body")
+	}
+	if secret == nil {
+		return nil, nil
+	}
+	return secret.Data, nil
+}
+
+// toStrings converts the list read from vault to a string slice
+func toStrings(v interface{}) []string {
+	var out []string
+	switch l := v.(type) {
+	case []interface{}:
+		for _, e := range l {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+	case []string:
+		out = l
+	case string:
+		for _, s := range strings.Split(l, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+// More info: https://www.vaultproject.io/api/auth/approle/index.html#create-update-approle
+func appRolePayload(s *api.AppRoleSubjectRef, policies []string) (map[string]interface{}, error) {
+	payload := tokenPayload(s.TokenParams, policies)
+	if s.BindSecretID != nil {
+		if !*s.BindSecretID && len(s.SecretIDBoundCIDRs) == 0 && len(s.TokenBoundCIDRs) == 0 {
+			return nil, errors.New("one of secretIDBoundCIDRs or tokenBoundCIDRs must be non empty, if bindSecretID is false")
+		}
+		payload["bind_secret_id"] = *s.BindSecretID
+	}
+	setIfNotEmpty(payload, "secret_id_bound_cidrs", s.SecretIDBoundCIDRs)
+	setIfNotEmpty(payload, "secret_id_num_uses", s.SecretIDNumUses)
+	setIfNotEmpty(payload, "secret_id_ttl", s.SecretIDTTL)
+	return payload, nil
+}
+
+// More info: https://www.vaultproject.io/api/auth/jwt/index.html#create-role
+func jwtPayload(s *api.JWTSubjectRef, roleType string, policies []string) (map[string]interface{}, error) {
+	if roleType == "oidc" && len(s.AllowedRedirectURIs) == 0 {
+		return nil, errors.New("allowedRedirectURIs must be non empty")
+	}
+	if roleType == "jwt" && len(s.BoundAudiences) == 0 && s.BoundSubject == "" && len(s.BoundClaims) == 0 && len(s.TokenBoundCIDRs) == 0 {
+		return nil, errors.New("one of boundAudiences, boundSubject, boundClaims or tokenBoundCIDRs must be non empty")
+	}
+
+	payload := tokenPayload(s.TokenParams, policies)
+	payload["role_type"] = roleType
+	setIfNotEmpty(payload, "user_claim", s.UserClaim)
+	setIfNotEmpty(payload, "bound_audiences", s.BoundAudiences)
+	setIfNotEmpty(payload, "bound_subject", s.BoundSubject)
+	setIfNotEmpty(payload, "bound_claims", s.BoundClaims)
+	setIfNotEmpty(payload, "groups_claim", s.GroupsClaim)
+	setIfNotEmpty(payload, "claim_mappings", s.ClaimMappings)
+	setIfNotEmpty(payload, "allowed_redirect_uris", s.AllowedRedirectURIs)
+	setIfNotEmpty(payload, "oidc_scopes", s.OIDCScopes)
+	return payload, nil
+}
+
+// More info: https://www.vaultproject.io/api/auth/aws/index.html#create-role
+func awsPayload(s *api.AWSSubjectRef, policies []string) (map[string]interface{}, error) {
+	switch s.AuthType {
+	case api.AWSAuthTypeIAM:
+		if len(s.BoundIAMPrincipalARNs) == 0 {
+			return nil, errors.New("boundIAMPrincipalARNs must be non empty for iam auth type")
+		}
+	case api.AWSAuthTypeEC2:
+		if len(s.BoundAMIIDs) == 0 && len(s.BoundAccountIDs) == 0 && len(s.BoundRegions) == 0 &&
+			len(s.BoundVPCIDs) == 0 && len(s.BoundSubnetIDs) == 0 && len(s.BoundIAMRoleARNs) == 0 &&
+			len(s.BoundIAMInstanceProfileARNs) == 0 && len(s.BoundEC2InstanceIDs) == 0 {
+			return nil, errors.New("at least one bound parameter must be specified for ec2 auth type")
+		}
+	default:
+		return nil, errors.Errorf("unknown auth type %s", s.AuthType)
+	}
+
+	payload := tokenPayload(s.TokenParams, policies)
+	payload["auth_type"] = string(s.AuthType)
+	setIfNotEmpty(payload, "bound_iam_principal_arn", s.BoundIAMPrincipalARNs)
+	setIfNotEmpty(payload, "bound_account_id", s.BoundAccountIDs)
+	setIfNotEmpty(payload, "bound_region", s.BoundRegions)
+	setIfNotEmpty(payload, "bound_vpc_id", s.BoundVPCIDs)
+	setIfNotEmpty(payload, "bound_subnet_id", s.BoundSubnetIDs)
+	setIfNotEmpty(payload, "bound_ami_id", s.BoundAMIIDs)
+	setIfNotEmpty(payload, "bound_iam_role_arn", s.BoundIAMRoleARNs)
+	setIfNotEmpty(payload, "bound_iam_instance_profile_arn", s.BoundIAMInstanceProfileARNs)
+	setIfNotEmpty(payload, "bound_ec2_instance_id", s.BoundEC2InstanceIDs)
+	setIfNotEmpty(payload, "inferred_entity_type", s.InferredEntityType)
+	setIfNotEmpty(payload, "inferred_aws_region", s.InferredAWSRegion)
+	return payload, nil
+}
+
+// More info: https://www.vaultproject.io/api/auth/gcp/index.html#create-role
+func gcpPayload(s *api.GCPSubjectRef, policies []string) (map[string]interface{}, error) {
+	switch s.Type {
+	case api.GCPAuthTypeIAM:
+		if len(s.BoundServiceAccounts) == 0 {
+			return nil, errors.New("boundServiceAccounts must be non empty for iam type")
+		}
+	case api.GCPAuthTypeGCE:
+	default:
+		return nil, errors.Errorf("unknown type %s", s.Type)
+	}
+
+	payload := tokenPayload(s.TokenParams, policies)
+	payload["type"] = string(s.Type)
+	setIfNotEmpty(payload, "bound_service_accounts", s.BoundServiceAccounts)
+	setIfNotEmpty(payload, "bound_projects", s.BoundProjects)
+	setIfNotEmpty(payload, "bound_zones", s.BoundZones)
+	setIfNotEmpty(payload, "bound_regions", s.BoundRegions)
+	setIfNotEmpty(payload, "bound_instance_groups", s.BoundInstanceGroups)
+	setIfNotEmpty(payload, "bound_labels", s.BoundLabels)
+	setIfNotEmpty(payload, "add_group_aliases", s.AddGroupAliases)
+	setIfNotEmpty(payload, "max_jwt_exp", s.MaxJWTExp)
+	return payload, nil
+}
+
+// More info: https://www.vaultproject.io/api/auth/azure/index.html#create-role
+func azurePayload(s *api.AzureSubjectRef, policies []string) (map[string]interface{}, error) {
+	if len(s.BoundServicePrincipalIDs) == 0 && len(s.BoundGroupIDs) == 0 && len(s.BoundLocations) == 0 &&
+		len(s.BoundSubscriptionIDs) == 0 && len(s.BoundResourceGroups) == 0 && len(s.BoundScaleSets) == 0 {
+		return nil, errors.New("at least one bound parameter must be specified")
+	}
+
+	payload := tokenPayload(s.TokenParams, policies)
+	setIfNotEmpty(payload, "bound_service_principal_ids", s.BoundServicePrincipalIDs)
+	setIfNotEmpty(payload, "bound_group_ids", s.BoundGroupIDs)
+	setIfNotEmpty(payload, "bound_locations", s.BoundLocations)
+	setIfNotEmpty(payload, "bound_subscription_ids", s.BoundSubscriptionIDs)
+	setIfNotEmpty(payload, "bound_resource_groups", s.BoundResourceGroups)
+	setIfNotEmpty(payload, "bound_scale_sets", s.BoundScaleSets)
+	return payload, nil
+}
+
+// More info: https://www.vaultproject.io/api/auth/cert/index.html#create-ca-certificate-role
+func certPayload(s *api.CertSubjectRef, policies []string) (map[string]interface{}, error) {
+	if s.Certificate == "" {
+		return nil, errors.New("certificate must be non empty")
+	}
+
+	payload := tokenPayload(s.TokenParams, policies)
+	payload["certificate"] = s.Certificate
+	setIfNotEmpty(payload, "allowed_common_names", s.AllowedCommonNames)
+	setIfNotEmpty(payload, "allowed_dns_sans", s.AllowedDNSSANs)
+	setIfNotEmpty(payload, "allowed_email_sans", s.AllowedEmailSANs)
+	setIfNotEmpty(payload, "allowed_uri_sans", s.AllowedURISANs)
+	setIfNotEmpty(payload, "allowed_organizational_units", s.AllowedOrganizationalUnits)
+	setIfNotEmpty(payload, "required_extensions", s.RequiredExtensions)
+	return payload, nil
+}
+
+// tokenPayload returns the common token parameters of the roles
+// More info: https://www.vaultproject.io/docs/auth/token.html
+func tokenPayload(t api.TokenParams, policies []string) map[string]interface{} {
+	payload := map[string]interface{}{
+		"token_policies": policies,
+	}
+	setIfNotEmpty(payload, "token_ttl", t.TTL)
+	setIfNotEmpty(payload, "token_max_ttl", t.MaxTTL)
+	setIfNotEmpty(payload, "token_period", t.Period)
+	setIfNotEmpty(payload, "token_bound_cidrs", t.TokenBoundCIDRs)
+	return payload
+}
+
+// setIfNotEmpty sets the value in the payload, if it's not empty,
+// so that vault uses the default for the empty values
+func setIfNotEmpty(payload map[string]interface{}, key string, val interface{}) {
+	switch v := val.(type) {
+	case string:
+		if v == "" {
+			return
+		}
+	case []string:
+		if len(v) == 0 {
+			return
+		}
+	case map[string]string:
+		if len(v) == 0 {
+			return
+		}
+	case int64:
+		if v == 0 {
+			return
+		}
+	case bool:
+		if !v {
+			return
+		}
+	}
+	payload[key] = val
+}
@@ -0,0 +1,378 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package policybinding
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "kubevault.dev/operator/apis/policy/v1alpha1"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+func TestRoles(t *testing.T) {
+	policies := []string{"k8s.-.demo.read"}
+	bindSecretID := false
+
+	cases := []struct {
+		testName  string
+		subject   api.SubjectRef
+		expected  []authRole
+		expectErr bool
+	}{
+		{
+			testName: "approle subject, expect no error",
+			subject: api.SubjectRef{
+				AppRole: &api.AppRoleSubjectRef{
+					SecretIDNumUses: 10,
+					TokenParams: api.TokenParams{
+						TTL: "1h",
+					},
+				},
+			},
+			expected: []authRole{
+				{
+					path: "/v1/auth/approle/role/demo",
+					payload: map[string]interface{}{
+						"token_policies":     policies,
+						"token_ttl":          "1h",
+						"bind_secret_id":     true,
+						"secret_id_num_uses": int64(10),
+					},
+				},
+			},
+		},
+		{
+			testName: "approle subject without secret id and cidrs, expect error",
+			subject: api.SubjectRef{
+				AppRole: &api.AppRoleSubjectRef{
+					BindSecretID: &bindSecretID,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "jwt and oidc subjects, expect no error",
+			subject: api.SubjectRef{
+				JWT: &api.JWTSubjectRef{
+					Path:           "gitlab",
+					BoundAudiences: []string{"vault"},
+					BoundClaims:    map[string]string{"project_path": "demo/app"},
+				},
+				OIDC: &api.JWTSubjectRef{
+					AllowedRedirectURIs: []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback"},
+					GroupsClaim:         "groups",
+				},
+			},
+			expected: []authRole{
+				{
+					path: "/v1/auth/gitlab/role/demo",
+					payload: map[string]interface{}{
+						"token_policies":  policies,
+						"role_type":       "jwt",
+						"user_claim":      "sub",
+						"bound_audiences": []string{"vault"},
+						"bound_claims":    map[string]string{"project_path": "demo/app"},
+					},
+				},
+				{
+					path: "/v1/auth/oidc/role/demo",
+					payload: map[string]interface{}{
+						"token_policies":        policies,
+						"role_type":             "oidc",
+						"user_claim":            "sub",
+						"groups_claim":          "groups",
+						"allowed_redirect_uris": []string{"https://vault.example.com/ui/vault/auth/oidc/oidc/callback"},
+					},
+				},
+			},
+		},
+		{
+			testName: "oidc subject without redirect uris, expect error",
+			subject: api.SubjectRef{
+				OIDC: &api.JWTSubjectRef{},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "aws subject, expect no error",
+			subject: api.SubjectRef{
+				AWS: &api.AWSSubjectRef{
+					BoundIAMPrincipalARNs: []string{"arn:aws:iam::123456789012:role/demo"},
+				},
+			},
+			expected: []authRole{
+				{
+					path: "/v1/auth/aws/role/demo",
+					payload: map[string]interface{}{
+						"token_policies":          policies,
+						"auth_type":               "iam",
+						"bound_iam_principal_arn": []string{"arn:aws:iam::123456789012:role/demo"},
+					},
+				},
+			},
+		},
+		{
+			testName: "aws ec2 subject without bound parameter, expect error",
+			subject: api.SubjectRef{
+				AWS: &api.AWSSubjectRef{
+					AuthType: api.AWSAuthTypeEC2,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "gcp subject, expect no error",
+			subject: api.SubjectRef{
+				GCP: &api.GCPSubjectRef{
+					BoundServiceAccounts: []string{"demo@project.iam.gserviceaccount.com"},
+				},
+			},
+			expected: []authRole{
+				{
+					path: "/v1/auth/gcp/role/demo",
+					payload: map[string]interface{}{
+						"token_policies":         policies,
+						"type":                   "iam",
+						"bound_service_accounts": []string{"demo@project.iam.gserviceaccount.com"},
+					},
+				},
+			},
+		},
+		{
+			testName: "azure subject, expect no error",
+			subject: api.SubjectRef{
+				Azure: &api.AzureSubjectRef{
+					BoundResourceGroups: []string{"demo"},
+				},
+			},
+			expected: []authRole{
+				{
+					path: "/v1/auth/azure/role/demo",
+					payload: map[string]interface{}{
+						"token_policies":        policies,
+						"bound_resource_groups": []string{"demo"},
+					},
+				},
+			},
+		},
+		{
+			testName: "cert subject without certificate, expect error",
+			subject: api.SubjectRef{
+				Cert: &api.CertSubjectRef{},
+			},
+			expectErr: true,
+		},
+		{
+			testName: "userpass and ldap subjects, expect no error",
+			subject: api.SubjectRef{
+				UserPass: &api.UserPassSubjectRef{
+					Usernames: []string{"alice"},
+				},
+				LDAP: &api.LDAPSubjectRef{
+					Groups: []string{"admins"},
+					Users:  []string{"bob"},
+				},
+			},
+			expected: []authRole{
+				{
+					path:        "/v1/auth/userpass/users/alice/policies",
+					readPath:    "/v1/auth/userpass/users/alice",
+					policiesKey: "token_policies",
+				},
+				{
+					path:        "/v1/auth/ldap/groups/admins",
+					readPath:    "/v1/auth/ldap/groups/admins",
+					policiesKey: "policies",
+				},
+				{
+					path:        "/v1/auth/ldap/users/bob",
+					readPath:    "/v1/auth/ldap/users/bob",
+					policiesKey: "policies",
+					keepKeys:    []string{"groups"},
+				},
+			},
+		},
+		{
+			testName:  "no subject, expect error",
+			subject:   api.SubjectRef{},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			c.subject.SetDefaults()
+			p := &pBinding{
+				policies: policies,
+				subject:  c.subject,
+			}
+			roles, err := p.roles("demo")
+			if c.expectErr {
+				assert.NotNil(t, err)
+			} else {
+				if assert.Nil(t, err) {
+					assert.Equal(t, c.expected, roles)
+				}
+			}
+		})
+	}
+}
+
+func TestSharedRolePayload(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/v1/auth/ldap/users/bob", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"groups":["admins"],"policies":["default","k8s.-.demo.old","k8s.-.demo.read"]}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodGet)
+	router.HandleFunc("/v1/auth/ldap/users/alice", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}).Methods(http.MethodGet)
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	vc, err := vaultClient(srv.URL, "root")
+	if !assert.Nil(t, err, "failed to create vault client") {
+		return
+	}
+	p := &pBinding{
+		vClient:        vc,
+		policies:       []string{"k8s.-.demo.read", "k8s.-.demo.write"},
+		sharedPolicies: []string{"k8s.-.demo.old", "k8s.-.demo.read"},
+	}
+	other := &pBinding{
+		vClient: vc,
+		otherGrants: map[string][]string{
+			"/v1/auth/ldap/users/bob": {"k8s.-.demo.read"},
+		},
+	}
+	bob := authRole{
+		readPath:    "/v1/auth/ldap/users/bob",
+		policiesKey: "policies",
+		keepKeys:    []string{"groups"},
+	}
+	alice := authRole{
+		readPath:    "/v1/auth/ldap/users/alice",
+		policiesKey: "policies",
+		keepKeys:    []string{"groups"},
+	}
+
+	cases := []struct {
+		testName string
+		binding  *pBinding
+		role     authRole
+		grant    []string
+		revoke   []string
+		expected map[string]interface{}
+	}{
+		{
+			testName: "grant, expect policies merged and previous policies revoked",
+			binding:  p,
+			role:     bob,
+			grant:    p.policies,
+			revoke:   p.sharedPolicies,
+			expected: map[string]interface{}{
+				"policies": []string{"default", "k8s.-.demo.read", "k8s.-.demo.write"},
+				"groups":   []interface{}{"admins"},
+			},
+		},
+		{
+			testName: "revoke, expect other policies kept",
+			binding:  p,
+			role:     bob,
+			revoke:   p.sharedPolicies,
+			expected: map[string]interface{}{
+				"policies": []string{"default"},
+				"groups":   []interface{}{"admins"},
+			},
+		},
+		{
+			testName: "grant to not found role, expect policies of binding",
+			binding:  p,
+			role:     alice,
+			grant:    p.policies,
+			revoke:   p.sharedPolicies,
+			expected: map[string]interface{}{
+				"policies": []string{"k8s.-.demo.read", "k8s.-.demo.write"},
+			},
+		},
+		{
+			testName: "revoke from not found role, expect nothing to write",
+			binding:  p,
+			role:     alice,
+			revoke:   p.sharedPolicies,
+			expected: nil,
+		},
+		{
+			testName: "revoke policy granted by other binding, expect it kept",
+			binding:  other,
+			role:     bob,
+			revoke:   []string{"k8s.-.demo.old", "k8s.-.demo.read"},
+			expected: map[string]interface{}{
+				"policies": []string{"default", "k8s.-.demo.read"},
+				"groups":   []interface{}{"admins"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.testName, func(t *testing.T) {
+			payload, err := c.binding.sharedRolePayload(c.role, c.grant, c.revoke)
+			if assert.Nil(t, err) {
+				assert.Equal(t, c.expected, payload)
+			}
+		})
+	}
+}
+
+func TestStaleSharedRoles(t *testing.T) {
+	p := &pBinding{
+		sharedSubjects: &api.SharedSubjectRef{
+			UserPass: &api.UserPassSubjectRef{
+				Path:      "userpass",
+				Usernames: []string{"alice", "bob"},
+			},
+			LDAP: &api.LDAPSubjectRef{
+				Path:   "ldap",
+				Groups: []string{"admins"},
+			},
+		},
+	}
+	roles := sharedRoles(&api.SharedSubjectRef{
+		UserPass: &api.UserPassSubjectRef{
+			Path:      "userpass",
+			Usernames: []string{"bob"},
+		},
+	})
+
+	expected := []authRole{
+		{
+			path:        "/v1/auth/userpass/users/alice/policies",
+			readPath:    "/v1/auth/userpass/users/alice",
+			policiesKey: "token_policies",
+		},
+		{
+			path:        "/v1/auth/ldap/groups/admins",
+			readPath:    "/v1/auth/ldap/groups/admins",
+			policiesKey: "policies",
+		},
+	}
+	assert.Equal(t, expected, p.staleSharedRoles(roles))
+}
@@ -0,0 +1,215 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approle
+
+import (
+	"encoding/json"
+	"fmt"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+type AppRole struct {
+	appRole     *api.VaultAppRole
+	vaultClient *vaultapi.Client
+	kubeClient  kubernetes.Interface
+	appRolePath string // Specifies the path where approle auth is enabled
+}
+
+// Links:
+// - https://www.vaultproject.io/api/auth/approle/index.html#create-update-approle
+// Creates approle
+func (a *AppRole) CreateRole() error {
+	if a.vaultClient == nil {
+		return errors.New("vault client is nil")
+	}
+	if a.appRole == nil {
+		return errors.New("VaultAppRole is nil")
+	}
+	if a.appRolePath == "" {
+		return errors.New("approle auth path is empty")
+	}
+
+	payload, err := rolePayload(a.appRole.Spec)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1/auth/%s/role/%s", a.appRolePath, a.appRole.RoleName())
+	req := a.vaultClient.NewRequest("POST", path)
+	if err := req.SetJSONBody(payload); err != nil {
+		return errors.Wrap(err, "failed to load payload in approle create role request")
+	}
+
+	_, err = a.vaultClient.RawRequest(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to create approle")
+	}
+	return nil
+}
+
+// DeleteRole deletes role
+// It's safe to call multiple time. It doesn't give
+// error even if respective role doesn't exist
+func (a *AppRole) DeleteRole(name string) error {
+	path := fmt.Sprintf("/v1/auth/%s/role/%s", a.appRolePath, name)
+	req := a.vaultClient.NewRequest("DELETE", path)
+
+	_, err := a.vaultClient.RawRequest(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete approle %s", name)
+	}
+	return nil
+}
+
+// Links:
+// - https://www.vaultproject.io/api/auth/approle/index.html#read-approle-role-id
+func (a *AppRole) ReadRoleID() (string, error) {
+	path := fmt.Sprintf("/v1/auth/%s/role/%s/role-id", a.appRolePath, a.appRole.RoleName())
+	req := a.vaultClient.NewRequest("GET", path)
+
+	resp, err := a.vaultClient.RawRequest(req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read role_id")
+	}
+	defer resp.Body.Close()
+
+	secret, err := vaultapi.ParseSecret(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse role_id response")
+	}
+	if secret == nil || secret.Data == nil {
+		return "", errors.New("role_id response is empty")
+	}
+	roleID, ok := secret.Data["role_id"].(string)
+	if !ok || roleID == "" {
+		return "", errors.New("role_id is not found")
+	}
+	return roleID, nil
+}
+
+// GenerateSecretID generates a new secret_id. If wrapTTL is set,
+// the response is wrapped and only the wrapping token is returned.
+// Links:
+// - https://www.vaultproject.io/api/auth/approle/index.html#generate-new-secret-id
+// - https://www.vaultproject.io/docs/concepts/response-wrapping.html
+func (a *AppRole) GenerateSecretID(spec api.AppRoleSecretIDRequestSpec) (*vaultapi.Secret, error) {
+	path := fmt.Sprintf("/v1/auth/%s/role/%s/secret-id", a.appRolePath, a.appRole.RoleName())
+	req := a.vaultClient.NewRequest("POST", path)
+	req.WrapTTL = spec.WrapTTL
+	payload, err := secretIDPayload(spec)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.SetJSONBody(payload); err != nil {
+		return nil, errors.Wrap(err, "failed to load payload in secret_id request")
+	}
+
+	resp, err := a.vaultClient.RawRequest(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate secret_id")
+	}
+	defer resp.Body.Close()
+
+	secret, err := vaultapi.ParseSecret(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse secret_id response")
+	}
+	if secret == nil {
+		return nil, errors.New("secret_id response is empty")
+	}
+	return secret, nil
+}
+
+// DestroySecretIDAccessor destroys the secret_id of the accessor
+// Links:
+// - https://www.vaultproject.io/api/auth/approle/index.html#destroy-approle-secret-id-accessor
+func (a *AppRole) DestroySecretIDAccessor(accessor string) error {
+	path := fmt.Sprintf("/v1/auth/%s/role/%s/secret-id-accessor/destroy", a.appRolePath, a.appRole.RoleName())
+	req := a.vaultClient.NewRequest("POST", path)
+	if err := req.SetJSONBody(map[string]interface{}{
+		"secret_id_accessor": accessor,
+	}); err != nil {
+		return errors.Wrap(err, "failed to load payload in destroy secret_id request")
+	}
+
+	_, err := a.vaultClient.RawRequest(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to destroy secret_id")
+	}
+	return nil
+}
+
+func rolePayload(spec api.VaultAppRoleSpec) (map[string]interface{}, error) {
+	payload := map[string]interface{}{
+		"token_policies": spec.Policies,
+	}
+	if spec.BindSecretID != nil {
+		if !*spec.BindSecretID && len(spec.SecretIDBoundCIDRs) == 0 && len(spec.TokenBoundCIDRs) == 0 {
+			return nil, errors.New("one of secretIDBoundCIDRs or tokenBoundCIDRs must be non empty, if bindSecretID is false")
+		}
+		payload["bind_secret_id"] = *spec.BindSecretID
+	}
+	if len(spec.SecretIDBoundCIDRs) > 0 {
+		payload["secret_id_bound_cidrs"] = spec.SecretIDBoundCIDRs
+	}
+	if spec.SecretIDNumUses > 0 {
+		payload["secret_id_num_uses"] = spec.SecretIDNumUses
+	}
+	if spec.SecretIDTTL != "" {
+		payload["secret_id_ttl"] = spec.SecretIDTTL
+	}
+	if len(spec.TokenBoundCIDRs) > 0 {
+		payload["token_bound_cidrs"] = spec.TokenBoundCIDRs
+	}
+	if spec.TokenTTL != "" {
+		payload["token_ttl"] = spec.TokenTTL
+	}
+	if spec.TokenMaxTTL != "" {
+		payload["token_max_ttl"] = spec.TokenMaxTTL
+	}
+	if spec.TokenNumUses > 0 {
+		payload["token_num_uses"] = spec.TokenNumUses
+	}
+	if spec.TokenPeriod != "" {
+		payload["token_period"] = spec.TokenPeriod
+	}
+	return payload, nil
+}
+
+func secretIDPayload(spec api.AppRoleSecretIDRequestSpec) (map[string]interface{}, error) {
+	payload := map[string]interface{}{}
+	if len(spec.Metadata) > 0 {
+		// vault expects metadata as a JSON-formatted string
+		data, err := json.Marshal(spec.Metadata)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal secret_id metadata")
+		}
+		payload["metadata"] = string(data)
+	}
+	if len(spec.CIDRList) > 0 {
+		payload["cidr_list"] = spec.CIDRList
+	}
+	if len(spec.TokenBoundCIDRs) > 0 {
+		payload["token_bound_cidrs"] = spec.TokenBoundCIDRs
+	}
+	return payload, nil
+}
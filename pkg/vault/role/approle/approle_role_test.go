@@ -0,0 +1,192 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approle
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	"github.com/gorilla/mux"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+func setupVaultServer() *httptest.Server {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/v1/auth/approle/role/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost, http.MethodDelete)
+
+	router.HandleFunc("/v1/auth/approle/role/k8s.-.demo.my-role/role-id", func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"data":{"role_id":"e5a7b66e-5d08-da9c-7075-71984634b882"}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodGet)
+
+	router.HandleFunc("/v1/auth/approle/role/k8s.-.demo.my-role/secret-id", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var data map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := data["metadata"].(string); !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte("metadata must be a string"))
+			utilruntime.Must(err)
+			return
+		}
+		if r.Header.Get("X-Vault-Wrap-TTL") != "" {
+			_, err := w.Write([]byte(`{"wrap_info":{"token":"s.wrapped","ttl":600,"wrapped_accessor":"84896a0c-1347-aa90-a4f6-aca8b7558780"}}`))
+			utilruntime.Must(err)
+			return
+		}
+		_, err := w.Write([]byte(`{"data":{"secret_id":"841771dc-11c9-bbc7-bcac-6a3945a69cd9","secret_id_accessor":"84896a0c-1347-aa90-a4f6-aca8b7558780"}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/auth/approle/role/k8s.-.demo.my-role/secret-id-accessor/destroy", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPost)
+
+	return httptest.NewServer(router)
+}
+
+func newDemoAppRole(t *testing.T, addr string, bindSecretID *bool) *AppRole {
+	cfg := vaultapi.DefaultConfig()
+	cfg.Address = addr
+	cl, err := vaultapi.NewClient(cfg)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	return &AppRole{
+		appRole: &api.VaultAppRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-role",
+				Namespace: "demo",
+			},
+			Spec: api.VaultAppRoleSpec{
+				Policies:     []string{"read"},
+				BindSecretID: bindSecretID,
+			},
+		},
+		vaultClient: cl,
+		appRolePath: DefaultAppRolePath,
+	}
+}
+
+func TestAppRole_CreateRole(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	falseVar := false
+	testData := []struct {
+		testName     string
+		bindSecretID *bool
+		expectedErr  bool
+	}{
+		{
+			testName:    "Create Role Successful",
+			expectedErr: false,
+		},
+		{
+			testName:     "Create Role Failed, no secret_id and no bound cidrs",
+			bindSecretID: &falseVar,
+			expectedErr:  true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			err := newDemoAppRole(t, srv.URL, test.bindSecretID).CreateRole()
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestAppRole_ReadRoleID(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	roleID, err := newDemoAppRole(t, srv.URL, nil).ReadRoleID()
+	if assert.Nil(t, err) {
+		assert.Equal(t, "e5a7b66e-5d08-da9c-7075-71984634b882", roleID)
+	}
+}
+
+func TestAppRole_GenerateSecretID(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	testData := []struct {
+		testName         string
+		spec             api.AppRoleSecretIDRequestSpec
+		expectedWrapped  bool
+		expectedAccessor string
+	}{
+		{
+			testName: "Generate secret_id",
+			spec: api.AppRoleSecretIDRequestSpec{
+				Metadata: map[string]string{"app": "demo"},
+			},
+			expectedAccessor: "84896a0c-1347-aa90-a4f6-aca8b7558780",
+		},
+		{
+			testName: "Generate response-wrapped secret_id",
+			spec: api.AppRoleSecretIDRequestSpec{
+				Metadata: map[string]string{"app": "demo"},
+				WrapTTL:  "10m",
+			},
+			expectedWrapped:  true,
+			expectedAccessor: "84896a0c-1347-aa90-a4f6-aca8b7558780",
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			secret, err := newDemoAppRole(t, srv.URL, nil).GenerateSecretID(test.spec)
+			if !assert.Nil(t, err) {
+				return
+			}
+			if test.expectedWrapped {
+				if assert.NotNil(t, secret.WrapInfo) {
+					assert.Equal(t, test.expectedAccessor, secret.WrapInfo.WrappedAccessor)
+				}
+			} else {
+				assert.Equal(t, test.expectedAccessor, secret.Data["secret_id_accessor"])
+			}
+		})
+	}
+}
+
+func TestAppRole_DestroySecretIDAccessor(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	err := newDemoAppRole(t, srv.URL, nil).DestroySecretIDAccessor("84896a0c-1347-aa90-a4f6-aca8b7558780")
+	assert.Nil(t, err)
+}
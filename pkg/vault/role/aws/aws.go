@@ -16,6 +16,8 @@ limitations under the License.
 package aws
 
 import (
+	"fmt"
+
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	"kubevault.dev/operator/pkg/vault"
 	"kubevault.dev/operator/pkg/vault/role"
@@ -30,6 +32,7 @@ const DefaultAWSPath = "aws"
 
 type AWSRoleInterface interface {
 	role.RoleInterface
+	role.LeaseInterface
 
 	// DeleteRole deletes role
 	DeleteRole(name string) error
@@ -67,3 +70,11 @@ func GetAWSPath(role *api.AWSRole) (string, error) {
 	}
 	return DefaultAWSPath, nil
 }
+
+// LeasePrefixes returns the prefixes of the leases issued against the role
+func LeasePrefixes(path, roleName string) []string {
+	return []string{
+		fmt.Sprintf("%s/creds/%s", path, roleName),
+		fmt.Sprintf("%s/sts/%s", path, roleName),
+	}
+}
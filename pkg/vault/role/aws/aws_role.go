@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/lease"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
@@ -101,3 +102,28 @@ func (a *AWSRole) DeleteRole(name string) error {
 	}
 	return nil
 }
+
+// HasLeases checks whether any lease issued against the aws role exists
+func (a *AWSRole) HasLeases(name string) (bool, error) {
+	for _, p := range LeasePrefixes(a.awsPath, name) {
+		ok, err := lease.HasLeases(a.vaultClient, p)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to lookup leases of aws role %s", name)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RevokeLeases revokes all leases issued against the aws role
+func (a *AWSRole) RevokeLeases(name string) error {
+	for _, p := range LeasePrefixes(a.awsPath, name) {
+		err := lease.RevokePrefix(a.vaultClient, p)
+		if err != nil {
+			return errors.Wrapf(err, "failed to revoke leases of aws role %s", name)
+		}
+	}
+	return nil
+}
@@ -16,6 +16,8 @@ limitations under the License.
 package azure
 
 import (
+	"fmt"
+
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	"kubevault.dev/operator/pkg/vault"
 	"kubevault.dev/operator/pkg/vault/role"
@@ -30,6 +32,7 @@ const DefaultAzurePath = "azure"
 
 type AzureRoleInterface interface {
 	role.RoleInterface
+	role.LeaseInterface
 
 	// DeleteRole deletes role
 	DeleteRole(name string) error
@@ -65,3 +68,10 @@ func GetAzurePath(role *api.AzureRole) (string, error) {
 	}
 	return DefaultAzurePath, nil
 }
+
+// LeasePrefixes returns the prefixes of the leases issued against the role
+func LeasePrefixes(path, roleName string) []string {
+	return []string{
+		fmt.Sprintf("%s/creds/%s", path, roleName),
+	}
+}
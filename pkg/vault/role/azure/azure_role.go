@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/lease"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
@@ -57,10 +58,18 @@ func (a *AzureRole) CreateRole() error {
 		payload["azure_roles"] = roleSpec.AzureRoles
 	}
 
+	if roleSpec.AzureGroups != "" {
+		payload["azure_groups"] = roleSpec.AzureGroups
+	}
+
 	if roleSpec.ApplicationObjectID != "" {
 		payload["application_object_id"] = roleSpec.ApplicationObjectID
 	}
 
+	if roleSpec.PermanentlyDelete != nil {
+		payload["permanently_delete"] = *roleSpec.PermanentlyDelete
+	}
+
 	if roleSpec.TTL != "" {
 		payload["ttl"] = roleSpec.TTL
 	}
@@ -93,3 +102,28 @@ func (a *AzureRole) DeleteRole(name string) error {
 	}
 	return nil
 }
+
+// HasLeases checks whether any lease issued against the azure role exists
+func (a *AzureRole) HasLeases(name string) (bool, error) {
+	for _, p := range LeasePrefixes(a.azurePath, name) {
+		ok, err := lease.HasLeases(a.vaultClient, p)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to lookup leases of azure role %s", name)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RevokeLeases revokes all leases issued against the azure role
+func (a *AzureRole) RevokeLeases(name string) error {
+	for _, p := range LeasePrefixes(a.azurePath, name) {
+		err := lease.RevokePrefix(a.vaultClient, p)
+		if err != nil {
+			return errors.Wrapf(err, "failed to revoke leases of azure role %s", name)
+		}
+	}
+	return nil
+}
@@ -32,56 +32,54 @@ import (
 	kfake "k8s.io/client-go/kubernetes/fake"
 )
 
-func setupVaultServer() *httptest.Server {
-	router := mux.NewRouter()
+// createRoleHandler validates the payload of the create role request, as vault does
+func createRoleHandler(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	var data interface{}
+	err := json.NewDecoder(r.Body).Decode(&data)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte(err.Error()))
+		log.Println(err)
+		return
+	}
 
-	router.HandleFunc("/v1/azure/roles/k8s.-.demo.demo-role", func(w http.ResponseWriter, r *http.Request) {
-		defer r.Body.Close()
-		var data interface{}
-		err := json.NewDecoder(r.Body).Decode(&data)
-		if err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			_, err := w.Write([]byte(err.Error()))
-			log.Println(err)
-			return
-		} else {
-			m := data.(map[string]interface{})
-			value1, ok1 := m["azure_roles"]
-			value2, ok2 := m["application_object_id"]
-			if (!ok1 || len(value1.(string)) == 0) && (!ok2 || len(value2.(string)) == 0) {
+	m := data.(map[string]interface{})
+	value1, ok1 := m["azure_roles"]
+	value2, ok2 := m["application_object_id"]
+	value3, ok3 := m["azure_groups"]
+	if (!ok1 || len(value1.(string)) == 0) && (!ok2 || len(value2.(string)) == 0) && (!ok3 || len(value3.(string)) == 0) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte("azure_roles, azure_groups and application_object_id are missing"))
+		log.Println(err)
+		return
+	}
+	for _, key := range []string{"azure_roles", "azure_groups"} {
+		if v, ok := m[key]; ok {
+			var list []map[string]interface{}
+			if err := json.Unmarshal([]byte(v.(string)), &list); err != nil {
 				w.WriteHeader(http.StatusBadRequest)
-				_, err := w.Write([]byte("both azure_roles and application_object_id are missing"))
+				_, err := w.Write([]byte("error parsing " + key + ": " + err.Error()))
 				log.Println(err)
 				return
 			}
-			w.WriteHeader(http.StatusOK)
 		}
+	}
+	if v, ok := m["permanently_delete"]; ok && v.(bool) && ok2 {
+		w.WriteHeader(http.StatusBadRequest)
+		_, err := w.Write([]byte("permanently_delete must be false if application_object_id is provided"))
+		log.Println(err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
 
-	}).Methods(http.MethodPost)
+func setupVaultServer() *httptest.Server {
+	router := mux.NewRouter()
 
-	router.HandleFunc("/v1/my-azure-path/roles/k8s.-.demo.demo-role", func(w http.ResponseWriter, r *http.Request) {
-		defer r.Body.Close()
-		var data interface{}
-		err := json.NewDecoder(r.Body).Decode(&data)
-		if err != nil {
-			w.WriteHeader(http.StatusBadRequest)
-			_, err := w.Write([]byte(err.Error()))
-			log.Println(err)
-			return
-		} else {
-			m := data.(map[string]interface{})
-			value1, ok1 := m["azure_roles"]
-			value2, ok2 := m["application_object_id"]
-			if (!ok1 || len(value1.(string)) == 0) && (!ok2 || len(value2.(string)) == 0) {
-				w.WriteHeader(http.StatusBadRequest)
-				_, err := w.Write([]byte("both azure_roles and application_object_id are missing"))
-				log.Println(err)
-				return
-			}
-			w.WriteHeader(http.StatusOK)
-		}
+	router.HandleFunc("/v1/azure/roles/k8s.-.demo.demo-role", createRoleHandler).Methods(http.MethodPost)
 
-	}).Methods(http.MethodPost)
+	router.HandleFunc("/v1/my-azure-path/roles/k8s.-.demo.demo-role", createRoleHandler).Methods(http.MethodPost)
 
 	router.HandleFunc("/v1/azure/roles/k8s.-.demo.demo-role", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -91,6 +89,7 @@ func setupVaultServer() *httptest.Server {
 }
 
 func TestAzureRole_CreateRole(t *testing.T) {
+	trueVar, falseVar := true, false
 	srv := setupVaultServer()
 	defer srv.Close()
 
@@ -209,7 +208,85 @@ func TestAzureRole_CreateRole(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Unsuccessful Operation! Both AzureRoles and ApplicationObjectID missing!",
+			name: "Successful Operation! Group membership!",
+			fields: fields{
+				azureRole: &api.AzureRole{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "demo-role",
+						Namespace: "demo",
+					},
+					Spec: api.AzureRoleSpec{
+						AzureGroups:       `[{"group_name": "foo"}, {"group_name": "bar"}]`,
+						PermanentlyDelete: &trueVar,
+						TTL:               "1h",
+						MaxTTL:            "24h",
+					},
+				},
+				vaultClient: cl,
+				kubeClient:  fkube,
+				azurePath:   "azure",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Successful Operation! Existing service principal!",
+			fields: fields{
+				azureRole: &api.AzureRole{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "demo-role",
+						Namespace: "demo",
+					},
+					Spec: api.AzureRoleSpec{
+						ApplicationObjectID: "3454-435-435-34",
+						PermanentlyDelete:   &falseVar,
+						TTL:                 "1h",
+					},
+				},
+				vaultClient: cl,
+				kubeClient:  fkube,
+				azurePath:   "azure",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unsuccessful Operation! Existing service principal is permanently deleted!",
+			fields: fields{
+				azureRole: &api.AzureRole{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "demo-role",
+						Namespace: "demo",
+					},
+					Spec: api.AzureRoleSpec{
+						ApplicationObjectID: "3454-435-435-34",
+						PermanentlyDelete:   &trueVar,
+					},
+				},
+				vaultClient: cl,
+				kubeClient:  fkube,
+				azurePath:   "azure",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unsuccessful Operation! AzureGroups is not a JSON array!",
+			fields: fields{
+				azureRole: &api.AzureRole{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "demo-role",
+						Namespace: "demo",
+					},
+					Spec: api.AzureRoleSpec{
+						AzureGroups: "foo",
+					},
+				},
+				vaultClient: cl,
+				kubeClient:  fkube,
+				azurePath:   "azure",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unsuccessful Operation! AzureRoles, AzureGroups and ApplicationObjectID missing!",
 			fields: fields{
 				azureRole: &api.AzureRole{
 					ObjectMeta: v1.ObjectMeta{
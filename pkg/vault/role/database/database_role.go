@@ -20,6 +20,7 @@ import (
 
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	vault "kubevault.dev/operator/pkg/vault"
+	"kubevault.dev/operator/pkg/vault/lease"
 	"kubevault.dev/operator/pkg/vault/role"
 	"kubevault.dev/operator/pkg/vault/role/database/mongodb"
 	"kubevault.dev/operator/pkg/vault/role/database/mysql"
@@ -179,6 +180,31 @@ func (d *DatabaseRole) DeleteRole(name string) error {
 	return nil
 }
 
+// HasLeases checks whether any lease issued against the database role exists
+func (d *DatabaseRole) HasLeases(name string) (bool, error) {
+	for _, p := range LeasePrefixes(d.path, name) {
+		ok, err := lease.HasLeases(d.vaultClient, p)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to lookup leases of database role %s", name)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RevokeLeases revokes all leases issued against the database role
+func (d *DatabaseRole) RevokeLeases(name string) error {
+	for _, p := range LeasePrefixes(d.path, name) {
+		err := lease.RevokePrefix(d.vaultClient, p)
+		if err != nil {
+			return errors.Wrapf(err, "failed to revoke leases of database role %s", name)
+		}
+	}
+	return nil
+}
+
 // If database path does not exist, then use default database path
 func GetMySQLDatabasePath(role *api.MySQLRole) (string, error) {
 	if role.Spec.Path != "" {
@@ -202,3 +228,10 @@ func GetPostgresDatabasePath(role *api.PostgresRole) (string, error) {
 	}
 	return DefaultDatabasePath, nil
 }
+
+// LeasePrefixes returns the prefixes of the leases issued against the role
+func LeasePrefixes(path, roleName string) []string {
+	return []string{
+		fmt.Sprintf("%s/creds/%s", path, roleName),
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 type DatabaseRoleInterface interface {
 	role.RoleInterface
+	role.LeaseInterface
 
 	// EnableDatabase enables database secret engine
 	EnableDatabase() error
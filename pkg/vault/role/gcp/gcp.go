@@ -16,6 +16,8 @@ limitations under the License.
 package gcp
 
 import (
+	"fmt"
+
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
 	"kubevault.dev/operator/pkg/vault"
 	"kubevault.dev/operator/pkg/vault/role"
@@ -30,6 +32,7 @@ const DefaultGCPPath = "gcp"
 
 type GCPRoleInterface interface {
 	role.RoleInterface
+	role.LeaseInterface
 
 	// DeleteRole deletes role
 	DeleteRole(name string) error
@@ -64,3 +67,21 @@ func GetGCPPath(role *api.GCPRole) (string, error) {
 	}
 	return DefaultGCPPath, nil
 }
+
+// LeasePrefixes returns the prefixes of the leases issued against the role.
+// The access tokens of the static and impersonated accounts have no lease.
+func LeasePrefixes(path string, roleType api.GCPRoleType, roleName string) []string {
+	switch roleType {
+	case api.GCPRoleTypeStaticAccount:
+		return []string{
+			fmt.Sprintf("%s/static-account/%s/key", path, roleName),
+		}
+	case api.GCPRoleTypeImpersonatedAccount:
+		return nil
+	default:
+		return []string{
+			fmt.Sprintf("%s/key/%s", path, roleName),
+			fmt.Sprintf("%s/token/%s", path, roleName),
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	api "kubevault.dev/operator/apis/engine/v1alpha1"
+	"kubevault.dev/operator/pkg/vault/lease"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	"github.com/pkg/errors"
@@ -33,13 +34,16 @@ type GCPRole struct {
 }
 
 const (
-	GCPSecretType       string = "secret_type"
-	GCPOAuthTokenScopes string = "token_scopes"
+	GCPSecretType          string = "secret_type"
+	GCPOAuthTokenScopes    string = "token_scopes"
+	GCPServiceAccountEmail string = "service_account_email"
 )
 
 // Links:
 // - https://www.vaultproject.io/api/secret/gcp/index.html#create-update-roleset
-// Creates roleset
+// - https://www.vaultproject.io/api-docs/secret/gcp#create-update-static-account
+// - https://www.vaultproject.io/api-docs/secret/gcp#create-update-impersonated-account
+// Creates roleset, static account or impersonated account
 func (a *GCPRole) CreateRole() error {
 	if a.vaultClient == nil {
 		return errors.New("vault client is nil")
@@ -51,15 +55,31 @@ func (a *GCPRole) CreateRole() error {
 		return errors.New("gcp engine path is empty")
 	}
 
-	path := fmt.Sprintf("/v1/%s/roleset/%s", a.gcpPath, a.gcpRole.RoleName())
+	roleType := a.gcpRole.RoleType()
+	path := fmt.Sprintf("/v1/%s/%s/%s", a.gcpPath, roleType, a.gcpRole.RoleName())
 	req := a.vaultClient.NewRequest("POST", path)
 
 	roleSpec := a.gcpRole.Spec
-	payload := map[string]interface{}{
-		"project":  roleSpec.Project,
-		"bindings": roleSpec.Bindings,
+	payload := map[string]interface{}{}
+	switch roleType {
+	case api.GCPRoleTypeRoleset:
+		payload["project"] = roleSpec.Project
+		payload["bindings"] = roleSpec.Bindings
+	case api.GCPRoleTypeStaticAccount:
+		payload[GCPServiceAccountEmail] = roleSpec.ServiceAccountEmail
+		if roleSpec.Bindings != "" {
+			payload["bindings"] = roleSpec.Bindings
+		}
+	case api.GCPRoleTypeImpersonatedAccount:
+		payload[GCPServiceAccountEmail] = roleSpec.ServiceAccountEmail
+		if roleSpec.TTL != "" {
+			payload["ttl"] = roleSpec.TTL
+		}
+	default:
+		return errors.Errorf("unknown gcp role type %s", roleType)
 	}
-	if roleSpec.SecretType != "" {
+	// impersonated accounts issue only access tokens
+	if roleSpec.SecretType != "" && roleType != api.GCPRoleTypeImpersonatedAccount {
 		payload[GCPSecretType] = roleSpec.SecretType
 	}
 
@@ -82,7 +102,7 @@ func (a *GCPRole) CreateRole() error {
 // It's safe to call multiple time. It doesn't give
 // error even if respective role doesn't exist
 func (a *GCPRole) DeleteRole(name string) error {
-	path := fmt.Sprintf("/v1/%s/roleset/%s", a.gcpPath, name)
+	path := fmt.Sprintf("/v1/%s/%s/%s", a.gcpPath, a.gcpRole.RoleType(), name)
 	req := a.vaultClient.NewRequest("DELETE", path)
 
 	_, err := a.vaultClient.RawRequest(req)
@@ -91,3 +111,28 @@ func (a *GCPRole) DeleteRole(name string) error {
 	}
 	return nil
 }
+
+// HasLeases checks whether any lease issued against the gcp role exists
+func (a *GCPRole) HasLeases(name string) (bool, error) {
+	for _, p := range LeasePrefixes(a.gcpPath, a.gcpRole.RoleType(), name) {
+		ok, err := lease.HasLeases(a.vaultClient, p)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to lookup leases of gcp role %s", name)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RevokeLeases revokes all leases issued against the gcp role
+func (a *GCPRole) RevokeLeases(name string) error {
+	for _, p := range LeasePrefixes(a.gcpPath, a.gcpRole.RoleType(), name) {
+		err := lease.RevokePrefix(a.vaultClient, p)
+		if err != nil {
+			return errors.Wrapf(err, "failed to revoke leases of gcp role %s", name)
+		}
+	}
+	return nil
+}
@@ -144,6 +144,48 @@ func CreateDemoDB() ([]GCPRole, *httptest.Server) {
 			kubeClient:  kubeClient,
 			gcpPath:     "",
 		},
+		{
+			gcpRole: &api.GCPRole{
+				TypeMeta: metav1.TypeMeta{},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-role",
+					Namespace: "demo",
+				},
+				Spec: api.GCPRoleSpec{
+					VaultRef: core.LocalObjectReference{
+						Name: "vault-app",
+					},
+					Type:                api.GCPRoleTypeStaticAccount,
+					SecretType:          "service_account_key",
+					ServiceAccountEmail: "app@ackube.iam.gserviceaccount.com",
+				},
+			},
+			vaultClient: cl,
+			kubeClient:  kubeClient,
+			gcpPath:     "gcp",
+		},
+		{
+			gcpRole: &api.GCPRole{
+				TypeMeta: metav1.TypeMeta{},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-role",
+					Namespace: "demo",
+				},
+				Spec: api.GCPRoleSpec{
+					VaultRef: core.LocalObjectReference{
+						Name: "vault-app",
+					},
+					Type:                api.GCPRoleTypeImpersonatedAccount,
+					SecretType:          "access_token",
+					ServiceAccountEmail: "app@ackube.iam.gserviceaccount.com",
+					TokenScopes:         []string{"https://www.googleapis.com/auth/cloud-platform"},
+					TTL:                 "1h",
+				},
+			},
+			vaultClient: cl,
+			kubeClient:  kubeClient,
+			gcpPath:     "gcp",
+		},
 	}
 
 	return DB, srv
@@ -199,6 +241,60 @@ func setupVaultServer() *httptest.Server {
 		w.WriteHeader(http.StatusOK)
 	}).Methods(http.MethodDelete)
 
+	router.HandleFunc("/v1/gcp/static-account/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var m map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&m)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if v, ok := m["service_account_email"]; !ok || len(v.(string)) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if v, ok := m["secret_type"]; !ok || len(v.(string)) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := m["project"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/gcp/static-account/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodDelete)
+
+	router.HandleFunc("/v1/gcp/impersonated-account/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var m map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&m)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if v, ok := m["service_account_email"]; !ok || len(v.(string)) == 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := m["secret_type"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if v, ok := m["ttl"]; !ok || v != "1h" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/gcp/impersonated-account/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodDelete)
+
 	return httptest.NewServer(router)
 }
 
@@ -231,6 +327,16 @@ func TestGCPRole_CreateRole(t *testing.T) {
 			gcpRole:     &demoRole[3],
 			expectedErr: true,
 		},
+		{
+			testName:    "Create Static Account Successful",
+			gcpRole:     &demoRole[4],
+			expectedErr: false,
+		},
+		{
+			testName:    "Create Impersonated Account Successful",
+			gcpRole:     &demoRole[5],
+			expectedErr: false,
+		},
 	}
 
 	for _, test := range testData {
@@ -260,6 +366,16 @@ func TestGCPRole_DeleteRole(t *testing.T) {
 			gcpRole:     &demoRole[0],
 			expectedErr: false,
 		},
+		{
+			testName:    "Delete Static Account Successful",
+			gcpRole:     &demoRole[4],
+			expectedErr: false,
+		},
+		{
+			testName:    "Delete Impersonated Account Successful",
+			gcpRole:     &demoRole[5],
+			expectedErr: false,
+		},
 	}
 
 	for _, test := range testData {
@@ -275,3 +391,9 @@ func TestGCPRole_DeleteRole(t *testing.T) {
 	}
 
 }
+
+func TestLeasePrefixes(t *testing.T) {
+	assert.Equal(t, []string{"gcp/key/my-role", "gcp/token/my-role"}, LeasePrefixes("gcp", api.GCPRoleTypeRoleset, "my-role"))
+	assert.Equal(t, []string{"gcp/static-account/my-role/key"}, LeasePrefixes("gcp", api.GCPRoleTypeStaticAccount, "my-role"))
+	assert.Nil(t, LeasePrefixes("gcp", api.GCPRoleTypeImpersonatedAccount, "my-role"))
+}
@@ -19,3 +19,11 @@ type RoleInterface interface {
 	// CreateRole creates role
 	CreateRole() error
 }
+
+type LeaseInterface interface {
+	// HasLeases checks whether any lease issued against the role exists
+	HasLeases(name string) (bool, error)
+
+	// RevokeLeases revokes all leases issued against the role
+	RevokeLeases(name string) error
+}
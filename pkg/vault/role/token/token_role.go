@@ -0,0 +1,187 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package token
+
+import (
+	"fmt"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+type TokenRole struct {
+	tokenRole   *api.VaultTokenRole
+	vaultClient *vaultapi.Client
+	kubeClient  kubernetes.Interface
+}
+
+// Links:
+// - https://www.vaultproject.io/api/auth/token/index.html#create-update-token-role
+// Creates token role
+func (t *TokenRole) CreateRole() error {
+	if t.vaultClient == nil {
+		return errors.New("vault client is nil")
+	}
+	if t.tokenRole == nil {
+		return errors.New("VaultTokenRole is nil")
+	}
+
+	path := fmt.Sprintf("/v1/auth/token/roles/%s", t.tokenRole.RoleName())
+	req := t.vaultClient.NewRequest("POST", path)
+	if err := req.SetJSONBody(rolePayload(t.tokenRole.Spec)); err != nil {
+		return errors.Wrap(err, "failed to load payload in token role create request")
+	}
+
+	_, err := t.vaultClient.RawRequest(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to create token role")
+	}
+	return nil
+}
+
+// DeleteRole deletes role
+// It's safe to call multiple time. It doesn't give
+// error even if respective role doesn't exist
+func (t *TokenRole) DeleteRole(name string) error {
+	path := fmt.Sprintf("/v1/auth/token/roles/%s", name)
+	req := t.vaultClient.NewRequest("DELETE", path)
+
+	_, err := t.vaultClient.RawRequest(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete token role %s", name)
+	}
+	return nil
+}
+
+// CreateToken creates a new token against the role
+// Links:
+// - https://www.vaultproject.io/api/auth/token/index.html#create-token
+func (t *TokenRole) CreateToken(spec api.VaultTokenRequestSpec) (*vaultapi.Secret, error) {
+	path := fmt.Sprintf("/v1/auth/token/create/%s", t.tokenRole.RoleName())
+	req := t.vaultClient.NewRequest("POST", path)
+	if err := req.SetJSONBody(tokenPayload(spec)); err != nil {
+		return nil, errors.Wrap(err, "failed to load payload in token create request")
+	}
+
+	secret, err := t.parseAuthResponse(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create token")
+	}
+	return secret, nil
+}
+
+// RenewToken renews the token
+// Links:
+// - https://www.vaultproject.io/api/auth/token/index.html#renew-a-token
+func (t *TokenRole) RenewToken(token string) (*vaultapi.Secret, error) {
+	req := t.vaultClient.NewRequest("POST", "/v1/auth/token/renew")
+	if err := req.SetJSONBody(map[string]interface{}{
+		"token": token,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to load payload in token renew request")
+	}
+
+	secret, err := t.parseAuthResponse(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to renew token")
+	}
+	return secret, nil
+}
+
+// RevokeTokenAccessor revokes the token of the accessor and its child tokens
+// Links:
+// - https://www.vaultproject.io/api/auth/token/index.html#revoke-a-token-accessor
+func (t *TokenRole) RevokeTokenAccessor(accessor string) error {
+	req := t.vaultClient.NewRequest("POST", "/v1/auth/token/revoke-accessor")
+	if err := req.SetJSONBody(map[string]interface{}{
+		"accessor": accessor,
+	}); err != nil {
+		return errors.Wrap(err, "failed to load payload in token revoke request")
+	}
+
+	_, err := t.vaultClient.RawRequest(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke token")
+	}
+	return nil
+}
+
+// parseAuthResponse sends the request and returns the response,
+// it returns error if the response doesn't contain the auth info
+func (t *TokenRole) parseAuthResponse(req *vaultapi.Request) (*vaultapi.Secret, error) {
+	resp, err := t.vaultClient.RawRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	secret, err := vaultapi.ParseSecret(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return nil, errors.New("token is not found in response")
+	}
+	return secret, nil
+}
+
+func rolePayload(spec api.VaultTokenRoleSpec) map[string]interface{} {
+	payload := map[string]interface{}{
+		"allowed_policies":    spec.AllowedPolicies,
+		"disallowed_policies": spec.DisallowedPolicies,
+		// the parent of the tokens would be the short lived token of the operator
+		"orphan": true,
+	}
+	if spec.Renewable != nil {
+		payload["renewable"] = *spec.Renewable
+	}
+	if spec.Period != "" {
+		payload["token_period"] = spec.Period
+	}
+	if spec.ExplicitMaxTTL != "" {
+		payload["token_explicit_max_ttl"] = spec.ExplicitMaxTTL
+	}
+	if spec.PathSuffix != "" {
+		payload["path_suffix"] = spec.PathSuffix
+	}
+	if len(spec.TokenBoundCIDRs) > 0 {
+		payload["token_bound_cidrs"] = spec.TokenBoundCIDRs
+	}
+	if spec.TokenType != "" {
+		payload["token_type"] = spec.TokenType
+	}
+	return payload
+}
+
+func tokenPayload(spec api.VaultTokenRequestSpec) map[string]interface{} {
+	payload := map[string]interface{}{}
+	if len(spec.Policies) > 0 {
+		payload["policies"] = spec.Policies
+	}
+	if len(spec.Metadata) > 0 {
+		payload["meta"] = spec.Metadata
+	}
+	if spec.TTL != "" {
+		payload["ttl"] = spec.TTL
+	}
+	if spec.NumUses > 0 {
+		payload["num_uses"] = spec.NumUses
+	}
+	return payload
+}
@@ -0,0 +1,221 @@
+/*
+Copyright The KubeVault Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package token
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "kubevault.dev/operator/apis/engine/v1alpha1"
+
+	"github.com/gorilla/mux"
+	vaultapi "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+func setupVaultServer() *httptest.Server {
+	router := mux.NewRouter()
+
+	router.HandleFunc("/v1/auth/token/roles/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var data map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil || data["orphan"] != true {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"errors":["tokens of the role must be orphan"]}`))
+			utilruntime.Must(err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPost)
+	router.HandleFunc("/v1/auth/token/roles/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodDelete)
+
+	router.HandleFunc("/v1/auth/token/create/k8s.-.demo.my-role", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var data map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if _, ok := data["policies"]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, err := w.Write([]byte(`{"errors":["token policies are not allowed"]}`))
+			utilruntime.Must(err)
+			return
+		}
+		_, err := w.Write([]byte(`{"auth":{"client_token":"s.token","accessor":"accessor-1","lease_duration":3600,"renewable":true}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/auth/token/renew", func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+		var data map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if data["token"] != "s.token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, err := w.Write([]byte(`{"errors":["permission denied"]}`))
+			utilruntime.Must(err)
+			return
+		}
+		_, err := w.Write([]byte(`{"auth":{"client_token":"s.token","accessor":"accessor-1","lease_duration":3600,"renewable":true}}`))
+		utilruntime.Must(err)
+	}).Methods(http.MethodPost)
+
+	router.HandleFunc("/v1/auth/token/revoke-accessor", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPost)
+
+	return httptest.NewServer(router)
+}
+
+func newDemoTokenRole(t *testing.T, addr string) *TokenRole {
+	cfg := vaultapi.DefaultConfig()
+	cfg.Address = addr
+	cl, err := vaultapi.NewClient(cfg)
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+	return &TokenRole{
+		tokenRole: &api.VaultTokenRole{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-role",
+				Namespace: "demo",
+			},
+			Spec: api.VaultTokenRoleSpec{
+				AllowedPolicies: []string{"read"},
+				Period:          "24h",
+			},
+		},
+		vaultClient: cl,
+	}
+}
+
+func TestTokenRole_CreateRole(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	err := newDemoTokenRole(t, srv.URL).CreateRole()
+	assert.Nil(t, err)
+}
+
+func TestTokenRole_CreateToken(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	testData := []struct {
+		testName    string
+		spec        api.VaultTokenRequestSpec
+		expectedErr bool
+	}{
+		{
+			testName: "Create Token Successful",
+			spec: api.VaultTokenRequestSpec{
+				Policies: []string{"read"},
+				Metadata: map[string]string{"app": "legacy"},
+			},
+			expectedErr: false,
+		},
+		{
+			testName:    "Create Token Failed",
+			spec:        api.VaultTokenRequestSpec{},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			secret, err := newDemoTokenRole(t, srv.URL).CreateToken(test.spec)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				if assert.Nil(t, err) {
+					assert.Equal(t, "s.token", secret.Auth.ClientToken)
+					assert.Equal(t, "accessor-1", secret.Auth.Accessor)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenRole_RenewToken(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	testData := []struct {
+		testName    string
+		token       string
+		expectedErr bool
+	}{
+		{
+			testName:    "Renew Token Successful",
+			token:       "s.token",
+			expectedErr: false,
+		},
+		{
+			testName:    "Renew Token Failed",
+			token:       "s.unknown",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range testData {
+		t.Run(test.testName, func(t *testing.T) {
+			secret, err := newDemoTokenRole(t, srv.URL).RenewToken(test.token)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+			} else {
+				if assert.Nil(t, err) {
+					assert.Equal(t, 3600, secret.Auth.LeaseDuration)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenRole_RevokeTokenAccessor(t *testing.T) {
+	srv := setupVaultServer()
+	defer srv.Close()
+
+	err := newDemoTokenRole(t, srv.URL).RevokeTokenAccessor("accessor-1")
+	assert.Nil(t, err)
+}
+
+func TestRolePayload(t *testing.T) {
+	trueVar := true
+	spec := api.VaultTokenRoleSpec{
+		AllowedPolicies: []string{"read"},
+		Renewable:       &trueVar,
+		Period:          "24h",
+		TokenType:       "service",
+	}
+	expected := map[string]interface{}{
+		"allowed_policies":    []string{"read"},
+		"disallowed_policies": []string(nil),
+		"orphan":              true,
+		"renewable":           true,
+		"token_period":        "24h",
+		"token_type":          "service",
+	}
+	assert.Equal(t, expected, rolePayload(spec))
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strconv"
 
+	engine "kubevault.dev/operator/apis/engine/v1alpha1"
 	"kubevault.dev/operator/pkg/vault/secret"
 
 	vaultapi "github.com/hashicorp/vault/api"
@@ -26,8 +27,11 @@ import (
 )
 
 const (
-	UID       = "AWS"
-	UseSTSKey = "useSTS"
+	UID                = "AWS"
+	UseSTSKey          = "useSTS"
+	RoleARNKey         = "roleARN"
+	TTLKey             = "ttl"
+	RoleSessionNameKey = "roleSessionName"
 )
 
 type SecretInfo struct {
@@ -41,6 +45,15 @@ type SecretInfo struct {
 	// Otherwise, '/aws/creds' endpoint will be used to retrieve credential
 	UseSTS bool
 
+	// The ARN of the role to assume, if the credential type of the role is assumed_role
+	RoleARN string
+
+	// Specifies the TTL of the STS credential
+	TTL string
+
+	// The role session name to attach to the assumed role ARN
+	RoleSessionName string
+
 	Client *vaultapi.Client
 }
 
@@ -58,11 +71,15 @@ func NewSecretManagerWithOptions(c *vaultapi.Client, opts map[string]string) (se
 	return s, err
 }
 
-func NewSecretGetter(vc *vaultapi.Client, path string, role string, useSTS bool) secret.SecretGetter {
+func NewSecretGetter(vc *vaultapi.Client, path string, role string, reqSpec engine.AWSAccessKeyRequestSpec) secret.SecretGetter {
 	return &SecretInfo{
-		Client: vc,
-		Path:   path,
-		Role:   role,
+		Client:          vc,
+		Path:            path,
+		Role:            role,
+		UseSTS:          reqSpec.UseSTS,
+		RoleARN:         reqSpec.RoleARN,
+		TTL:             reqSpec.TTL,
+		RoleSessionName: reqSpec.RoleSessionName,
 	}
 }
 
@@ -79,6 +96,15 @@ func (s *SecretInfo) SetOptions(c *vaultapi.Client, opts map[string]string) erro
 			s.UseSTS = v
 		}
 	}
+	if val, ok := opts[RoleARNKey]; ok {
+		s.RoleARN = val
+	}
+	if val, ok := opts[TTLKey]; ok {
+		s.TTL = val
+	}
+	if val, ok := opts[RoleSessionNameKey]; ok {
+		s.RoleSessionName = val
+	}
 	return nil
 }
 
@@ -99,7 +125,27 @@ func (s *SecretInfo) GetSecret() (*vaultapi.Secret, error) {
 	} else {
 		path = fmt.Sprintf("/v1/%s/creds/%s", s.Path, s.Role)
 	}
+
+	// https://www.vaultproject.io/api/secret/aws/index.html#generate-credentials
+	payload := map[string]interface{}{}
+	if s.RoleARN != "" {
+		payload["role_arn"] = s.RoleARN
+	}
+	if s.TTL != "" {
+		payload["ttl"] = s.TTL
+	}
+	if s.RoleSessionName != "" {
+		payload["role_session_name"] = s.RoleSessionName
+	}
+
 	req := s.Client.NewRequest("GET", path)
+	if len(payload) > 0 {
+		// the parameters are sent in the body of a POST request
+		req.Method = "POST"
+		if err := req.SetJSONBody(payload); err != nil {
+			return nil, errors.Wrap(err, "failed to load payload in aws access key request")
+		}
+	}
 
 	resp, err := s.Client.RawRequest(req)
 	if err != nil {
@@ -37,6 +37,9 @@ type SecretInfo struct {
 	// Specifies the role for credential
 	Role string
 
+	// Specifies the type of the role, i.e. roleset, static-account or impersonated-account
+	RoleType string
+
 	// Contains the information about secret type, i.e. access_token or service_account_key
 	SecretType string
 
@@ -63,10 +66,11 @@ func NewSecretManagerWithOptions(c *vaultapi.Client, opts map[string]string) (se
 	return s, err
 }
 
-func NewSecretGetter(vc *vaultapi.Client, path string, roleName string, secretType engine.GCPSecretType, reqSpec engine.GCPAccessKeyRequestSpec) secret.SecretGetter {
+func NewSecretGetter(vc *vaultapi.Client, path string, roleName string, roleType engine.GCPRoleType, secretType engine.GCPSecretType, reqSpec engine.GCPAccessKeyRequestSpec) secret.SecretGetter {
 	return &SecretInfo{
 		Path:         path,
 		Role:         roleName,
+		RoleType:     string(roleType),
 		SecretType:   string(secretType),
 		KeyAlgorithm: reqSpec.KeyAlgorithm,
 		KeyType:      reqSpec.KeyType,
@@ -107,12 +111,20 @@ func (s *SecretInfo) GetSecret() (*vaultapi.Secret, error) {
 
 	var path string
 	if s.SecretType == string(engine.GCPSecretAccessToken) {
-		path = fmt.Sprintf("/v1/%s/token/%s", s.Path, s.Role)
+		path = "token"
 	} else if s.SecretType == string(engine.GCPSecretServiceAccountKey) {
-		path = fmt.Sprintf("/v1/%s/key/%s", s.Path, s.Role)
+		path = "key"
 	} else {
 		return nil, errors.New("secret_type is not specified")
 	}
+	switch engine.GCPRoleType(s.RoleType) {
+	case "", engine.GCPRoleTypeRoleset:
+		path = fmt.Sprintf("/v1/%s/%s/%s", s.Path, path, s.Role)
+	case engine.GCPRoleTypeStaticAccount, engine.GCPRoleTypeImpersonatedAccount:
+		path = fmt.Sprintf("/v1/%s/%s/%s/%s", s.Path, s.RoleType, s.Role, path)
+	default:
+		return nil, errors.Errorf("unknown role type %s", s.RoleType)
+	}
 
 	req := s.Client.NewRequest("GET", path)
 	if s.SecretType == string(engine.GCPSecretServiceAccountKey) {
@@ -30,6 +30,8 @@ import (
 
 const (
 	ModeKubernetesSecret = "kubernetes-secret"
+	// RootTokenID is the key of the root token in the unsealer key store
+	RootTokenID = "vault-root"
 )
 
 type Options struct {
@@ -42,6 +44,11 @@ func NewOptions(s api.KubernetesSecretSpec) (*Options, error) {
 	}, nil
 }
 
+// UnsealKeyID returns the key of the i-th unseal key share in the unsealer key store
+func UnsealKeyID(i int) string {
+	return fmt.Sprintf("vault-unseal-%d", i)
+}
+
 func (o *Options) Apply(pt *core.PodTemplateSpec) error {
 	if pt == nil {
 		return errors.New("podTempleSpec is nil")
@@ -17,8 +17,11 @@ package unsealer
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
+	catalog "kubevault.dev/operator/apis/catalog/v1alpha1"
 	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
 	sa_util "kubevault.dev/operator/pkg/util"
 	"kubevault.dev/operator/pkg/vault/unsealer/aws"
@@ -31,6 +34,7 @@ import (
 	"github.com/pkg/errors"
 	core "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	core_util "kmodules.xyz/client-go/core/v1"
@@ -41,6 +45,9 @@ import (
 
 const (
 	K8sTokenReviewerJwtEnv = "K8S_TOKEN_REVIEWER_JWT"
+	PGPKeysVolumeName      = "vault-unsealer-pgp-keys"
+	PGPKeysDir             = "/etc/vault/unsealer/pgp"
+	RootTokenPGPKeyFile    = "root-token.asc"
 	timeout                = 30 * time.Second
 	timeInterval           = 2 * time.Second
 )
@@ -56,6 +63,8 @@ type unsealerSrv struct {
 	vs         *api.VaultServer
 	unsealer   Unsealer
 	image      string
+	// pgpKeys indicates whether the unsealer image supports pgp encrypted unseal keys
+	pgpKeys bool
 }
 
 func newUnsealer(s *api.UnsealerSpec) (Unsealer, error) {
@@ -72,7 +81,7 @@ func newUnsealer(s *api.UnsealerSpec) (Unsealer, error) {
 	}
 }
 
-func NewUnsealerService(restConfig *rest.Config, vs *api.VaultServer, image string) (Unsealer, error) {
+func NewUnsealerService(restConfig *rest.Config, vs *api.VaultServer, version catalog.VaultServerVersionUnsealer) (Unsealer, error) {
 	if vs == nil {
 		return nil, errors.New("VaultServer is nil")
 	}
@@ -94,7 +103,8 @@ func NewUnsealerService(restConfig *rest.Config, vs *api.VaultServer, image stri
 		restConfig: restConfig,
 		vs:         vs,
 		kc:         kc,
-		image:      image,
+		image:      version.Image,
+		pgpKeys:    version.PGPKeys,
 		unsealer:   unslr,
 	}, nil
 }
@@ -138,6 +148,46 @@ func (u *unsealerSrv) Apply(pt *core.PodTemplateSpec) error {
 		args = append(args, fmt.Sprintf("--vault.ca-cert=%s", u.vs.Spec.TLS.CABundle))
 	}
 
+	// Add flags and volume for pgp encrypted unseal keys
+	if len(unslr.PGPKeys) > 0 {
+		if !u.pgpKeys {
+			return errors.Errorf("unsealer image %s does not support pgp encrypted unseal keys", u.image)
+		}
+		var (
+			files   []string
+			sources []core.VolumeProjection
+		)
+		for i, k := range unslr.PGPKeys {
+			file := PGPKeyFileName(i)
+			files = append(files, filepath.Join(PGPKeysDir, file))
+			sources = append(sources, pgpKeyProjection(k, file))
+		}
+		args = append(args, fmt.Sprintf("--pgp-keys=%s", strings.Join(files, ",")))
+		args = append(args, fmt.Sprintf("--pgp-custodians=%s", strings.Join(Custodians(unslr), ",")))
+
+		if unslr.RootTokenPGPKey != nil {
+			sources = append(sources, pgpKeyProjection(*unslr.RootTokenPGPKey, RootTokenPGPKeyFile))
+			args = append(args, fmt.Sprintf("--root-token-pgp-key=%s", filepath.Join(PGPKeysDir, RootTokenPGPKeyFile)))
+		}
+		if unslr.UnsealKeysSecret != "" {
+			args = append(args, fmt.Sprintf("--unseal-keys-secret=%s", unslr.UnsealKeysSecret))
+		}
+
+		pt.Spec.Volumes = core_util.UpsertVolume(pt.Spec.Volumes, core.Volume{
+			Name: PGPKeysVolumeName,
+			VolumeSource: core.VolumeSource{
+				Projected: &core.ProjectedVolumeSource{
+					Sources: sources,
+				},
+			},
+		})
+		cont.VolumeMounts = core_util.UpsertVolumeMount(cont.VolumeMounts, core.VolumeMount{
+			Name:      PGPKeysVolumeName,
+			MountPath: PGPKeysDir,
+			ReadOnly:  true,
+		})
+	}
+
 	// Add kubernetes auth flags
 	args = append(args, fmt.Sprintf("--auth.k8s-host=%s", u.restConfig.Host))
 
@@ -187,5 +237,54 @@ func (u *unsealerSrv) GetRBAC(prefix, namespace string) []rbac.Role {
 	if u == nil {
 		return nil
 	}
-	return u.unsealer.GetRBAC(prefix, namespace)
+	roles := u.unsealer.GetRBAC(prefix, namespace)
+
+	// unsealer reads the decrypted unseal key shares supplied by the custodians
+	unslr := u.vs.Spec.Unsealer
+	if len(unslr.PGPKeys) > 0 && unslr.UnsealKeysSecret != "" {
+		roles = append(roles, rbac.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      prefix + "-unsealer-unseal-keys-reader",
+				Namespace: namespace,
+			},
+			Rules: []rbac.PolicyRule{
+				{
+					APIGroups:     []string{core.GroupName},
+					Resources:     []string{"secrets"},
+					ResourceNames: []string{unslr.UnsealKeysSecret},
+					Verbs:         []string{"get"},
+				},
+			},
+		})
+	}
+	return roles
+}
+
+// PGPKeyFileName returns the file name of the i-th custodian pgp key
+// inside the unsealer container
+func PGPKeyFileName(i int) string {
+	return fmt.Sprintf("pgp-key-%d.asc", i)
+}
+
+// Custodians returns the custodian names in the order of the unseal key shares
+func Custodians(s *api.UnsealerSpec) []string {
+	var names []string
+	for _, k := range s.PGPKeys {
+		names = append(names, k.Custodian)
+	}
+	return names
+}
+
+func pgpKeyProjection(k api.PGPKeySource, file string) core.VolumeProjection {
+	return core.VolumeProjection{
+		ConfigMap: &core.ConfigMapProjection{
+			LocalObjectReference: k.ConfigMapKeyRef.LocalObjectReference,
+			Items: []core.KeyToPath{
+				{
+					Key:  k.ConfigMapKeyRef.Key,
+					Path: file,
+				},
+			},
+		},
+	}
 }
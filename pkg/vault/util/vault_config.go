@@ -18,6 +18,9 @@ package util
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
 
 	vaultapi "github.com/hashicorp/vault/api"
 	"k8s.io/kubernetes/pkg/apis/core"
@@ -36,6 +39,12 @@ const (
 
 	// VaultTLSAssetDir is the dir where vault's server TLS sits
 	VaultTLSAssetDir = "/etc/vault/tls/"
+
+	// VaultTLSClientCADir is the dir where the CA certificate for client authentication sits
+	VaultTLSClientCADir = "/etc/vault/tls-client-ca/"
+
+	// TLSClientCAKey is the key of the CA certificate in the client CA secret
+	TLSClientCAKey = "ca.crt"
 )
 
 var listenerFmt = `
@@ -53,13 +62,85 @@ func NewConfigWithDefaultParams() string {
 	return fmt.Sprintf(listenerFmt, filepath.Join(VaultTLSAssetDir, core.TLSCertKey), filepath.Join(VaultTLSAssetDir, core.TLSPrivateKeyKey))
 }
 
-// ListenerConfig creates tcp listener config
-func GetListenerConfig() string {
+// GetListenerConfig creates tcp listener config
+func GetListenerConfig(l *api.TCPListenerSpec) string {
 	listenerCfg := fmt.Sprintf(listenerFmt,
 		filepath.Join(VaultTLSAssetDir, core.TLSCertKey),
 		filepath.Join(VaultTLSAssetDir, core.TLSPrivateKeyKey))
 
-	return listenerCfg
+	params := getListenerParams(l)
+	if len(params) == 0 {
+		return listenerCfg
+	}
+	return fmt.Sprintf("%s  %s\n}\n", strings.TrimSuffix(listenerCfg, "}\n"), strings.Join(params, "\n  "))
+}
+
+func getListenerParams(l *api.TCPListenerSpec) []string {
+	if l == nil {
+		return nil
+	}
+
+	var params []string
+	if l.TLSMinVersion != "" {
+		params = append(params, fmt.Sprintf("tls_min_version = %q", l.TLSMinVersion))
+	}
+	if len(l.TLSCipherSuites) > 0 {
+		params = append(params, fmt.Sprintf("tls_cipher_suites = %q", strings.Join(l.TLSCipherSuites, ",")))
+	}
+	if l.TLSClientCASecret != "" {
+		params = append(params, fmt.Sprintf("tls_client_ca_file = %q", filepath.Join(VaultTLSClientCADir, TLSClientCAKey)))
+	}
+	if p := l.ProxyProtocol; p != nil {
+		params = append(params, fmt.Sprintf("proxy_protocol_behavior = %q", p.Behavior))
+		if len(p.AuthorizedAddrs) > 0 {
+			params = append(params, fmt.Sprintf("proxy_protocol_authorized_addrs = %q", strings.Join(p.AuthorizedAddrs, ",")))
+		}
+	}
+	if x := l.XForwardedFor; x != nil {
+		params = append(params, fmt.Sprintf("x_forwarded_for_authorized_addrs = %q", strings.Join(x.AuthorizedAddrs, ",")))
+		if x.HopSkips > 0 {
+			params = append(params, fmt.Sprintf("x_forwarded_for_hop_skips = %d", x.HopSkips))
+		}
+		if x.RejectNotAuthorized != nil {
+			params = append(params, fmt.Sprintf("x_forwarded_for_reject_not_authorized = %t", *x.RejectNotAuthorized))
+		}
+		if x.RejectNotPresent != nil {
+			params = append(params, fmt.Sprintf("x_forwarded_for_reject_not_present = %t", *x.RejectNotPresent))
+		}
+	}
+	return params
+}
+
+// GetServerConfig creates the server level config.
+// It returns empty string if no parameter is specified.
+func GetServerConfig(s *api.ServerConfigSpec) string {
+	if s == nil {
+		return ""
+	}
+
+	var params []string
+	if s.UI {
+		params = append(params, "ui = true")
+	}
+	if s.LogLevel != "" {
+		params = append(params, fmt.Sprintf("log_level = %q", s.LogLevel))
+	}
+	if s.DefaultLeaseTTL != nil {
+		params = append(params, fmt.Sprintf("default_lease_ttl = %q", s.DefaultLeaseTTL.Duration.String()))
+	}
+	if s.MaxLeaseTTL != nil {
+		params = append(params, fmt.Sprintf("max_lease_ttl = %q", s.MaxLeaseTTL.Duration.String()))
+	}
+	if s.DisableMlock {
+		params = append(params, "disable_mlock = true")
+	}
+	if s.CacheSize > 0 {
+		params = append(params, fmt.Sprintf("cache_size = %d", s.CacheSize))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return strings.Join(params, "\n") + "\n"
 }
 
 func NewVaultClient(hostname string, port string, tlsConfig *vaultapi.TLSConfig) (*vaultapi.Client, error) {
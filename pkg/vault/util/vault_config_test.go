@@ -17,8 +17,12 @@ package util
 
 import (
 	"testing"
+	"time"
+
+	api "kubevault.dev/operator/apis/kubevault/v1alpha1"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestGetListenerConfig(t *testing.T) {
@@ -30,5 +34,56 @@ listener "tcp" {
   tls_key_file  = "/etc/vault/tls/tls.key"
 }
 `
-	assert.Equal(t, expectedOutput, GetListenerConfig())
+	assert.Equal(t, expectedOutput, GetListenerConfig(nil))
+}
+
+func TestGetListenerConfigWithParams(t *testing.T) {
+	rejectNotPresent := false
+	l := &api.TCPListenerSpec{
+		TLSMinVersion:     "tls12",
+		TLSCipherSuites:   []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+		TLSClientCASecret: "client-ca",
+		XForwardedFor: &api.XForwardedForSpec{
+			AuthorizedAddrs:  []string{"10.0.0.0/8"},
+			HopSkips:         1,
+			RejectNotPresent: &rejectNotPresent,
+		},
+	}
+	expectedOutput := `
+listener "tcp" {
+  address = "0.0.0.0:8200"
+  cluster_address = "0.0.0.0:8201"
+  tls_cert_file = "/etc/vault/tls/tls.crt"
+  tls_key_file  = "/etc/vault/tls/tls.key"
+  tls_min_version = "tls12"
+  tls_cipher_suites = "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"
+  tls_client_ca_file = "/etc/vault/tls-client-ca/ca.crt"
+  x_forwarded_for_authorized_addrs = "10.0.0.0/8"
+  x_forwarded_for_hop_skips = 1
+  x_forwarded_for_reject_not_present = false
+}
+`
+	assert.Equal(t, expectedOutput, GetListenerConfig(l))
+}
+
+func TestGetServerConfig(t *testing.T) {
+	assert.Equal(t, "", GetServerConfig(nil))
+	assert.Equal(t, "", GetServerConfig(&api.ServerConfigSpec{}))
+
+	s := &api.ServerConfigSpec{
+		UI:              true,
+		LogLevel:        "debug",
+		DefaultLeaseTTL: &metav1.Duration{Duration: time.Hour},
+		MaxLeaseTTL:     &metav1.Duration{Duration: 24 * time.Hour},
+		DisableMlock:    true,
+		CacheSize:       65536,
+	}
+	expectedOutput := `ui = true
+log_level = "debug"
+default_lease_ttl = "1h0m0s"
+max_lease_ttl = "24h0m0s"
+disable_mlock = true
+cache_size = 65536
+`
+	assert.Equal(t, expectedOutput, GetServerConfig(s))
 }